@@ -0,0 +1,344 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package actions
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/auth"
+	"github.com/pydio/cells/v4/common/client/grpc"
+	"github.com/pydio/cells/v4/common/config"
+	"github.com/pydio/cells/v4/common/forms"
+	"github.com/pydio/cells/v4/common/log"
+	"github.com/pydio/cells/v4/common/nodes"
+	"github.com/pydio/cells/v4/common/nodes/compose"
+	"github.com/pydio/cells/v4/common/nodes/models"
+	"github.com/pydio/cells/v4/common/proto/idm"
+	"github.com/pydio/cells/v4/common/proto/jobs"
+	"github.com/pydio/cells/v4/common/proto/mailer"
+	"github.com/pydio/cells/v4/common/proto/rest"
+	"github.com/pydio/cells/v4/common/proto/tree"
+	"github.com/pydio/cells/v4/common/service/errors"
+	"github.com/pydio/cells/v4/common/utils/i18n"
+	"github.com/pydio/cells/v4/common/utils/permissions"
+	"github.com/pydio/cells/v4/idm/share"
+	"github.com/pydio/cells/v4/scheduler/actions"
+)
+
+const (
+	sendMailActionName = "broker.mailer.actions.send-mail"
+
+	attachNone    = "none"
+	attachContent = "content"
+	attachLink    = "link"
+
+	// Attachments are inlined in the SendMail request and stored in the mailer queue: they must stay well
+	// under the 4MB limit of gRPC messages.
+	defaultMaxAttachmentSize = 2 * 1024 * 1024
+	maxAttachmentSizeLimit   = 3 * 1024 * 1024
+	defaultLinkExpiration    = 7
+)
+
+// SendMailAction sends a templated email to a list of addresses or to the users passed in input.
+type SendMailAction struct {
+	common.RuntimeHolder
+	mailerClient      mailer.MailerServiceClient
+	router            nodes.Client
+	owner             string
+	to                string
+	subject           string
+	body              string
+	attach            string
+	maxAttachmentSize int64
+	linkExpiration    int
+}
+
+// GetDescription returns the action description
+func (m *SendMailAction) GetDescription(lang ...string) actions.ActionDescription {
+	return actions.ActionDescription{
+		ID:                sendMailActionName,
+		Label:             "Send Email",
+		Icon:              "email-outline",
+		Category:          actions.ActionCategoryNotify,
+		InputDescription:  "Users to send the email to, and optionally a node to attach or link",
+		OutputDescription: "Returns unchanged input",
+		Description:       "Send an email built from a subject and body template. Emails are pushed to the mailer queue.",
+		SummaryTemplate:   "",
+		HasForm:           true,
+	}
+}
+
+// GetParametersForm returns an UX Form
+func (m *SendMailAction) GetParametersForm() *forms.Form {
+	return &forms.Form{Groups: []*forms.Group{
+		{
+			Fields: []forms.Field{
+				&forms.FormField{
+					Name:        "to",
+					Type:        forms.ParamString,
+					Label:       "Recipients",
+					Description: "Comma-separated list of email addresses. If empty, the email is sent to the users passed in input",
+					Mandatory:   false,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "subject",
+					Type:        forms.ParamString,
+					Label:       "Subject",
+					Description: "Subject of the email, evaluated against the input nodes and users",
+					Mandatory:   true,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "body",
+					Type:        forms.ParamTextarea,
+					Label:       "Body",
+					Description: "Body of the email in Markdown, evaluated against the input nodes and users",
+					Mandatory:   true,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "attach",
+					Type:        forms.ParamSelect,
+					Label:       "Input Node",
+					Description: "Attach the content of the first input node, or append a public link to it",
+					Default:     attachNone,
+					Mandatory:   false,
+					Editable:    true,
+					ChoicePresetList: []map[string]string{
+						{attachNone: "Ignore"},
+						{attachContent: "Attach content"},
+						{attachLink: "Create a public link"},
+					},
+				},
+				&forms.FormField{
+					Name:        "maxAttachmentSize",
+					Type:        forms.ParamIntegerBytes,
+					Label:       "Maximum Attachment Size",
+					Description: "Do not attach files bigger than this size (3MB at most)",
+					Default:     defaultMaxAttachmentSize,
+					Mandatory:   false,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "linkExpiration",
+					Type:        forms.ParamInteger,
+					Label:       "Link Expiration",
+					Description: "Number of days after which public links created by this action expire",
+					Default:     defaultLinkExpiration,
+					Mandatory:   false,
+					Editable:    true,
+				},
+			},
+		},
+	}}
+}
+
+// GetName returns the Unique Identifier of the SendMailAction.
+func (m *SendMailAction) GetName() string {
+	return sendMailActionName
+}
+
+// Init passes parameters to a newly created instance.
+func (m *SendMailAction) Init(job *jobs.Job, action *jobs.Action) error {
+	m.subject = action.Parameters["subject"]
+	m.body = action.Parameters["body"]
+	if m.subject == "" || m.body == "" {
+		return errors.BadRequest(common.ServiceTasks, "missing parameters subject or body in SendMail action")
+	}
+	m.to = action.Parameters["to"]
+	m.owner = job.Owner
+	m.attach = attachNone
+	if a, ok := action.Parameters["attach"]; ok && a != "" {
+		if a != attachNone && a != attachContent && a != attachLink {
+			return errors.BadRequest(common.ServiceTasks, "invalid value for attach parameter: "+a)
+		}
+		m.attach = a
+	}
+	m.maxAttachmentSize = defaultMaxAttachmentSize
+	if s, ok := action.Parameters["maxAttachmentSize"]; ok && s != "" {
+		size, e := strconv.ParseInt(s, 10, 64)
+		if e != nil || size <= 0 || size > maxAttachmentSizeLimit {
+			return errors.BadRequest(common.ServiceTasks, "invalid value for maxAttachmentSize parameter: "+s)
+		}
+		m.maxAttachmentSize = size
+	}
+	m.linkExpiration = defaultLinkExpiration
+	if s, ok := action.Parameters["linkExpiration"]; ok && s != "" {
+		days, e := strconv.Atoi(s)
+		if e != nil || days <= 0 {
+			return errors.BadRequest(common.ServiceTasks, "invalid value for linkExpiration parameter: "+s)
+		}
+		m.linkExpiration = days
+	}
+	m.mailerClient = mailer.NewMailerServiceClient(grpc.GetClientConnFromCtx(m.GetRuntimeContext(), common.ServiceMailer))
+	m.router = compose.PathClientAdmin(m.GetRuntimeContext())
+	return nil
+}
+
+// Run processes the actual action code
+func (m *SendMailAction) Run(ctx context.Context, channels *actions.RunnableChannels, input jobs.ActionMessage) (jobs.ActionMessage, error) {
+
+	if !config.Get("services", common.ServiceGrpcNamespace_+common.ServiceMailer, "valid").Default(false).Bool() {
+		log.Logger(ctx).Debug("Ignoring as no valid mailer was found")
+		return input.WithIgnore(), nil
+	}
+
+	to := m.recipients(ctx, input)
+	if len(to) == 0 {
+		log.TasksLogger(ctx).Info("No recipient with an email address found, ignoring")
+		return input.WithIgnore(), nil
+	}
+
+	mail := &mailer.Mail{
+		To:              to,
+		Subject:         jobs.EvaluateFieldStr(ctx, input, m.subject),
+		ContentMarkdown: jobs.EvaluateFieldStr(ctx, input, m.body),
+	}
+
+	if m.attach != attachNone {
+		if len(input.Nodes) == 0 {
+			log.TasksLogger(ctx).Warn("No node passed in input, cannot attach file to email")
+		} else if m.attach == attachContent {
+			att, e := m.attachment(ctx, input.Nodes[0])
+			if e != nil {
+				return input.WithError(e), e
+			}
+			mail.InlineAttachments = append(mail.InlineAttachments, att)
+		} else {
+			u, e := m.publicLink(ctx, input.Nodes[0])
+			if e != nil {
+				return input.WithError(e), e
+			}
+			mail.ContentMarkdown += fmt.Sprintf("\n\n[%s](%s)", path.Base(input.Nodes[0].GetPath()), u)
+		}
+	}
+
+	if _, e := m.mailerClient.SendMail(ctx, &mailer.SendMailRequest{Mail: mail, InQueue: true}); e != nil {
+		return input.WithError(e), e
+	}
+	log.TasksLogger(ctx).Info(fmt.Sprintf("Email queued for %d recipient(s)", len(to)))
+	return input, nil
+}
+
+// recipients evaluates the "to" parameter, or falls back to the input users having an email address.
+func (m *SendMailAction) recipients(ctx context.Context, input jobs.ActionMessage) (to []*mailer.User) {
+	if m.to != "" {
+		for _, address := range strings.Split(jobs.EvaluateFieldStr(ctx, input, m.to), ",") {
+			if address = strings.TrimSpace(address); address != "" {
+				to = append(to, &mailer.User{Address: address})
+			}
+		}
+		return
+	}
+	for _, u := range input.Users {
+		email, ok := u.Attributes["email"]
+		if !ok || email == "" {
+			continue
+		}
+		name, ok := u.Attributes["displayName"]
+		if !ok {
+			name = u.Login
+		}
+		to = append(to, &mailer.User{
+			Uuid:     u.Uuid,
+			Address:  email,
+			Name:     name,
+			Language: i18n.UserLanguage(ctx, u, config.Get()),
+		})
+	}
+	return
+}
+
+// attachment reads the node content, provided it is a file smaller than the maximum attachment size.
+func (m *SendMailAction) attachment(ctx context.Context, node *tree.Node) (*mailer.Attachment, error) {
+	resp, e := m.router.ReadNode(ctx, &tree.ReadNodeRequest{Node: node})
+	if e != nil {
+		return nil, e
+	}
+	node = resp.GetNode()
+	if !node.IsLeaf() {
+		return nil, errors.BadRequest(common.ServiceTasks, "cannot attach folder "+node.GetPath())
+	}
+	if node.GetSize() > m.maxAttachmentSize {
+		return nil, errors.BadRequest(common.ServiceTasks, fmt.Sprintf("file %s is too big to be attached (%d bytes)", node.GetPath(), node.GetSize()))
+	}
+	reader, e := m.router.GetObject(ctx, node, &models.GetRequestData{Length: node.GetSize()})
+	if e != nil {
+		return nil, e
+	}
+	defer reader.Close()
+	data, e := io.ReadAll(reader)
+	if e != nil {
+		return nil, e
+	}
+	contentType := node.GetStringMeta(common.MetaNamespaceMime)
+	if contentType == "" {
+		contentType = mime.TypeByExtension(path.Ext(node.GetPath()))
+	}
+	return &mailer.Attachment{
+		Name:        path.Base(node.GetPath()),
+		ContentType: contentType,
+		Data:        data,
+	}, nil
+}
+
+// publicLink creates a read-only public link on the node on behalf of the job owner and returns its absolute URL.
+// Links expire after linkExpiration days.
+func (m *SendMailAction) publicLink(ctx context.Context, node *tree.Node) (string, error) {
+	if m.owner == "" || m.owner == common.PydioSystemUsername {
+		return "", errors.BadRequest(common.ServiceTasks, "public links can only be created by jobs owned by a user")
+	}
+	var owner *idm.User
+	if u, claims := permissions.FindUserNameInContext(ctx); u != m.owner || claims.Name != m.owner {
+		user, e := permissions.SearchUniqueUser(ctx, m.owner, "")
+		if e != nil {
+			return "", e
+		}
+		ctx = auth.WithImpersonate(ctx, user)
+		owner = user
+	} else {
+		owner = &idm.User{Uuid: claims.Subject, Login: claims.Name, GroupPath: claims.GroupPath}
+	}
+	link := &rest.ShareLink{
+		Label:       path.Base(node.GetPath()),
+		RootNodes:   []*tree.Node{node},
+		Permissions: []rest.ShareLinkAccessType{rest.ShareLinkAccessType_Preview, rest.ShareLinkAccessType_Download},
+		AccessEnd:   time.Now().AddDate(0, 0, m.linkExpiration).Unix(),
+	}
+	if _, e := share.NewClient(m.GetRuntimeContext()).PutLink(ctx, owner, &rest.PutShareLinkRequest{ShareLink: link}, nil); e != nil {
+		return "", e
+	}
+	u := link.GetLinkUrl()
+	if strings.HasPrefix(u, "/") {
+		u = strings.TrimSuffix(config.GetDefaultSiteURL(), "/") + u
+	}
+	log.TasksLogger(ctx).Info("Created public link " + u + " for " + node.GetPath())
+	return u, nil
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package actions
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/v4/common/config/mock"
+	"github.com/pydio/cells/v4/common/proto/idm"
+	"github.com/pydio/cells/v4/common/proto/jobs"
+)
+
+func init() {
+	_ = mock.RegisterMockConfig()
+}
+
+func TestSendMailAction_Init(t *testing.T) {
+
+	Convey("Test Init", t, func() {
+		action := &SendMailAction{}
+		action.SetRuntimeContext(context.Background())
+		So(action.GetName(), ShouldEqual, sendMailActionName)
+		job := &jobs.Job{Owner: "admin"}
+		So(action.Init(job, &jobs.Action{}), ShouldNotBeNil)
+		So(action.Init(job, &jobs.Action{Parameters: map[string]string{"subject": "Hello"}}), ShouldNotBeNil)
+		So(action.Init(job, &jobs.Action{Parameters: map[string]string{"subject": "Hello", "body": "World", "attach": "unknown"}}), ShouldNotBeNil)
+		So(action.Init(job, &jobs.Action{Parameters: map[string]string{"subject": "Hello", "body": "World", "maxAttachmentSize": "-1"}}), ShouldNotBeNil)
+		So(action.Init(job, &jobs.Action{Parameters: map[string]string{"subject": "Hello", "body": "World", "maxAttachmentSize": "10485760"}}), ShouldNotBeNil)
+		So(action.Init(job, &jobs.Action{Parameters: map[string]string{"subject": "Hello", "body": "World", "linkExpiration": "0"}}), ShouldNotBeNil)
+		So(action.Init(job, &jobs.Action{Parameters: map[string]string{"subject": "Hello", "body": "World", "attach": "link"}}), ShouldBeNil)
+		So(action.attach, ShouldEqual, attachLink)
+		So(action.maxAttachmentSize, ShouldEqual, defaultMaxAttachmentSize)
+		So(action.linkExpiration, ShouldEqual, defaultLinkExpiration)
+		So(action.owner, ShouldEqual, "admin")
+	})
+}
+
+func TestSendMailAction_Recipients(t *testing.T) {
+
+	Convey("Recipients from parameter", t, func() {
+		action := &SendMailAction{to: "a@example.com, b@example.com,"}
+		to := action.recipients(context.Background(), jobs.ActionMessage{})
+		So(to, ShouldHaveLength, 2)
+		So(to[1].Address, ShouldEqual, "b@example.com")
+	})
+
+	Convey("Recipients from input users", t, func() {
+		action := &SendMailAction{}
+		to := action.recipients(context.Background(), jobs.ActionMessage{Users: []*idm.User{
+			{Login: "john", Attributes: map[string]string{"email": "john@example.com"}},
+			{Login: "jane", Attributes: map[string]string{"email": "jane@example.com", "displayName": "Jane"}},
+			{Login: "nomail"},
+		}})
+		So(to, ShouldHaveLength, 2)
+		So(to[0].Name, ShouldEqual, "john")
+		So(to[1].Name, ShouldEqual, "Jane")
+	})
+}
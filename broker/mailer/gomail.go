@@ -22,6 +22,7 @@ package mailer
 
 import (
 	"fmt"
+	"io"
 
 	"gopkg.in/gomail.v2"
 
@@ -71,6 +72,18 @@ func NewGomailMessage(email *mailer.Mail) (*gomail.Message, error) {
 	for _, a := range email.Attachments {
 		m.Attach(a)
 	}
+	for _, a := range email.InlineAttachments {
+		data := a.Data
+		var settings []gomail.FileSetting
+		settings = append(settings, gomail.SetCopyFunc(func(w io.Writer) error {
+			_, e := w.Write(data)
+			return e
+		}))
+		if a.ContentType != "" {
+			settings = append(settings, gomail.SetHeader(map[string][]string{"Content-Type": {a.ContentType}}))
+		}
+		m.Attach(a.Name, settings...)
+	}
 
 	return m, nil
 }
@@ -22,6 +22,7 @@ package mailer
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 
 	"github.com/sendgrid/sendgrid-go"
@@ -65,6 +66,13 @@ func (s *SendGrid) Send(email *mailer.Mail) error {
 		// fmt.Printf("Sendgrid to mail: %s - %s \n", to.Name, to.Address)
 
 		message := mail.NewSingleEmail(from, email.Subject, to, email.ContentPlain, email.ContentHtml)
+		for _, a := range email.InlineAttachments {
+			att := mail.NewAttachment().SetFilename(a.Name).SetContent(base64.StdEncoding.EncodeToString(a.Data)).SetDisposition("attachment")
+			if a.ContentType != "" {
+				att.SetType(a.ContentType)
+			}
+			message.AddAttachment(att)
+		}
 		client := sendgrid.NewSendClient(s.ApiKey)
 		resp, err := client.Send(message)
 		if err != nil {
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/client/grpc"
+	"github.com/pydio/cells/v4/common/proto/jobs"
+)
+
+var (
+	jobsExportIds     []string
+	jobsExportFormat  string
+	jobsExportFile    string
+	jobsExportSecrets bool
+)
+
+var jobsExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export jobs definitions as JSON or YAML",
+	Long: `
+DESCRIPTION
+
+  Export one or more jobs definitions, or all custom jobs (jobs created by administrators) if no ID is passed.
+  The resulting document can be imported on another instance with the "import" command.
+
+  Tasks are not exported. Webhooks secrets are removed, unless the --secrets flag is set: they are then read
+  from the configuration vault.
+
+EXAMPLES
+
+  1. Export all custom jobs to a YAML file
+  $ ` + os.Args[0] + ` admin jobs export --format yaml --file jobs.yaml
+
+  2. Export a specific job to the standard output
+  $ ` + os.Args[0] + ` admin jobs export --id 0a7a5d8f-0b3c-4bd2-8a6b-f2c1e1c4c2e4
+
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format := strings.ToLower(jobsExportFormat)
+		if format == "" && jobsExportFile != "" {
+			format = strings.TrimPrefix(strings.ToLower(filepath.Ext(jobsExportFile)), ".")
+		}
+		if format == "" {
+			format = "json"
+		}
+
+		cli := jobs.NewJobServiceClient(grpc.GetClientConnFromCtx(ctx, common.ServiceJobs))
+		jj, e := listJobs(jobsCliContext(), cli, jobsExportIds)
+		if e != nil {
+			return e
+		}
+		data, e := jobs.NewJobsExport(jj, jobsExportSecrets).Marshal(format)
+		if e != nil {
+			return e
+		}
+		if jobsExportFile == "" {
+			cmd.Println(string(data))
+			return nil
+		}
+		if e := os.WriteFile(jobsExportFile, data, 0600); e != nil {
+			return e
+		}
+		cmd.Printf(promptui.IconGood+" Exported %d job(s) to %s\n", len(jj), jobsExportFile)
+		return nil
+	},
+}
+
+func init() {
+	jobsExportCmd.Flags().StringSliceVarP(&jobsExportIds, "id", "i", []string{}, "ID of the job(s) to export, all custom jobs if empty")
+	jobsExportCmd.Flags().StringVar(&jobsExportFormat, "format", "", "Export format, json or yaml (detected from file extension by default)")
+	jobsExportCmd.Flags().StringVarP(&jobsExportFile, "file", "f", "", "Write export to this file instead of standard output")
+	jobsExportCmd.Flags().BoolVar(&jobsExportSecrets, "secrets", false, "Keep webhooks secrets in the export")
+	JobsCmd.AddCommand(jobsExportCmd)
+}
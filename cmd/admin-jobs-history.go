@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/manifoldco/promptui"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/yudai/gojsondiff"
+	"github.com/yudai/gojsondiff/formatter"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/client/grpc"
+	"github.com/pydio/cells/v4/common/proto/jobs"
+	json "github.com/pydio/cells/v4/common/utils/jsonx"
+)
+
+var (
+	jobsHistoryId      string
+	jobsHistoryShow    string
+	jobsHistoryDiff    string
+	jobsHistoryRestore string
+)
+
+var jobsHistoryCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Show the history of a job definition",
+	Long: `
+DESCRIPTION
+
+  View the changes made to a job definition and revert to a given revision if necessary.
+
+  A revision is created each time the job definition is modified, along with an optional message and the
+  user originating the change. Only the most recent revisions are kept.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		if jobsHistoryId == "" {
+			log.Fatal("Please provide a job ID with the --id flag")
+		}
+		cliCtx := jobsCliContext()
+		cli := jobs.NewJobServiceClient(grpc.GetClientConnFromCtx(ctx, common.ServiceJobs))
+		resp, e := cli.ListJobRevisions(cliCtx, &jobs.ListJobRevisionsRequest{JobID: jobsHistoryId})
+		if e != nil {
+			log.Fatal("Cannot list revisions for this job: ", e)
+		}
+		revisions := resp.GetRevisions()
+		retrieve := func(s string) *jobs.JobRevision {
+			id, e := strconv.ParseInt(s, 10, 32)
+			if e != nil {
+				log.Fatal("Cannot parse revision Id")
+			}
+			for _, r := range revisions {
+				if r.Revision == int32(id) {
+					return r
+				}
+			}
+			log.Fatal("Cannot retrieve revision " + s)
+			return nil
+		}
+
+		if jobsHistoryShow != "" {
+			b, _ := json.MarshalIndent(retrieve(jobsHistoryShow).Job, "", "  ")
+			cmd.Println(string(b))
+			return
+		}
+
+		if jobsHistoryDiff != "" {
+			var previous, version *jobs.JobRevision
+			if strings.Contains(jobsHistoryDiff, ":") {
+				parts := strings.Split(jobsHistoryDiff, ":")
+				previous = retrieve(parts[0])
+				version = retrieve(parts[1])
+			} else {
+				version = retrieve(jobsHistoryDiff)
+				previous = retrieve(strconv.Itoa(int(version.Revision) - 1))
+			}
+
+			var prevData, lastData map[string]interface{}
+			bytesPrev, _ := json.Marshal(previous.Job)
+			bytesLast, _ := json.Marshal(version.Job)
+			_ = json.Unmarshal(bytesPrev, &prevData)
+			_ = json.Unmarshal(bytesLast, &lastData)
+			d, e := gojsondiff.New().Compare(bytesPrev, bytesLast)
+			if e != nil {
+				log.Fatal("Cannot diff revisions", e)
+			}
+			if !d.Modified() {
+				cmd.Println("No differences found between two revisions")
+				return
+			}
+			diffString, e := formatter.NewAsciiFormatter(prevData, formatter.AsciiFormatterConfig{
+				ShowArrayIndex: true,
+				Coloring:       true,
+			}).Format(d)
+			if e != nil {
+				log.Fatal("Cannot format diffs", e)
+			}
+			cmd.Print(diffString)
+			return
+		}
+
+		if jobsHistoryRestore != "" {
+			version := retrieve(jobsHistoryRestore)
+			prompt := promptui.Select{
+				Label: "This will override the job definition with a previous revision, are you sure you want to do that?",
+				Items: []string{"Yes", "No"},
+			}
+			index, _, _ := prompt.Run()
+			if index == 0 {
+				if _, e := cli.RestoreJobRevision(cliCtx, &jobs.RestoreJobRevisionRequest{JobID: jobsHistoryId, Revision: version.Revision}); e != nil {
+					log.Fatal("Cannot restore revision: ", e)
+				}
+				cmd.Println(promptui.IconGood + " Job restored to revision " + jobsHistoryRestore)
+			}
+			return
+		}
+
+		table := tablewriter.NewWriter(cmd.OutOrStdout())
+		table.SetHeader([]string{"Revision", "Date", "Author", "Label", "Message"})
+		table.SetAutoWrapText(false)
+
+		for _, r := range revisions {
+			table.Append([]string{
+				fmt.Sprintf("%d", r.Revision),
+				time.Unix(int64(r.Time), 0).Format("2006 Jan _2 15:04:05"),
+				r.Author,
+				r.GetJob().GetLabel(),
+				r.Message,
+			})
+		}
+
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+		table.Render()
+	},
+}
+
+func init() {
+	jobsHistoryCmd.Flags().StringVarP(&jobsHistoryId, "id", "i", "", "ID of the job")
+	jobsHistoryCmd.Flags().StringVar(&jobsHistoryDiff, "diff", "", "Display a Diff between two revisions, either by providing REV1:REV2 or just REV1 (will be compared to previous one)")
+	jobsHistoryCmd.Flags().StringVar(&jobsHistoryShow, "cat", "", "Print the JSON definition of the job for this revision")
+	jobsHistoryCmd.Flags().StringVar(&jobsHistoryRestore, "restore", "", "Restore job definition to this specific revision")
+	JobsCmd.AddCommand(jobsHistoryCmd)
+}
@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/client/grpc"
+	"github.com/pydio/cells/v4/common/proto/jobs"
+	"github.com/pydio/cells/v4/common/utils/uuid"
+)
+
+var (
+	jobsImportFile     string
+	jobsImportNewIds   bool
+	jobsImportMap      []string
+	jobsImportOwner    string
+	jobsImportInactive bool
+	jobsImportMessage  string
+)
+
+var jobsImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import jobs definitions exported from another instance",
+	Long: `
+DESCRIPTION
+
+  Import jobs definitions from a JSON or YAML document created by the "export" command.
+
+  By default, jobs keep their ID: a job that already exists with the same ID is replaced, and its previous
+  definition remains available in the job history. Use --new-ids to create copies with fresh IDs, or --map
+  to change specific IDs. References to remapped IDs inside actions parameters are updated as well.
+
+  Webhooks secrets are stored in the configuration vault. When a job is imported without its webhook secret
+  (i.e. exported without --secrets), an existing job with the same ID keeps its current secret.
+
+EXAMPLES
+
+  1. Promote jobs exported from a staging instance, keeping their IDs
+  $ ` + os.Args[0] + ` admin jobs import --file jobs.yaml --message "Promoted from staging"
+
+  2. Import jobs as new copies, disabled until reviewed
+  $ ` + os.Args[0] + ` admin jobs import --file jobs.json --new-ids --inactive
+
+  3. Import a job under another ID
+  $ ` + os.Args[0] + ` admin jobs import --file jobs.json --map staging-job-id=production-job-id
+
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if jobsImportFile == "" {
+			return fmt.Errorf("please provide the file to import with --file")
+		}
+		data, e := os.ReadFile(jobsImportFile)
+		if e != nil {
+			return e
+		}
+		export, e := jobs.UnmarshalJobsExport(data)
+		if e != nil {
+			return fmt.Errorf("cannot read jobs from %s: %s", jobsImportFile, e.Error())
+		}
+
+		mapping := make(map[string]string)
+		if jobsImportNewIds {
+			for _, j := range export.Jobs {
+				mapping[j.ID] = uuid.New()
+			}
+		}
+		for _, m := range jobsImportMap {
+			parts := strings.SplitN(m, "=", 2)
+			if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				return fmt.Errorf("invalid --map value %s, expected OLD=NEW", m)
+			}
+			mapping[parts[0]] = parts[1]
+		}
+		originals := make([]string, len(export.Jobs))
+		for i, j := range export.Jobs {
+			originals[i] = j.ID
+		}
+		export.RemapIDs(mapping)
+
+		cliCtx := jobsCliContext()
+		cli := jobs.NewJobServiceClient(grpc.GetClientConnFromCtx(ctx, common.ServiceJobs))
+		table := tablewriter.NewWriter(cmd.OutOrStdout())
+		table.SetHeader([]string{"Label", "Source ID", "Imported ID", "Status"})
+		table.SetAutoWrapText(false)
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+
+		var failed bool
+		for i, j := range export.Jobs {
+			if jobsImportOwner != "" {
+				j.Owner = jobsImportOwner
+			}
+			if jobsImportInactive {
+				j.Inactive = true
+			}
+			status := "Created"
+			if _, er := cli.GetJob(cliCtx, &jobs.GetJobRequest{JobID: j.ID}); er == nil {
+				status = "Updated"
+			}
+			message := jobsImportMessage
+			if message == "" {
+				message = "Imported from " + jobsImportFile
+			}
+			if _, er := cli.PutJob(cliCtx, &jobs.PutJobRequest{Job: j, RevisionMessage: message}); er != nil {
+				status = "Error: " + er.Error()
+				failed = true
+			}
+			table.Append([]string{j.Label, originals[i], j.ID, status})
+		}
+		table.Render()
+		if failed {
+			return fmt.Errorf("some jobs could not be imported")
+		}
+		cmd.Println(promptui.IconGood + fmt.Sprintf(" Imported %d job(s)", len(export.Jobs)))
+		return nil
+	},
+}
+
+func init() {
+	jobsImportCmd.Flags().StringVarP(&jobsImportFile, "file", "f", "", "JSON or YAML file created by the export command")
+	jobsImportCmd.Flags().BoolVar(&jobsImportNewIds, "new-ids", false, "Generate new IDs for all imported jobs")
+	jobsImportCmd.Flags().StringArrayVar(&jobsImportMap, "map", []string{}, "Replace a job ID by another one, as OLD=NEW (can be repeated)")
+	jobsImportCmd.Flags().StringVar(&jobsImportOwner, "owner", "", "Set this user as owner of the imported jobs")
+	jobsImportCmd.Flags().BoolVar(&jobsImportInactive, "inactive", false, "Disable imported jobs")
+	jobsImportCmd.Flags().StringVarP(&jobsImportMessage, "message", "m", "", "Message stored in the jobs history")
+	JobsCmd.AddCommand(jobsImportCmd)
+}
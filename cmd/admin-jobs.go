@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/proto/jobs"
+	"github.com/pydio/cells/v4/common/service/context/metadata"
+)
+
+var JobsCmd = &cobra.Command{
+	Use:   "jobs",
+	Short: "Manage scheduler jobs definitions",
+	Long: `
+DESCRIPTION
+
+  Export, import and review the history of scheduler jobs definitions. The server must be running when
+  launching these commands.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+func init() {
+	AdminCmd.AddCommand(JobsCmd)
+}
+
+/* Package protected utility methods that are used by the various jobs subcommands */
+
+// jobsCliContext flags changes made from the command line as such in the jobs revisions.
+func jobsCliContext() context.Context {
+	return metadata.WithUserNameMetadata(context.Background(), "cli")
+}
+
+// listJobs loads jobs by ID, or all custom jobs if ids is empty.
+func listJobs(ctx context.Context, cli jobs.JobServiceClient, ids []string) ([]*jobs.Job, error) {
+	stream, e := cli.ListJobs(ctx, &jobs.ListJobsRequest{JobIDs: ids})
+	if e != nil {
+		return nil, e
+	}
+	var jj []*jobs.Job
+	for {
+		resp, er := stream.Recv()
+		if er != nil {
+			break
+		}
+		if job := resp.GetJob(); job != nil && (len(ids) > 0 || job.Custom) {
+			jj = append(jj, job)
+		}
+	}
+	if len(ids) > 0 && len(jj) != len(ids) {
+		return nil, fmt.Errorf("cannot find all jobs %v in %s", ids, common.ServiceJobs)
+	}
+	return jj, nil
+}
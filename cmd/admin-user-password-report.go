@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/auth"
+	"github.com/pydio/cells/v4/common/client/grpc"
+	"github.com/pydio/cells/v4/common/proto/idm"
+	service "github.com/pydio/cells/v4/common/proto/service"
+)
+
+var userPasswordReportCmd = &cobra.Command{
+	Use:   "password-report",
+	Short: "Count users by password hashing scheme",
+	Long: `
+DESCRIPTION
+
+  Count users by the scheme used to hash their password.
+  New passwords are hashed with argon2id. Passwords stored with legacy schemes (PBKDF2, MD5) are
+  upgraded transparently at the next successful login of their owner: users that still appear
+  on legacy schemes have not logged in since.
+
+EXAMPLE
+
+  $ ` + os.Args[0] + ` admin user password-report
+
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client := idm.NewUserServiceClient(grpc.GetClientConnFromCtx(ctx, common.ServiceUser))
+
+		table := tablewriter.NewWriter(cmd.OutOrStdout())
+		table.SetHeader([]string{"Scheme", "Users", "Legacy"})
+		for _, scheme := range []string{auth.PasswordSchemeArgon2id, auth.PasswordSchemeBcrypt, auth.PasswordSchemePBKDF2, auth.PasswordSchemeMD5} {
+			query, _ := anypb.New(&idm.UserSingleQuery{
+				AttributeName:  idm.UserAttrPasswordScheme,
+				AttributeValue: scheme,
+			})
+			resp, err := client.CountUser(context.Background(), &idm.SearchUserRequest{
+				Query: &service.Query{SubQueries: []*anypb.Any{query}},
+			})
+			if err != nil {
+				return err
+			}
+			legacy := ""
+			if scheme == auth.PasswordSchemePBKDF2 || scheme == auth.PasswordSchemeMD5 {
+				legacy = "  X  "
+			}
+			table.Append([]string{scheme, fmt.Sprintf("%d", resp.Count), legacy})
+		}
+		cmd.Println(" ")
+		table.Render()
+		cmd.Println(" ")
+
+		return nil
+	},
+}
+
+func init() {
+	UserCmd.AddCommand(userPasswordReportCmd)
+}
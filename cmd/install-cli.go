@@ -152,7 +152,7 @@ func promptDB(c *install.InstallConfig) (adminRequired bool, err error) {
 
 	connType := p.Select{
 		Label: "Database Connection Type",
-		Items: []string{"TCP", "Socket", "Manual"},
+		Items: []string{"TCP", "Socket", "PostgreSQL (TCP)", "Manual"},
 	}
 	dbTcpHost := p.Prompt{Label: "Database Hostname", Validate: notEmpty, Default: c.DbTCPHostname, AllowEdit: true}
 	dbTcpPort := p.Prompt{Label: "Database Port", Validate: validPortNumber, Default: c.DbTCPPort, AllowEdit: true}
@@ -169,13 +169,20 @@ func promptDB(c *install.InstallConfig) (adminRequired bool, err error) {
 		return false, er
 	}
 	var e error
-	if uConnIdx == 2 {
+	if uConnIdx == 3 {
+		c.DbConnectionType = "manual"
 		if c.DbManualDSN, e = dbDSN.Run(); e != nil {
 			return false, e
 		}
 	} else {
-		if uConnIdx == 0 {
+		if uConnIdx == 0 || uConnIdx == 2 {
 			c.DbConnectionType = "tcp"
+			if uConnIdx == 2 {
+				c.DbConnectionType = "postgres"
+				if c.DbTCPPort == "" || c.DbTCPPort == "3306" {
+					dbTcpPort.Default = "5432"
+				}
+			}
 			if c.DbTCPHostname, e = dbTcpHost.Run(); e != nil {
 				return false, e
 			}
@@ -198,7 +205,7 @@ func promptDB(c *install.InstallConfig) (adminRequired bool, err error) {
 		if pass, e = dbPass.Run(); e != nil {
 			return false, e
 		}
-		if uConnIdx == 0 {
+		if uConnIdx == 0 || uConnIdx == 2 {
 			c.DbTCPName = name
 			c.DbTCPUser = user
 			c.DbTCPPassword = pass
@@ -82,7 +82,8 @@ func (b *BasicAuthenticator) Wrap(handler http.Handler) http.HandlerFunc {
 				return
 			}
 
-			// Otherwise continue in standard user/pass scheme
+			// Otherwise continue in standard user/pass scheme. Users having multi-factor authentication
+			// enabled are refused here, they must use a personal access token as password.
 			token, err := djv.PasswordCredentialsToken(ctx, user, pass)
 			if err != nil {
 				http.Error(w, err.Error(), http.StatusNotFound)
@@ -0,0 +1,195 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/client/grpc"
+	"github.com/pydio/cells/v4/common/proto/idm"
+	"github.com/pydio/cells/v4/common/service/errors"
+	"github.com/pydio/cells/v4/common/utils/permissions"
+)
+
+const (
+	mappingReservedRoles     = "Roles"
+	mappingReservedGroupPath = "GroupPath"
+)
+
+// unmarshalConnectorConfig decodes connector configuration passed as a structpb.Struct.
+func unmarshalConnectorConfig(data proto.Message, target interface{}) error {
+	s, ok := data.(*structpb.Struct)
+	if !ok || s == nil {
+		return fmt.Errorf("missing connector configuration")
+	}
+	bb, err := json.Marshal(s.AsMap())
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bb, target)
+}
+
+// mapExternalUser applies mapping rules to the values of an external user (directory entry, token claims)
+// to build an idm.User owned by the connector. GroupPath is left empty if no rule provides it.
+func mapExternalUser(connectorID, login string, rules []MappingRule, values func(name string) []string) *idm.User {
+	user := &idm.User{
+		Login: login,
+		Attributes: map[string]string{
+			idm.UserAttrAuthSource: connectorID,
+		},
+	}
+	for _, rule := range rules {
+		vv := applyMappingRule(rule, values(rule.LeftAttribute))
+		if len(vv) == 0 {
+			continue
+		}
+		switch rule.RightAttribute {
+		case mappingReservedRoles:
+			for _, v := range rule.AddPrefix(rule.RolePrefix, vv) {
+				user.Roles = append(user.Roles, &idm.Role{Uuid: connectorID + "_" + v, Label: v})
+			}
+		case mappingReservedGroupPath:
+			user.GroupPath = "/" + strings.Trim(vv[0], "/")
+		default:
+			user.Attributes[rule.RightAttribute] = vv[0]
+		}
+	}
+	return user
+}
+
+// applyMappingRule cleans DN values and filters them using the rule RuleString.
+func applyMappingRule(rule MappingRule, values []string) []string {
+	values = rule.SanitizeValues(values)
+	values = rule.RemoveLdapEscape(rule.ConvertDNtoName(values))
+	if rule.RuleString == "" {
+		return values
+	}
+	if strings.HasPrefix(rule.RuleString, "preg:") {
+		return rule.FilterPreg(rule.RuleString, values)
+	}
+	return rule.FilterList(rule.SanitizeValues(strings.Split(rule.RuleString, ",")), values)
+}
+
+// identityFromUser builds the Identity returned by connectors from the local user.
+func identityFromUser(user *idm.User) Identity {
+	var groups []string
+	for _, r := range user.GetRoles() {
+		if !r.GetUserRole() && !r.GetGroupRole() {
+			groups = append(groups, r.GetUuid())
+		}
+	}
+	return Identity{
+		UserID:        user.GetUuid(),
+		Username:      user.GetLogin(),
+		Email:         user.GetAttributes()[idm.UserAttrEmail],
+		EmailVerified: true,
+		Groups:        groups,
+	}
+}
+
+// syncExternalUser creates or updates the local user matching an external user. Roles prefixed with the
+// connector ID are replaced by the mapped ones, other roles are kept as is.
+func syncExternalUser(ctx context.Context, connectorID string, user *idm.User) (*idm.User, error) {
+	existing, err := permissions.SearchUniqueUser(ctx, user.Login, "")
+	if err != nil && errors.FromError(err).Code != http.StatusNotFound {
+		return nil, err
+	}
+	if existing != nil {
+		if existing.GetAttributes()[idm.UserAttrAuthSource] != connectorID {
+			return nil, errors.Forbidden("external.user.exists", "user %s already exists and is not managed by %s", user.Login, connectorID)
+		}
+		user.Uuid = existing.Uuid
+		if user.GroupPath == "" {
+			// No mapping rule matched: keep the group set by an administrator
+			user.GroupPath = existing.GroupPath
+		}
+		for k, v := range existing.GetAttributes() {
+			if _, ok := user.Attributes[k]; !ok {
+				user.Attributes[k] = v
+			}
+		}
+		var kept []*idm.Role
+		for _, r := range existing.GetRoles() {
+			if !strings.HasPrefix(r.GetUuid(), connectorID+"_") {
+				kept = append(kept, r)
+			}
+		}
+		user.Roles = append(kept, user.Roles...)
+	} else if _, ok := user.Attributes[idm.UserAttrProfile]; !ok {
+		// Profile is only defaulted at creation, so that a profile raised by an administrator is kept
+		user.Attributes[idm.UserAttrProfile] = common.PydioProfileStandard
+	}
+
+	if user.GroupPath == "" {
+		user.GroupPath = "/"
+	}
+
+	if err := ensureExternalRoles(ctx, connectorID, user.Roles); err != nil {
+		return nil, err
+	}
+
+	userClient := idm.NewUserServiceClient(grpc.GetClientConnFromCtx(ctx, common.ServiceUser))
+	resp, err := userClient.CreateUser(ctx, &idm.CreateUserRequest{User: user})
+	if err != nil {
+		return nil, err
+	}
+	permissions.ForceClearUserCache(user.Login)
+	return resp.GetUser(), nil
+}
+
+// ensureExternalRoles creates the roles managed by the connector that do not exist yet.
+func ensureExternalRoles(ctx context.Context, connectorID string, roles []*idm.Role) error {
+	var names []string
+	for _, r := range roles {
+		if strings.HasPrefix(r.GetUuid(), connectorID+"_") {
+			names = append(names, r.GetUuid())
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	found, err := permissions.GetRoles(ctx, names)
+	if err != nil {
+		return err
+	}
+	exists := make(map[string]bool, len(found))
+	for _, r := range found {
+		exists[r.GetUuid()] = true
+	}
+	roleClient := idm.NewRoleServiceClient(grpc.GetClientConnFromCtx(ctx, common.ServiceRole))
+	for _, r := range roles {
+		if !strings.HasPrefix(r.GetUuid(), connectorID+"_") || exists[r.GetUuid()] {
+			continue
+		}
+		if _, e := roleClient.CreateRole(ctx, &idm.CreateRoleRequest{Role: &idm.Role{Uuid: r.Uuid, Label: r.Label}}); e != nil {
+			return e
+		}
+	}
+	return nil
+}
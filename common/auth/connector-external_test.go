@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/client/grpc"
+	"github.com/pydio/cells/v4/common/proto/idm"
+	"github.com/pydio/cells/v4/common/utils/permissions"
+	"github.com/pydio/cells/v4/common/utils/uuid"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// usersMock stores users in memory, indexed by login
+type usersMock struct {
+	idm.UnimplementedUserServiceServer
+	users map[string]*idm.User
+}
+
+func (u *usersMock) CreateUser(ctx context.Context, req *idm.CreateUserRequest) (*idm.CreateUserResponse, error) {
+	user := req.GetUser()
+	if user.Uuid == "" {
+		user.Uuid = uuid.New()
+	}
+	u.users[user.Login] = user
+	return &idm.CreateUserResponse{User: user}, nil
+}
+
+func (u *usersMock) SearchUser(req *idm.SearchUserRequest, stream idm.UserService_SearchUserServer) error {
+	for _, sub := range req.GetQuery().GetSubQueries() {
+		q := &idm.UserSingleQuery{}
+		if e := sub.UnmarshalTo(q); e != nil {
+			return e
+		}
+		if user, ok := u.users[q.Login]; ok {
+			if e := stream.Send(&idm.SearchUserResponse{User: user}); e != nil {
+				return e
+			}
+		}
+	}
+	return nil
+}
+
+func TestSyncExternalUser(t *testing.T) {
+
+	mock := &usersMock{users: map[string]*idm.User{}}
+	grpc.RegisterMock(common.ServiceUser, &idm.UserServiceStub{UserServiceServer: mock})
+	ctx := context.Background()
+	groupRule := []MappingRule{{RuleName: "ou", LeftAttribute: "ou", RightAttribute: "GroupPath"}}
+
+	Convey("GroupPath is only mapped when a rule matches", t, func() {
+		u := mapExternalUser("ext", "john", groupRule, func(name string) []string { return nil })
+		So(u.GroupPath, ShouldBeEmpty)
+		u = mapExternalUser("ext", "john", groupRule, func(name string) []string { return []string{"sales"} })
+		So(u.GroupPath, ShouldEqual, "/sales")
+	})
+
+	Convey("New users without mapped group are created at the root", t, func() {
+		u := mapExternalUser("ext", "jane", nil, func(name string) []string { return nil })
+		created, e := syncExternalUser(ctx, "ext", u)
+		So(e, ShouldBeNil)
+		So(created.GroupPath, ShouldEqual, "/")
+		So(created.Attributes[idm.UserAttrProfile], ShouldEqual, common.PydioProfileStandard)
+	})
+
+	Convey("Profile raised by an administrator is kept", t, func() {
+		mock.users["carol"] = &idm.User{
+			Uuid:      "carol-uuid",
+			Login:     "carol",
+			GroupPath: "/",
+			Attributes: map[string]string{
+				idm.UserAttrAuthSource: "ext",
+				idm.UserAttrProfile:    common.PydioProfileAdmin,
+			},
+		}
+		permissions.ForceClearUserCache("carol")
+
+		synced, e := syncExternalUser(ctx, "ext", mapExternalUser("ext", "carol", nil, func(name string) []string { return nil }))
+		So(e, ShouldBeNil)
+		So(synced.Attributes[idm.UserAttrProfile], ShouldEqual, common.PydioProfileAdmin)
+		So(mock.users["carol"].Attributes[idm.UserAttrProfile], ShouldEqual, common.PydioProfileAdmin)
+	})
+
+	Convey("Group set by an administrator is kept when no rule matches", t, func() {
+		mock.users["bob"] = &idm.User{
+			Uuid:       "bob-uuid",
+			Login:      "bob",
+			GroupPath:  "/admins",
+			Attributes: map[string]string{idm.UserAttrAuthSource: "ext"},
+		}
+		permissions.ForceClearUserCache("bob")
+
+		synced, e := syncExternalUser(ctx, "ext", mapExternalUser("ext", "bob", groupRule, func(name string) []string { return nil }))
+		So(e, ShouldBeNil)
+		So(synced.Uuid, ShouldEqual, "bob-uuid")
+		So(synced.GroupPath, ShouldEqual, "/admins")
+
+		synced, e = syncExternalUser(ctx, "ext", mapExternalUser("ext", "bob", groupRule, func(name string) []string { return []string{"sales"} }))
+		So(e, ShouldBeNil)
+		So(synced.GroupPath, ShouldEqual, "/sales")
+	})
+
+	Convey("Users managed by another source are refused", t, func() {
+		mock.users["alice"] = &idm.User{Uuid: "alice-uuid", Login: "alice", GroupPath: "/"}
+		permissions.ForceClearUserCache("alice")
+		_, e := syncExternalUser(ctx, "ext", mapExternalUser("ext", "alice", nil, func(name string) []string { return nil }))
+		So(e, ShouldNotBeNil)
+	})
+}
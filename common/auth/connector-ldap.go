@@ -0,0 +1,243 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strings"
+
+	"github.com/go-ldap/ldap/v3"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pydio/cells/v4/common/log"
+	"github.com/pydio/cells/v4/common/proto/idm"
+	"github.com/pydio/cells/v4/common/service/errors"
+)
+
+const (
+	ldapConnectionNormal   = "normal"
+	ldapConnectionSSL      = "ssl"
+	ldapConnectionStartTLS = "starttls"
+)
+
+var (
+	_ PasswordConnector = (*ldapconnector)(nil)
+)
+
+func init() {
+	RegisterConnectorType("ldap", func(data proto.Message) (Opener, error) {
+		c := &ldapconfig{}
+		if err := unmarshalConnectorConfig(data, c); err != nil {
+			return nil, err
+		}
+		return c, nil
+	})
+}
+
+// ldapconfig is the configuration of an LDAP/AD connector, as stored in the connectors
+// list of the OAuth service configuration.
+type ldapconfig struct {
+	// Host is the host:port of the directory server
+	Host string
+	// Connection is one of "normal", "ssl" or "starttls"
+	Connection string
+	// SkipVerifyCertificate disables TLS certificate validation
+	SkipVerifyCertificate bool
+
+	// BindDN and BindPassword are used to look up the user entry. Leave empty for anonymous search.
+	BindDN       string
+	BindPassword string
+
+	// UserBaseDN is where users are searched
+	UserBaseDN string
+	// UserFilter restricts entries that can log in, default (objectClass=person)
+	UserFilter string
+	// LoginAttribute is matched against the login, e.g. uid or sAMAccountName
+	LoginAttribute string
+
+	// MappingRules transform LDAP attributes into user attributes, Roles and GroupPath
+	MappingRules []MappingRule
+}
+
+// ldapConn is the subset of *ldap.Conn used by the connector.
+type ldapConn interface {
+	Bind(username, password string) error
+	Search(searchRequest *ldap.SearchRequest) (*ldap.SearchResult, error)
+	Close()
+}
+
+func (c *ldapconfig) Open(id string, _ log.ZapLogger) (Connector, error) {
+	if c.Host == "" {
+		return nil, fmt.Errorf("ldap connector %s: missing Host", id)
+	}
+	if c.UserBaseDN == "" {
+		return nil, fmt.Errorf("ldap connector %s: missing UserBaseDN", id)
+	}
+	conf := *c
+	if conf.Connection == "" {
+		conf.Connection = ldapConnectionNormal
+	}
+	if conf.UserFilter == "" {
+		conf.UserFilter = "(objectClass=person)"
+	}
+	if conf.LoginAttribute == "" {
+		conf.LoginAttribute = "uid"
+	}
+	return &ldapconnector{
+		id:       id,
+		conf:     conf,
+		dial:     dialLdap,
+		syncUser: syncExternalUser,
+	}, nil
+}
+
+type ldapconnector struct {
+	id   string
+	conf ldapconfig
+
+	dial     func(conf ldapconfig) (ldapConn, error)
+	syncUser func(ctx context.Context, connectorID string, user *idm.User) (*idm.User, error)
+}
+
+func dialLdap(conf ldapconfig) (ldapConn, error) {
+	tlsConfig := &tls.Config{
+		ServerName:         strings.Split(conf.Host, ":")[0],
+		InsecureSkipVerify: conf.SkipVerifyCertificate,
+	}
+	switch conf.Connection {
+	case ldapConnectionSSL:
+		return ldap.DialTLS("tcp", conf.Host, tlsConfig)
+	case ldapConnectionStartTLS:
+		conn, err := ldap.Dial("tcp", conf.Host)
+		if err != nil {
+			return nil, err
+		}
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		return conn, nil
+	default:
+		return ldap.Dial("tcp", conf.Host)
+	}
+}
+
+func (l *ldapconnector) Prompt() string {
+	return "ldap"
+}
+
+// Login binds against the directory with the user DN, then maps the entry to a local
+// user that is created or updated in the user service.
+func (l *ldapconnector) Login(ctx context.Context, s Scopes, username, password string) (Identity, bool, error) {
+	if username == "" {
+		return Identity{}, false, errors.BadRequest("ldap.login", "empty username")
+	}
+
+	conn, err := l.dial(l.conf)
+	if err != nil {
+		return Identity{}, false, err
+	}
+	defer conn.Close()
+
+	entry, err := l.findEntry(conn, username)
+	if err != nil {
+		return Identity{}, false, err
+	}
+
+	// Never try an empty password, as most servers treat it as an anonymous bind
+	if password == "" {
+		return Identity{}, false, nil
+	}
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return Identity{}, false, nil
+		}
+		return Identity{}, false, err
+	}
+
+	user := l.mapEntry(entry, username)
+	local, err := l.syncUser(ctx, l.id, user)
+	if err != nil {
+		log.Logger(ctx).Error("ldap: cannot synchronize local user", zap.String("login", user.Login), zap.Error(err))
+		return Identity{}, false, err
+	}
+
+	return identityFromUser(local), true, nil
+}
+
+// findEntry looks up a unique entry matching the login.
+func (l *ldapconnector) findEntry(conn ldapConn, username string) (*ldap.Entry, error) {
+	if l.conf.BindDN != "" {
+		if err := conn.Bind(l.conf.BindDN, l.conf.BindPassword); err != nil {
+			return nil, fmt.Errorf("ldap: cannot bind service account: %v", err)
+		}
+	}
+
+	attributes := []string{"dn", l.conf.LoginAttribute}
+	for _, rule := range l.conf.MappingRules {
+		attributes = append(attributes, rule.LeftAttribute)
+	}
+	req := ldap.NewSearchRequest(
+		l.conf.UserBaseDN,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, 0, false,
+		fmt.Sprintf("(&%s(%s=%s))", l.conf.UserFilter, l.conf.LoginAttribute, ldap.EscapeFilter(username)),
+		attributes,
+		nil,
+	)
+	res, err := conn.Search(req)
+	if err != nil {
+		return nil, err
+	}
+	switch len(res.Entries) {
+	case 0:
+		return nil, errors.NotFound("ldap.user.not.found", "cannot find user %s in directory", username)
+	case 1:
+		return res.Entries[0], nil
+	default:
+		return nil, errors.Conflict("ldap.user.conflict", "found more than one entry for user %s", username)
+	}
+}
+
+// mapEntry applies the mapping rules to the directory entry to build an idm.User.
+func (l *ldapconnector) mapEntry(entry *ldap.Entry, username string) *idm.User {
+	login := username
+	if logins := ldapAttributeValues(entry, l.conf.LoginAttribute); len(logins) > 0 && strings.EqualFold(logins[0], username) {
+		login = logins[0]
+	}
+	return mapExternalUser(l.id, login, l.conf.MappingRules, func(name string) []string {
+		return ldapAttributeValues(entry, name)
+	})
+}
+
+func ldapAttributeValues(entry *ldap.Entry, name string) []string {
+	if strings.EqualFold(name, "dn") {
+		return []string{entry.DN}
+	}
+	for _, a := range entry.Attributes {
+		if strings.EqualFold(a.Name, name) {
+			return a.Values
+		}
+	}
+	return nil
+}
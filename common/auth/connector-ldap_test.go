@@ -0,0 +1,173 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-ldap/ldap/v3"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/pydio/cells/v4/common/proto/idm"
+)
+
+// mockDirectory is an in-memory LDAP stand-in
+type mockDirectory struct {
+	passwords map[string]string
+	entries   []*ldap.Entry
+	bound     string
+}
+
+func (m *mockDirectory) Bind(username, password string) error {
+	if p, ok := m.passwords[username]; ok && p == password {
+		m.bound = username
+		return nil
+	}
+	return ldap.NewError(ldap.LDAPResultInvalidCredentials, nil)
+}
+
+func (m *mockDirectory) Search(req *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	res := &ldap.SearchResult{}
+	for _, e := range m.entries {
+		if !strings.HasSuffix(e.DN, req.BaseDN) {
+			continue
+		}
+		if strings.Contains(req.Filter, "(uid="+e.GetAttributeValue("uid")+")") {
+			res.Entries = append(res.Entries, e)
+		}
+	}
+	return res, nil
+}
+
+func (m *mockDirectory) Close() {}
+
+func newMockLdapConnector(dir *mockDirectory, rules []MappingRule) (*ldapconnector, map[string]*idm.User) {
+	synced := make(map[string]*idm.User)
+	conf := &ldapconfig{
+		Host:         "localhost:389",
+		BindDN:       "cn=admin,dc=example,dc=com",
+		BindPassword: "admin",
+		UserBaseDN:   "ou=people,dc=example,dc=com",
+		MappingRules: rules,
+	}
+	c, _ := conf.Open("ldap-test", nil)
+	lc := c.(*ldapconnector)
+	lc.dial = func(ldapconfig) (ldapConn, error) {
+		return dir, nil
+	}
+	lc.syncUser = func(ctx context.Context, connectorID string, user *idm.User) (*idm.User, error) {
+		user.Uuid = "uuid-" + user.Login
+		synced[user.Login] = user
+		return user, nil
+	}
+	return lc, synced
+}
+
+func TestLdapConnector(t *testing.T) {
+
+	dir := &mockDirectory{
+		passwords: map[string]string{
+			"cn=admin,dc=example,dc=com":           "admin",
+			"uid=jdoe,ou=people,dc=example,dc=com": "secret",
+		},
+		entries: []*ldap.Entry{
+			ldap.NewEntry("uid=jdoe,ou=people,dc=example,dc=com", map[string][]string{
+				"uid":         {"jdoe"},
+				"displayName": {"John Doe"},
+				"mail":        {"jdoe@example.com"},
+				"ou":          {"sales"},
+				"memberOf": {
+					"cn=employees,ou=groups,dc=example,dc=com",
+					"cn=admins\\, eu,ou=groups,dc=example,dc=com",
+					"cn=guests,ou=groups,dc=example,dc=com",
+				},
+			}),
+		},
+	}
+	rules := []MappingRule{
+		{RuleName: "dn", LeftAttribute: "displayName", RightAttribute: "displayName"},
+		{RuleName: "mail", LeftAttribute: "mail", RightAttribute: "email"},
+		{RuleName: "ou", LeftAttribute: "ou", RightAttribute: "GroupPath"},
+		{RuleName: "groups", LeftAttribute: "memberOf", RightAttribute: "Roles", RuleString: "preg:^(employees|admins)", RolePrefix: "grp_"},
+	}
+
+	Convey("Test opener requires configuration", t, func() {
+		_, e := (&ldapconfig{}).Open("empty", nil)
+		So(e, ShouldNotBeNil)
+
+		data, _ := structpb.NewStruct(map[string]interface{}{
+			"Host":       "ldap.example.com:636",
+			"Connection": "ssl",
+			"UserBaseDN": "dc=example,dc=com",
+			"MappingRules": []interface{}{
+				map[string]interface{}{"LeftAttribute": "mail", "RightAttribute": "email"},
+			},
+		})
+		op, e := connectorTypes["ldap"](data)
+		So(e, ShouldBeNil)
+		c, e := op.Open("ldap", nil)
+		So(e, ShouldBeNil)
+		lc := c.(*ldapconnector)
+		So(lc.conf.Connection, ShouldEqual, ldapConnectionSSL)
+		So(lc.conf.LoginAttribute, ShouldEqual, "uid")
+		So(lc.conf.MappingRules, ShouldHaveLength, 1)
+	})
+
+	Convey("Test login and mapping", t, func() {
+		c, synced := newMockLdapConnector(dir, rules)
+
+		identity, valid, e := c.Login(context.Background(), Scopes{}, "jdoe", "secret")
+		So(e, ShouldBeNil)
+		So(valid, ShouldBeTrue)
+		So(identity.UserID, ShouldEqual, "uuid-jdoe")
+		So(identity.Email, ShouldEqual, "jdoe@example.com")
+		So(identity.Groups, ShouldResemble, []string{"ldap-test_grp_employees", "ldap-test_grp_admins\\, eu"})
+
+		u := synced["jdoe"]
+		So(u, ShouldNotBeNil)
+		So(u.GroupPath, ShouldEqual, "/sales")
+		So(u.Attributes[idm.UserAttrDisplayName], ShouldEqual, "John Doe")
+		So(u.Attributes[idm.UserAttrAuthSource], ShouldEqual, "ldap-test")
+		// Profile is defaulted by syncExternalUser for new users only
+		So(u.Attributes, ShouldNotContainKey, idm.UserAttrProfile)
+	})
+
+	Convey("Test wrong password and unknown user", t, func() {
+		c, synced := newMockLdapConnector(dir, rules)
+
+		_, valid, e := c.Login(context.Background(), Scopes{}, "jdoe", "wrong")
+		So(e, ShouldBeNil)
+		So(valid, ShouldBeFalse)
+
+		_, valid, e = c.Login(context.Background(), Scopes{}, "jdoe", "")
+		So(e, ShouldBeNil)
+		So(valid, ShouldBeFalse)
+
+		_, _, e = c.Login(context.Background(), Scopes{}, "unknown", "secret")
+		So(e, ShouldNotBeNil)
+
+		So(synced, ShouldBeEmpty)
+	})
+
+}
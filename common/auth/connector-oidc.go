@@ -0,0 +1,247 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/coreos/go-oidc"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pydio/cells/v4/common/log"
+	"github.com/pydio/cells/v4/common/proto/idm"
+	"github.com/pydio/cells/v4/common/service/errors"
+	"github.com/pydio/cells/v4/common/utils/net"
+)
+
+var (
+	_ CallbackConnector = (*oidcconnector)(nil)
+)
+
+func init() {
+	RegisterConnectorType("oidc", func(data proto.Message) (Opener, error) {
+		c := &oidcconfig{}
+		if err := unmarshalConnectorConfig(data, c); err != nil {
+			return nil, err
+		}
+		return c, nil
+	})
+}
+
+// oidcconfig is the configuration of an upstream OpenID Connect provider, as stored in the connectors
+// list of the OAuth service configuration.
+type oidcconfig struct {
+	// Issuer is the IdP issuer URL, used for discovery and tokens validation
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// RedirectURI overrides the callback URL computed from the incoming request
+	RedirectURI string
+	// Scopes requested to the IdP, default openid, profile and email
+	Scopes []string
+	// LoginClaim is used as the local login, default preferred_username (then email)
+	LoginClaim string
+
+	// MappingRules transform token claims into user attributes, Roles and GroupPath.
+	// Nested claims are accessed with a dotted path, e.g. realm_access.roles
+	MappingRules []MappingRule
+}
+
+func (c *oidcconfig) Open(id string, _ log.ZapLogger) (Connector, error) {
+	if c.Issuer == "" || c.ClientID == "" {
+		return nil, fmt.Errorf("oidc connector %s: missing Issuer or ClientID", id)
+	}
+	conf := *c
+	if len(conf.Scopes) == 0 {
+		conf.Scopes = []string{oidc.ScopeOpenID, "profile", "email"}
+	}
+	if conf.LoginClaim == "" {
+		conf.LoginClaim = "preferred_username"
+	}
+	return &oidcconnector{
+		id:       id,
+		conf:     conf,
+		syncUser: syncExternalUser,
+	}, nil
+}
+
+type oidcconnector struct {
+	id   string
+	conf oidcconfig
+
+	// Provider discovery is done lazily, so that an unreachable IdP does not prevent startup
+	mu       sync.Mutex
+	provider *oidc.Provider
+	verifier *oidc.IDTokenVerifier
+
+	syncUser func(ctx context.Context, connectorID string, user *idm.User) (*idm.User, error)
+}
+
+// ConnectorCallbackURL computes the URL where upstream providers redirect after login.
+func ConnectorCallbackURL(r *http.Request, connectorID string) string {
+	u := net.ExternalDomainFromRequest(r)
+	return u.String() + "/oidc/connectors/" + connectorID + "/callback"
+}
+
+func (o *oidcconnector) init(ctx context.Context) (*oidc.Provider, *oidc.IDTokenVerifier, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.provider != nil {
+		return o.provider, o.verifier, nil
+	}
+	p, err := oidc.NewProvider(ctx, o.conf.Issuer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("oidc: cannot discover provider %s: %v", o.conf.Issuer, err)
+	}
+	o.provider = p
+	o.verifier = p.Verifier(&oidc.Config{ClientID: o.conf.ClientID})
+	return o.provider, o.verifier, nil
+}
+
+func (o *oidcconnector) oauth2Config(p *oidc.Provider, callbackURL string) *oauth2.Config {
+	if o.conf.RedirectURI != "" {
+		callbackURL = o.conf.RedirectURI
+	}
+	return &oauth2.Config{
+		ClientID:     o.conf.ClientID,
+		ClientSecret: o.conf.ClientSecret,
+		Endpoint:     p.Endpoint(),
+		Scopes:       o.conf.Scopes,
+		RedirectURL:  callbackURL,
+	}
+}
+
+// LoginURL builds the IdP authorization URL. The state is also passed as nonce and checked on callback.
+func (o *oidcconnector) LoginURL(s Scopes, callbackURL, state string) (string, error) {
+	p, _, err := o.init(context.Background())
+	if err != nil {
+		return "", err
+	}
+	return o.oauth2Config(p, callbackURL).AuthCodeURL(state, oidc.Nonce(state)), nil
+}
+
+// HandleCallback exchanges the code, validates the ID token against the IdP keys and maps claims
+// to a local user that is created or updated in the user service.
+func (o *oidcconnector) HandleCallback(s Scopes, r *http.Request) (Identity, error) {
+	ctx := r.Context()
+	q := r.URL.Query()
+	if e := q.Get("error"); e != "" {
+		return Identity{}, errors.Unauthorized("oidc.callback", "%s: %s", e, q.Get("error_description"))
+	}
+	code := q.Get("code")
+	if code == "" {
+		return Identity{}, errors.BadRequest("oidc.callback", "missing code")
+	}
+
+	p, verifier, err := o.init(ctx)
+	if err != nil {
+		return Identity{}, err
+	}
+	token, err := o.oauth2Config(p, ConnectorCallbackURL(r, o.id)).Exchange(ctx, code)
+	if err != nil {
+		return Identity{}, fmt.Errorf("oidc: cannot exchange code: %v", err)
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return Identity{}, fmt.Errorf("oidc: no id_token in token response")
+	}
+	idToken, err := verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return Identity{}, errors.Unauthorized("oidc.callback", "invalid id_token: %v", err)
+	}
+	if idToken.Nonce != q.Get("state") {
+		return Identity{}, errors.Unauthorized("oidc.callback", "nonce does not match")
+	}
+
+	var claims map[string]interface{}
+	if err := idToken.Claims(&claims); err != nil {
+		return Identity{}, err
+	}
+	user, err := o.mapClaims(claims)
+	if err != nil {
+		return Identity{}, err
+	}
+	local, err := o.syncUser(ctx, o.id, user)
+	if err != nil {
+		log.Logger(ctx).Error("oidc: cannot synchronize local user", zap.String("login", user.Login), zap.Error(err))
+		return Identity{}, err
+	}
+	return identityFromUser(local), nil
+}
+
+// mapClaims finds the login and applies the mapping rules to the token claims.
+func (o *oidcconnector) mapClaims(claims map[string]interface{}) (*idm.User, error) {
+	var login string
+	for _, c := range []string{o.conf.LoginClaim, "email", "sub"} {
+		if vv := claimValues(claims, c); len(vv) > 0 && vv[0] != "" {
+			login = vv[0]
+			break
+		}
+	}
+	if login == "" {
+		return nil, errors.BadRequest("oidc.claims", "cannot find login in token claims")
+	}
+	user := mapExternalUser(o.id, login, o.conf.MappingRules, func(name string) []string {
+		return claimValues(claims, name)
+	})
+	if _, ok := user.Attributes[idm.UserAttrEmail]; !ok {
+		if vv := claimValues(claims, "email"); len(vv) > 0 {
+			user.Attributes[idm.UserAttrEmail] = vv[0]
+		}
+	}
+	return user, nil
+}
+
+// claimValues reads a claim as a list of strings, following dotted paths for nested claims.
+func claimValues(claims map[string]interface{}, name string) []string {
+	var current interface{} = claims
+	for _, part := range strings.Split(name, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		if current, ok = m[part]; !ok {
+			return nil
+		}
+	}
+	switch v := current.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var out []string
+		for _, i := range v {
+			out = append(out, fmt.Sprintf("%v", i))
+		}
+		return out
+	case nil:
+		return nil
+	default:
+		return []string{fmt.Sprintf("%v", v)}
+	}
+}
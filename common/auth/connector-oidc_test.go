@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/pydio/cells/v4/common/proto/idm"
+)
+
+// newMockIdP serves discovery, keys and token endpoints, and signs ID tokens with the given claims.
+func newMockIdP(t *testing.T, claims map[string]interface{}) *httptest.Server {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var srv *httptest.Server
+	m := http.NewServeMux()
+	m.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"issuer":                                srv.URL,
+			"authorization_endpoint":                srv.URL + "/auth",
+			"token_endpoint":                        srv.URL + "/token",
+			"jwks_uri":                              srv.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	m.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: &key.PublicKey, Algorithm: "RS256", Use: "sig"}}})
+	})
+	m.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		c := map[string]interface{}{
+			"iss": srv.URL,
+			"aud": "cells",
+			"iat": time.Now().Unix(),
+			"exp": time.Now().Add(time.Minute).Unix(),
+		}
+		for k, v := range claims {
+			c[k] = v
+		}
+		payload, _ := json.Marshal(c)
+		jws, _ := signer.Sign(payload)
+		raw, _ := jws.CompactSerialize()
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"id_token":     raw,
+		})
+	})
+	srv = httptest.NewServer(m)
+	return srv
+}
+
+func newMockOidcConnector(issuer string, rules []MappingRule) (*oidcconnector, map[string]*idm.User) {
+	synced := make(map[string]*idm.User)
+	conf := &oidcconfig{
+		Issuer:       issuer,
+		ClientID:     "cells",
+		ClientSecret: "secret",
+		MappingRules: rules,
+	}
+	c, _ := conf.Open("oidc-test", nil)
+	oc := c.(*oidcconnector)
+	oc.syncUser = func(ctx context.Context, connectorID string, user *idm.User) (*idm.User, error) {
+		user.Uuid = "uuid-" + user.Login
+		synced[user.Login] = user
+		return user, nil
+	}
+	return oc, synced
+}
+
+func TestOidcConnector(t *testing.T) {
+
+	claims := map[string]interface{}{
+		"sub":                "1234",
+		"nonce":              "challenge",
+		"preferred_username": "jdoe",
+		"email":              "jdoe@example.com",
+		"name":               "John Doe",
+		"realm_access": map[string]interface{}{
+			"roles": []string{"employees", "offline_access"},
+		},
+	}
+	rules := []MappingRule{
+		{RuleName: "name", LeftAttribute: "name", RightAttribute: "displayName"},
+		{RuleName: "roles", LeftAttribute: "realm_access.roles", RightAttribute: "Roles", RuleString: "employees,admins"},
+	}
+
+	Convey("Test claims mapping", t, func() {
+		c, _ := newMockOidcConnector("http://localhost", rules)
+		So(claimValues(claims, "realm_access.roles"), ShouldResemble, []string{"employees", "offline_access"})
+		So(claimValues(claims, "realm_access.missing"), ShouldBeNil)
+
+		u, e := c.mapClaims(claims)
+		So(e, ShouldBeNil)
+		So(u.Login, ShouldEqual, "jdoe")
+		So(u.Attributes[idm.UserAttrEmail], ShouldEqual, "jdoe@example.com")
+		So(u.Attributes[idm.UserAttrDisplayName], ShouldEqual, "John Doe")
+		So(u.Roles, ShouldHaveLength, 1)
+		So(u.Roles[0].Uuid, ShouldEqual, "oidc-test_employees")
+
+		_, e = c.mapClaims(map[string]interface{}{})
+		So(e, ShouldNotBeNil)
+	})
+
+	Convey("Test login redirect and callback", t, func() {
+		idp := newMockIdP(t, claims)
+		defer idp.Close()
+		c, synced := newMockOidcConnector(idp.URL, rules)
+
+		loginURL, e := c.LoginURL(Scopes{}, "http://cells.local/oidc/connectors/oidc-test/callback", "challenge")
+		So(e, ShouldBeNil)
+		u, _ := url.Parse(loginURL)
+		So(u.Path, ShouldEqual, "/auth")
+		So(u.Query().Get("state"), ShouldEqual, "challenge")
+		So(u.Query().Get("nonce"), ShouldEqual, "challenge")
+		So(u.Query().Get("client_id"), ShouldEqual, "cells")
+
+		r := httptest.NewRequest(http.MethodGet, "http://cells.local/connectors/oidc-test/callback?code=abc&state=challenge", nil)
+		identity, e := c.HandleCallback(Scopes{}, r)
+		So(e, ShouldBeNil)
+		So(identity.UserID, ShouldEqual, "uuid-jdoe")
+		So(identity.Groups, ShouldResemble, []string{"oidc-test_employees"})
+		So(synced["jdoe"], ShouldNotBeNil)
+
+		r = httptest.NewRequest(http.MethodGet, "http://cells.local/connectors/oidc-test/callback?code=abc&state=other", nil)
+		_, e = c.HandleCallback(Scopes{}, r)
+		So(e, ShouldNotBeNil)
+
+		r = httptest.NewRequest(http.MethodGet, "http://cells.local/connectors/oidc-test/callback?error=access_denied&state=challenge", nil)
+		_, e = c.HandleCallback(Scopes{}, r)
+		So(e, ShouldNotBeNil)
+	})
+
+}
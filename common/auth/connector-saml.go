@@ -0,0 +1,504 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pydio/cells/v4/common/log"
+	"github.com/pydio/cells/v4/common/proto/idm"
+	"github.com/pydio/cells/v4/common/service/errors"
+)
+
+const (
+	samlProtocolNS  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	samlAssertionNS = "urn:oasis:names:tc:SAML:2.0:assertion"
+
+	samlBindingPOST               = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	samlStatusSuccess             = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	samlSubjectConfirmationBearer = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+
+	samlAllowedClockDrift = 30 * time.Second
+)
+
+var (
+	_ SAMLConnector         = (*samlconnector)(nil)
+	_ SAMLMetadataConnector = (*samlconnector)(nil)
+
+	samlNameIDFormats = map[string]string{
+		"persistent":   "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent",
+		"transient":    "urn:oasis:names:tc:SAML:2.0:nameid-format:transient",
+		"emailAddress": "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress",
+		"unspecified":  "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified",
+	}
+)
+
+// SAMLMetadataConnector is implemented by SAML connectors that can publish their service provider metadata.
+type SAMLMetadataConnector interface {
+	Metadata() ([]byte, error)
+}
+
+func init() {
+	RegisterConnectorType("saml", func(data proto.Message) (Opener, error) {
+		c := &samlconfig{}
+		if err := unmarshalConnectorConfig(data, c); err != nil {
+			return nil, err
+		}
+		return c, nil
+	})
+}
+
+// samlconfig is the configuration of a SAML 2.0 service provider, as stored in the connectors
+// list of the OAuth service configuration.
+type samlconfig struct {
+	// SSOURL is the IdP endpoint receiving AuthnRequests with the HTTP-POST binding
+	SSOURL string
+	// CA is a path to the PEM encoded IdP signing certificate(s), CAData its content
+	CA     string
+	CAData string
+
+	// RedirectURI is the assertion consumer service, i.e. https://<host>/oidc/connectors/<id>/callback
+	RedirectURI string
+	// EntityIssuer is the SP entity ID, default to the RedirectURI. It is checked against assertions audience.
+	EntityIssuer string
+	// SSOIssuer is the expected IdP issuer, checked if not empty
+	SSOIssuer string
+	// NameIDPolicyFormat is one of persistent, transient, emailAddress, unspecified or a full URN
+	NameIDPolicyFormat string
+
+	// UsernameAttr is the attribute used as login, default to the subject NameID
+	UsernameAttr string
+	// EmailAttr is the attribute used as email
+	EmailAttr string
+
+	// MappingRules transform assertion attributes into user attributes, Roles and GroupPath
+	MappingRules []MappingRule
+}
+
+func (c *samlconfig) Open(id string, _ log.ZapLogger) (Connector, error) {
+	if c.SSOURL == "" || c.RedirectURI == "" {
+		return nil, fmt.Errorf("saml connector %s: missing SSOURL or RedirectURI", id)
+	}
+	conf := *c
+	if conf.EntityIssuer == "" {
+		conf.EntityIssuer = conf.RedirectURI
+	}
+	if f, ok := samlNameIDFormats[conf.NameIDPolicyFormat]; ok {
+		conf.NameIDPolicyFormat = f
+	} else if conf.NameIDPolicyFormat == "" {
+		conf.NameIDPolicyFormat = samlNameIDFormats["persistent"]
+	}
+
+	caData := []byte(conf.CAData)
+	if conf.CA != "" {
+		bb, err := os.ReadFile(conf.CA)
+		if err != nil {
+			return nil, fmt.Errorf("saml connector %s: cannot read CA: %v", id, err)
+		}
+		caData = append(caData, bb...)
+	}
+	var certs []*x509.Certificate
+	for block, rest := pem.Decode(caData); block != nil; block, rest = pem.Decode(rest) {
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("saml connector %s: cannot parse CA: %v", id, err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("saml connector %s: no IdP certificate found in CA or CAData", id)
+	}
+
+	return &samlconnector{
+		id:       id,
+		conf:     conf,
+		certs:    certs,
+		now:      time.Now,
+		syncUser: syncExternalUser,
+	}, nil
+}
+
+type samlconnector struct {
+	id    string
+	conf  samlconfig
+	certs []*x509.Certificate
+
+	now      func() time.Time
+	syncUser func(ctx context.Context, connectorID string, user *idm.User) (*idm.User, error)
+}
+
+// POSTData builds a base64 encoded AuthnRequest for the HTTP-POST binding.
+func (sc *samlconnector) POSTData(s Scopes, requestID string) (ssoURL, samlRequest string, err error) {
+	req := &samlAuthnRequest{
+		ID:                          requestID,
+		Version:                     "2.0",
+		IssueInstant:                sc.now().UTC(),
+		Destination:                 sc.conf.SSOURL,
+		ProtocolBinding:             samlBindingPOST,
+		AssertionConsumerServiceURL: sc.conf.RedirectURI,
+		Issuer:                      &samlIssuer{Issuer: sc.conf.EntityIssuer},
+		NameIDPolicy: &samlNameIDPolicy{
+			AllowCreate: true,
+			Format:      sc.conf.NameIDPolicyFormat,
+		},
+	}
+	data, err := xml.Marshal(req)
+	if err != nil {
+		return "", "", err
+	}
+	return sc.conf.SSOURL, base64.StdEncoding.EncodeToString(data), nil
+}
+
+// HandlePOST validates the response signature, its conditions and maps attributes to a local user
+// that is created or updated in the user service.
+func (sc *samlconnector) HandlePOST(ctx context.Context, s Scopes, samlResponse, inResponseTo string) (Identity, error) {
+	resp, err := sc.validateResponse(samlResponse, inResponseTo)
+	if err != nil {
+		return Identity{}, err
+	}
+	user, err := sc.mapAssertion(resp.Assertion)
+	if err != nil {
+		return Identity{}, err
+	}
+	local, err := sc.syncUser(ctx, sc.id, user)
+	if err != nil {
+		log.Logger(ctx).Error("saml: cannot synchronize local user", zap.String("login", user.Login), zap.Error(err))
+		return Identity{}, err
+	}
+	return identityFromUser(local), nil
+}
+
+// Metadata describes the connector as a service provider, to be imported in the IdP.
+func (sc *samlconnector) Metadata() ([]byte, error) {
+	md := &samlEntityDescriptor{
+		EntityID: sc.conf.EntityIssuer,
+		SPSSODescriptor: samlSPSSODescriptor{
+			WantAssertionsSigned:       true,
+			ProtocolSupportEnumeration: samlProtocolNS,
+			NameIDFormat:               sc.conf.NameIDPolicyFormat,
+			AssertionConsumerService: samlIndexedEndpoint{
+				Binding:  samlBindingPOST,
+				Location: sc.conf.RedirectURI,
+				Index:    1,
+			},
+		},
+	}
+	data, err := xml.MarshalIndent(md, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+// validateResponse checks the signature of the response or of its assertion, then the response content.
+func (sc *samlconnector) validateResponse(encoded, inResponseTo string) (*samlResponse, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.BadRequest("saml.response", "cannot decode response: %v", err)
+	}
+	signed, err := sc.verifySignature(raw)
+	if err != nil {
+		return nil, errors.Unauthorized("saml.signature", "invalid signature: %v", err)
+	}
+	resp := &samlResponse{}
+	if err := xml.Unmarshal(signed, resp); err != nil {
+		return nil, errors.BadRequest("saml.response", "cannot parse response: %v", err)
+	}
+
+	if resp.Destination != "" && resp.Destination != sc.conf.RedirectURI {
+		return nil, errors.Unauthorized("saml.response", "unexpected destination %s", resp.Destination)
+	}
+	if inResponseTo != "" && resp.InResponseTo != inResponseTo {
+		return nil, errors.Unauthorized("saml.response", "response does not match request")
+	}
+	if resp.Status == nil || resp.Status.StatusCode.Value != samlStatusSuccess {
+		var msg string
+		if resp.Status != nil {
+			msg = resp.Status.StatusCode.Value + " " + resp.Status.StatusMessage
+		}
+		return nil, errors.Unauthorized("saml.response", "authentication failed: %s", strings.TrimSpace(msg))
+	}
+	a := resp.Assertion
+	if a == nil {
+		return nil, errors.Unauthorized("saml.response", "response has no assertion")
+	}
+	if sc.conf.SSOIssuer != "" {
+		if a.Issuer == nil || a.Issuer.Issuer != sc.conf.SSOIssuer {
+			return nil, errors.Unauthorized("saml.response", "unexpected issuer")
+		}
+	}
+
+	now := sc.now()
+	if c := a.Conditions; c != nil {
+		if !c.NotBefore.IsZero() && now.Add(samlAllowedClockDrift).Before(c.NotBefore) {
+			return nil, errors.Unauthorized("saml.response", "assertion is not valid yet")
+		}
+		if !c.NotOnOrAfter.IsZero() && !now.Add(-samlAllowedClockDrift).Before(c.NotOnOrAfter) {
+			return nil, errors.Unauthorized("saml.response", "assertion has expired")
+		}
+		for _, r := range c.AudienceRestriction {
+			var found bool
+			for _, aud := range r.Audiences {
+				if aud.Value == sc.conf.EntityIssuer {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, errors.Unauthorized("saml.response", "assertion audience does not include %s", sc.conf.EntityIssuer)
+			}
+		}
+	}
+
+	if a.Subject == nil || a.Subject.NameID == nil || a.Subject.NameID.Value == "" {
+		return nil, errors.Unauthorized("saml.response", "assertion has no subject")
+	}
+	var bearer bool
+	for _, sub := range a.Subject.SubjectConfirmations {
+		if sub.Method != samlSubjectConfirmationBearer || sub.SubjectConfirmationData == nil {
+			continue
+		}
+		d := sub.SubjectConfirmationData
+		if !d.NotOnOrAfter.IsZero() && !now.Add(-samlAllowedClockDrift).Before(d.NotOnOrAfter) {
+			continue
+		}
+		if d.Recipient != "" && d.Recipient != sc.conf.RedirectURI {
+			continue
+		}
+		if inResponseTo != "" && d.InResponseTo != "" && d.InResponseTo != inResponseTo {
+			continue
+		}
+		bearer = true
+		break
+	}
+	if !bearer {
+		return nil, errors.Unauthorized("saml.response", "no valid bearer subject confirmation")
+	}
+	return resp, nil
+}
+
+// verifySignature validates either the whole response or its single assertion, and returns the
+// document rebuilt from the validated elements only.
+func (sc *samlconnector) verifySignature(raw []byte) ([]byte, error) {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(raw); err != nil {
+		return nil, err
+	}
+	response := doc.Root()
+	if response == nil {
+		return nil, fmt.Errorf("empty document")
+	}
+	if len(response.SelectElements("EncryptedAssertion")) > 0 {
+		return nil, fmt.Errorf("encrypted assertions are not supported")
+	}
+	if len(response.SelectElements("Assertion")) != 1 {
+		return nil, fmt.Errorf("response must contain exactly one assertion")
+	}
+
+	validator := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{Roots: sc.certs})
+	if transformed, err := validator.Validate(response); err == nil {
+		doc.SetRoot(transformed)
+		return doc.WriteToBytes()
+	}
+
+	// Response is not signed, the assertion must be
+	assertion, err := etreeutils.NSSelectOne(response, samlAssertionNS, "Assertion")
+	if err != nil {
+		return nil, err
+	}
+	if assertion == nil {
+		return nil, fmt.Errorf("response has no assertion")
+	}
+	transformed, err := validator.Validate(assertion)
+	if err != nil {
+		return nil, err
+	}
+	response.RemoveChild(assertion)
+	response.AddChild(transformed)
+	return doc.WriteToBytes()
+}
+
+// mapAssertion finds the login and applies the mapping rules to the assertion attributes.
+func (sc *samlconnector) mapAssertion(a *samlAssertion) (*idm.User, error) {
+	values := func(name string) []string {
+		if a.AttributeStatement == nil {
+			return nil
+		}
+		for _, attr := range a.AttributeStatement.Attributes {
+			if attr.Name == name || (attr.FriendlyName != "" && attr.FriendlyName == name) {
+				var vv []string
+				for _, v := range attr.AttributeValues {
+					vv = append(vv, strings.TrimSpace(v.Value))
+				}
+				return vv
+			}
+		}
+		return nil
+	}
+
+	login := a.Subject.NameID.Value
+	if sc.conf.UsernameAttr != "" {
+		vv := values(sc.conf.UsernameAttr)
+		if len(vv) == 0 || vv[0] == "" {
+			return nil, errors.BadRequest("saml.attributes", "missing attribute %s", sc.conf.UsernameAttr)
+		}
+		login = vv[0]
+	}
+	user := mapExternalUser(sc.id, login, sc.conf.MappingRules, values)
+	if _, ok := user.Attributes[idm.UserAttrEmail]; !ok && sc.conf.EmailAttr != "" {
+		if vv := values(sc.conf.EmailAttr); len(vv) > 0 {
+			user.Attributes[idm.UserAttrEmail] = vv[0]
+		}
+	}
+	return user, nil
+}
+
+type samlIssuer struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+	Issuer  string   `xml:",chardata"`
+}
+
+type samlNameIDPolicy struct {
+	XMLName     xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol NameIDPolicy"`
+	AllowCreate bool     `xml:"AllowCreate,attr,omitempty"`
+	Format      string   `xml:"Format,attr,omitempty"`
+}
+
+type samlAuthnRequest struct {
+	XMLName                     xml.Name          `xml:"urn:oasis:names:tc:SAML:2.0:protocol AuthnRequest"`
+	ID                          string            `xml:"ID,attr"`
+	Version                     string            `xml:"Version,attr"`
+	IssueInstant                time.Time         `xml:"IssueInstant,attr"`
+	Destination                 string            `xml:"Destination,attr,omitempty"`
+	ProtocolBinding             string            `xml:"ProtocolBinding,attr,omitempty"`
+	AssertionConsumerServiceURL string            `xml:"AssertionConsumerServiceURL,attr,omitempty"`
+	Issuer                      *samlIssuer       `xml:"Issuer,omitempty"`
+	NameIDPolicy                *samlNameIDPolicy `xml:"NameIDPolicy,omitempty"`
+}
+
+type samlResponse struct {
+	XMLName      xml.Name       `xml:"urn:oasis:names:tc:SAML:2.0:protocol Response"`
+	ID           string         `xml:"ID,attr"`
+	InResponseTo string         `xml:"InResponseTo,attr"`
+	Destination  string         `xml:"Destination,attr"`
+	Issuer       *samlIssuer    `xml:"Issuer"`
+	Status       *samlStatus    `xml:"Status"`
+	Assertion    *samlAssertion `xml:"Assertion"`
+}
+
+type samlStatus struct {
+	StatusCode    samlStatusCode `xml:"StatusCode"`
+	StatusMessage string         `xml:"StatusMessage"`
+}
+
+type samlStatusCode struct {
+	Value string `xml:"Value,attr"`
+}
+
+type samlAssertion struct {
+	ID                 string                  `xml:"ID,attr"`
+	Issuer             *samlIssuer             `xml:"Issuer"`
+	Subject            *samlSubject            `xml:"Subject"`
+	Conditions         *samlConditions         `xml:"Conditions"`
+	AttributeStatement *samlAttributeStatement `xml:"AttributeStatement"`
+}
+
+type samlSubject struct {
+	NameID               *samlNameID               `xml:"NameID"`
+	SubjectConfirmations []samlSubjectConfirmation `xml:"SubjectConfirmation"`
+}
+
+type samlNameID struct {
+	Format string `xml:"Format,attr"`
+	Value  string `xml:",chardata"`
+}
+
+type samlSubjectConfirmation struct {
+	Method                  string                       `xml:"Method,attr"`
+	SubjectConfirmationData *samlSubjectConfirmationData `xml:"SubjectConfirmationData"`
+}
+
+type samlSubjectConfirmationData struct {
+	NotOnOrAfter time.Time `xml:"NotOnOrAfter,attr"`
+	Recipient    string    `xml:"Recipient,attr"`
+	InResponseTo string    `xml:"InResponseTo,attr"`
+}
+
+type samlConditions struct {
+	NotBefore           time.Time                 `xml:"NotBefore,attr"`
+	NotOnOrAfter        time.Time                 `xml:"NotOnOrAfter,attr"`
+	AudienceRestriction []samlAudienceRestriction `xml:"AudienceRestriction"`
+}
+
+type samlAudienceRestriction struct {
+	Audiences []samlAudience `xml:"Audience"`
+}
+
+type samlAudience struct {
+	Value string `xml:",chardata"`
+}
+
+type samlAttributeStatement struct {
+	Attributes []samlAttribute `xml:"Attribute"`
+}
+
+type samlAttribute struct {
+	Name            string               `xml:"Name,attr"`
+	FriendlyName    string               `xml:"FriendlyName,attr"`
+	AttributeValues []samlAttributeValue `xml:"AttributeValue"`
+}
+
+type samlAttributeValue struct {
+	Value string `xml:",chardata"`
+}
+
+type samlEntityDescriptor struct {
+	XMLName         xml.Name            `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID        string              `xml:"entityID,attr"`
+	SPSSODescriptor samlSPSSODescriptor `xml:"SPSSODescriptor"`
+}
+
+type samlSPSSODescriptor struct {
+	WantAssertionsSigned       bool                `xml:"WantAssertionsSigned,attr"`
+	ProtocolSupportEnumeration string              `xml:"protocolSupportEnumeration,attr"`
+	NameIDFormat               string              `xml:"NameIDFormat"`
+	AssertionConsumerService   samlIndexedEndpoint `xml:"AssertionConsumerService"`
+}
+
+type samlIndexedEndpoint struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+	Index    int    `xml:"index,attr"`
+}
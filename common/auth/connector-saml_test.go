@@ -0,0 +1,188 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/v4/common/proto/idm"
+)
+
+const (
+	samlTestACS    = "https://cells.local/oidc/connectors/saml-test/callback"
+	samlTestIssuer = "https://idp.example.com"
+)
+
+const samlTestResponse = `<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_resp1" Version="2.0" IssueInstant="%[1]s" Destination="%[3]s" InResponseTo="%[4]s">
+<saml:Issuer>%[5]s</saml:Issuer>
+<samlp:Status><samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Success"/></samlp:Status>
+<saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_assert1" Version="2.0" IssueInstant="%[1]s">
+<saml:Issuer>%[5]s</saml:Issuer>
+<saml:Subject>
+<saml:NameID Format="urn:oasis:names:tc:SAML:2.0:nameid-format:persistent">jdoe</saml:NameID>
+<saml:SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer"><saml:SubjectConfirmationData NotOnOrAfter="%[2]s" Recipient="%[3]s" InResponseTo="%[4]s"/></saml:SubjectConfirmation>
+</saml:Subject>
+<saml:Conditions NotBefore="%[1]s" NotOnOrAfter="%[2]s"><saml:AudienceRestriction><saml:Audience>%[3]s</saml:Audience></saml:AudienceRestriction></saml:Conditions>
+<saml:AttributeStatement>
+<saml:Attribute Name="urn:oid:0.9.2342.19200300.100.1.3" FriendlyName="mail"><saml:AttributeValue>jdoe@example.com</saml:AttributeValue></saml:Attribute>
+<saml:Attribute Name="groups"><saml:AttributeValue>employees</saml:AttributeValue><saml:AttributeValue>guests</saml:AttributeValue></saml:Attribute>
+</saml:AttributeStatement>
+</saml:Assertion>
+</samlp:Response>`
+
+func newSamlTestCert(t *testing.T) (tls.Certificate, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, tpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+// signedSamlResponse builds a response to requestID, with its assertion signed by cert.
+func signedSamlResponse(t *testing.T, cert tls.Certificate, requestID string, tamper bool) string {
+	now := time.Now().UTC()
+	raw := fmt.Sprintf(samlTestResponse, now.Format(time.RFC3339), now.Add(5*time.Minute).Format(time.RFC3339), samlTestACS, requestID, samlTestIssuer)
+	doc := etree.NewDocument()
+	if err := doc.ReadFromString(raw); err != nil {
+		t.Fatal(err)
+	}
+	assertion := doc.Root().SelectElement("Assertion")
+	ctx := dsig.NewDefaultSigningContext(dsig.TLSCertKeyStore(cert))
+	ctx.Canonicalizer = dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+	signed, err := ctx.SignEnveloped(assertion)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc.Root().RemoveChild(assertion)
+	doc.Root().AddChild(signed)
+	if tamper {
+		signed.FindElement(".//NameID").SetText("admin")
+	}
+	out, _ := doc.WriteToBytes()
+	return base64.StdEncoding.EncodeToString(out)
+}
+
+func TestSamlConnector(t *testing.T) {
+
+	cert, certPEM := newSamlTestCert(t)
+	synced := make(map[string]*idm.User)
+	conf := &samlconfig{
+		SSOURL:       samlTestIssuer + "/sso",
+		CAData:       certPEM,
+		RedirectURI:  samlTestACS,
+		SSOIssuer:    samlTestIssuer,
+		EmailAttr:    "mail",
+		MappingRules: []MappingRule{{RuleName: "groups", LeftAttribute: "groups", RightAttribute: "Roles", RuleString: "employees"}},
+	}
+
+	Convey("Test configuration", t, func() {
+		_, e := (&samlconfig{SSOURL: conf.SSOURL, RedirectURI: samlTestACS}).Open("saml-test", nil)
+		So(e, ShouldNotBeNil)
+		_, e = (&samlconfig{CAData: certPEM, RedirectURI: samlTestACS}).Open("saml-test", nil)
+		So(e, ShouldNotBeNil)
+	})
+
+	c, e := conf.Open("saml-test", nil)
+	if e != nil {
+		t.Fatal(e)
+	}
+	sc := c.(*samlconnector)
+	sc.syncUser = func(ctx context.Context, connectorID string, user *idm.User) (*idm.User, error) {
+		user.Uuid = "uuid-" + user.Login
+		synced[user.Login] = user
+		return user, nil
+	}
+
+	Convey("Test AuthnRequest and metadata", t, func() {
+		ssoURL, req, e := sc.POSTData(Scopes{}, "_request1")
+		So(e, ShouldBeNil)
+		So(ssoURL, ShouldEqual, conf.SSOURL)
+		data, e := base64.StdEncoding.DecodeString(req)
+		So(e, ShouldBeNil)
+		So(string(data), ShouldContainSubstring, `ID="_request1"`)
+		So(string(data), ShouldContainSubstring, `AssertionConsumerServiceURL="`+samlTestACS+`"`)
+		So(string(data), ShouldContainSubstring, samlNameIDFormats["persistent"])
+
+		md, e := sc.Metadata()
+		So(e, ShouldBeNil)
+		So(string(md), ShouldContainSubstring, `entityID="`+samlTestACS+`"`)
+		So(string(md), ShouldContainSubstring, `Location="`+samlTestACS+`"`)
+	})
+
+	Convey("Test signed assertion", t, func() {
+		identity, e := sc.HandlePOST(context.Background(), Scopes{}, signedSamlResponse(t, cert, "_request1", false), "_request1")
+		So(e, ShouldBeNil)
+		So(identity.UserID, ShouldEqual, "uuid-jdoe")
+		So(identity.Email, ShouldEqual, "jdoe@example.com")
+		So(identity.Groups, ShouldResemble, []string{"saml-test_employees"})
+		So(synced["jdoe"], ShouldNotBeNil)
+	})
+
+	Convey("Test invalid responses", t, func() {
+		_, e := sc.HandlePOST(context.Background(), Scopes{}, signedSamlResponse(t, cert, "_request1", false), "_request2")
+		So(e, ShouldNotBeNil)
+
+		_, e = sc.HandlePOST(context.Background(), Scopes{}, signedSamlResponse(t, cert, "_request1", true), "_request1")
+		So(e, ShouldNotBeNil)
+
+		other, _ := newSamlTestCert(t)
+		_, e = sc.HandlePOST(context.Background(), Scopes{}, signedSamlResponse(t, other, "_request1", false), "_request1")
+		So(e, ShouldNotBeNil)
+
+		now := time.Now().UTC()
+		unsigned := fmt.Sprintf(samlTestResponse, now.Format(time.RFC3339), now.Add(5*time.Minute).Format(time.RFC3339), samlTestACS, "_request1", samlTestIssuer)
+		_, e = sc.HandlePOST(context.Background(), Scopes{}, base64.StdEncoding.EncodeToString([]byte(unsigned)), "_request1")
+		So(e, ShouldNotBeNil)
+
+		sc.now = func() time.Time { return now.Add(time.Hour) }
+		_, e = sc.HandlePOST(context.Background(), Scopes{}, signedSamlResponse(t, cert, "_request1", false), "_request1")
+		So(e, ShouldNotBeNil)
+		So(strings.Contains(e.Error(), "expired"), ShouldBeTrue)
+		sc.now = time.Now
+	})
+
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Argon2idHasher creates and verifies argon2id hashes, encoded in the PHC string format
+// $argon2id$v=19$m=<memory>,t=<time>,p=<threads>$<salt>$<hash>
+type Argon2idHasher struct {
+	Time    uint32
+	Memory  uint32
+	Threads uint8
+	SaltLen uint32
+	KeyLen  uint32
+}
+
+// NewArgon2idHasher creates an Argon2idHasher with the parameters recommended by the argon2 package.
+func NewArgon2idHasher() *Argon2idHasher {
+	return &Argon2idHasher{
+		Time:    1,
+		Memory:  64 * 1024,
+		Threads: 4,
+		SaltLen: 16,
+		KeyLen:  32,
+	}
+}
+
+// Scheme implements PasswordHasher.
+func (a *Argon2idHasher) Scheme(hash string) string {
+	if strings.HasPrefix(hash, "$"+PasswordSchemeArgon2id+"$") {
+		return PasswordSchemeArgon2id
+	}
+	return ""
+}
+
+// CreateHash implements PasswordHasher.
+func (a *Argon2idHasher) CreateHash(password string) string {
+	salt := make([]byte, a.SaltLen)
+	if _, e := rand.Read(salt); e != nil {
+		panic(e)
+	}
+	key := argon2.IDKey([]byte(password), salt, a.Time, a.Memory, a.Threads, a.KeyLen)
+	return fmt.Sprintf("$%s$v=%d$m=%d,t=%d,p=%d$%s$%s", PasswordSchemeArgon2id, argon2.Version, a.Memory, a.Time, a.Threads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))
+}
+
+// Check implements PasswordHasher.
+func (a *Argon2idHasher) Check(password, hash string) (bool, error) {
+	params, salt, key, err := a.decode(hash)
+	if err != nil {
+		return false, err
+	}
+	computed := argon2.IDKey([]byte(password), salt, params.Time, params.Memory, params.Threads, uint32(len(key)))
+	return subtle.ConstantTimeCompare(computed, key) == 1, nil
+}
+
+// NeedsRehash returns true if hash was created with different parameters than the current ones.
+func (a *Argon2idHasher) NeedsRehash(hash string) bool {
+	params, salt, key, err := a.decode(hash)
+	if err != nil {
+		return true
+	}
+	return params.Time != a.Time || params.Memory != a.Memory || params.Threads != a.Threads ||
+		uint32(len(salt)) != a.SaltLen || uint32(len(key)) != a.KeyLen
+}
+
+func (a *Argon2idHasher) decode(hash string) (params *Argon2idHasher, salt, key []byte, err error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != PasswordSchemeArgon2id {
+		return nil, nil, nil, fmt.Errorf("invalid argon2id hash")
+	}
+	var version int
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return nil, nil, nil, err
+	}
+	if version != argon2.Version {
+		return nil, nil, nil, fmt.Errorf("unsupported argon2 version %d", version)
+	}
+	params = &Argon2idHasher{}
+	if _, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Time, &params.Threads); err != nil {
+		return nil, nil, nil, err
+	}
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, nil, nil, err
+	}
+	if key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return nil, nil, nil, err
+	}
+	return
+}
+
+// BcryptHasher creates and verifies bcrypt hashes. A zero Cost uses bcrypt.DefaultCost.
+type BcryptHasher struct {
+	Cost int
+}
+
+func (b *BcryptHasher) cost() int {
+	if b.Cost < bcrypt.MinCost {
+		return bcrypt.DefaultCost
+	}
+	return b.Cost
+}
+
+// Scheme implements PasswordHasher.
+func (b *BcryptHasher) Scheme(hash string) string {
+	if _, e := bcrypt.Cost([]byte(hash)); e == nil {
+		return PasswordSchemeBcrypt
+	}
+	return ""
+}
+
+// CreateHash implements PasswordHasher.
+func (b *BcryptHasher) CreateHash(password string) string {
+	h, e := bcrypt.GenerateFromPassword([]byte(password), b.cost())
+	if e != nil {
+		panic(e)
+	}
+	return string(h)
+}
+
+// Check implements PasswordHasher.
+func (b *BcryptHasher) Check(password, hash string) (bool, error) {
+	if e := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)); e != nil {
+		if e == bcrypt.ErrMismatchedHashAndPassword {
+			return false, nil
+		}
+		return false, e
+	}
+	return true, nil
+}
+
+// NeedsRehash returns true if hash was created with a different cost than the current one.
+func (b *BcryptHasher) NeedsRehash(hash string) bool {
+	c, e := bcrypt.Cost([]byte(hash))
+	return e != nil || c != b.cost()
+}
@@ -36,6 +36,75 @@ import (
 	"golang.org/x/crypto/pbkdf2"
 )
 
+const (
+	PasswordSchemeArgon2id = "argon2id"
+	PasswordSchemeBcrypt   = "bcrypt"
+	PasswordSchemePBKDF2   = "pbkdf2"
+	PasswordSchemeMD5      = "md5"
+)
+
+// PasswordHasher creates and verifies password hashes for one or more schemes.
+type PasswordHasher interface {
+	// Scheme returns the name of the scheme used by hash, or an empty string if this hasher does not support it.
+	Scheme(hash string) string
+	// CreateHash hashes a clear password.
+	CreateHash(password string) string
+	// Check verifies a clear password against a hash.
+	Check(password, hash string) (bool, error)
+}
+
+// PasswordHashers creates new hashes with a default hasher, and verifies hashes with any of the known hashers.
+type PasswordHashers struct {
+	Default PasswordHasher
+	Legacy  []PasswordHasher
+}
+
+// NewPasswordHashers builds a PasswordHashers that creates hashes with def.
+func NewPasswordHashers(def PasswordHasher, legacy ...PasswordHasher) *PasswordHashers {
+	return &PasswordHashers{Default: def, Legacy: legacy}
+}
+
+// CreateHash hashes a clear password with the default hasher.
+func (p *PasswordHashers) CreateHash(password string) string {
+	return p.Default.CreateHash(password)
+}
+
+// Scheme finds the scheme of a stored hash.
+func (p *PasswordHashers) Scheme(hash string) string {
+	if h, scheme := p.find(hash); h != nil {
+		return scheme
+	}
+	return ""
+}
+
+// Check verifies a clear password against a stored hash. If the password is valid but the hash was not created
+// with the default hasher and settings, rehash is true and the password should be hashed again with CreateHash.
+func (p *PasswordHashers) Check(password, hash string) (valid bool, rehash bool, err error) {
+	h, _ := p.find(hash)
+	if h == nil {
+		return false, false, fmt.Errorf("unsupported password format")
+	}
+	if valid, err = h.Check(password, hash); !valid {
+		return
+	}
+	if h != p.Default {
+		rehash = true
+	} else if r, ok := h.(interface{ NeedsRehash(string) bool }); ok {
+		rehash = r.NeedsRehash(hash)
+	}
+	return
+}
+
+func (p *PasswordHashers) find(hash string) (PasswordHasher, string) {
+	for _, h := range append([]PasswordHasher{p.Default}, p.Legacy...) {
+		if s := h.Scheme(hash); s != "" {
+			return h, s
+		}
+	}
+	return nil, ""
+}
+
+// PydioPW handles PBKDF2 hashes, and checks legacy MD5 hashes.
 type PydioPW struct {
 	PBKDF2_HASH_ALGORITHM string
 	PBKDF2_ITERATIONS     int
@@ -110,6 +179,27 @@ func (p PydioPW) CheckDBKDF2PydioPwd(password string, hashedPw string, legacySal
 	return false, fmt.Errorf("Password format invalid")
 }
 
+// Scheme implements PasswordHasher.
+func (p PydioPW) Scheme(hash string) string {
+	if strings.HasPrefix(hash, "$") {
+		return ""
+	}
+	if n := len(strings.Split(hash, ":")); n == p.HASH_SECTIONS {
+		return PasswordSchemePBKDF2
+	} else if n < p.HASH_SECTIONS && len(hash) == md5.Size*2 {
+		return PasswordSchemeMD5
+	}
+	return ""
+}
+
+// Check implements PasswordHasher. It also tries the salt format of hashes coming from the PHP version.
+func (p PydioPW) Check(password, hash string) (bool, error) {
+	if valid, _ := p.CheckDBKDF2PydioPwd(password, hash); valid {
+		return true, nil
+	}
+	return p.CheckDBKDF2PydioPwd(password, hash, true)
+}
+
 func (p PydioPW) CreateHash(password string) (base64Pw string) {
 	salt := RandStringBytes(p.PBKDF2_SALT_BYTE_SIZE)
 	hashedPw, _ := p.pbkdf2CreateHash([]byte(password), salt, p.PBKDF2_ITERATIONS, p.PBKDF2_HASH_BYTE_SIZE, p.PBKDF2_HASH_ALGORITHM)
@@ -101,3 +101,66 @@ func TestCheckPasswordMd5(t *testing.T) {
 	})
 
 }
+
+func TestPasswordHashers(t *testing.T) {
+
+	legacy := PydioPW{
+		PBKDF2_HASH_ALGORITHM: "sha256",
+		PBKDF2_ITERATIONS:     1000,
+		PBKDF2_SALT_BYTE_SIZE: 32,
+		PBKDF2_HASH_BYTE_SIZE: 24,
+		HASH_SECTIONS:         4,
+		HASH_ALGORITHM_INDEX:  0,
+		HASH_ITERATION_INDEX:  1,
+		HASH_SALT_INDEX:       2,
+		HASH_PBKDF2_INDEX:     3,
+	}
+	argon := NewArgon2idHasher()
+	bc := &BcryptHasher{Cost: 4}
+	hashers := NewPasswordHashers(argon, bc, legacy)
+
+	Convey("Test Argon2id Password", t, func() {
+		hash := hashers.CreateHash("P@ssw0rd")
+		So(hash, ShouldStartWith, "$argon2id$v=19$m=65536,t=1,p=4$")
+		So(hashers.Scheme(hash), ShouldEqual, PasswordSchemeArgon2id)
+		So(hash, ShouldNotEqual, hashers.CreateHash("P@ssw0rd"))
+
+		valid, rehash, err := hashers.Check("P@ssw0rd", hash)
+		So(err, ShouldBeNil)
+		So(valid, ShouldBeTrue)
+		So(rehash, ShouldBeFalse)
+
+		valid, _, _ = hashers.Check("wrong", hash)
+		So(valid, ShouldBeFalse)
+
+		stronger := &Argon2idHasher{Time: 2, Memory: 1024, Threads: 1, SaltLen: 16, KeyLen: 32}
+		valid, rehash, _ = NewPasswordHashers(stronger, argon).Check("P@ssw0rd", hash)
+		So(valid, ShouldBeTrue)
+		So(rehash, ShouldBeTrue)
+
+		_, err = argon.Check("P@ssw0rd", "$argon2id$v=19$m=65536$salt$key")
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Test legacy schemes require rehash", t, func() {
+		for scheme, hash := range map[string]string{
+			PasswordSchemeBcrypt: bc.CreateHash("P@ssw0rd"),
+			PasswordSchemePBKDF2: testPw,
+		} {
+			So(hashers.Scheme(hash), ShouldEqual, scheme)
+			valid, rehash, err := hashers.Check("P@ssw0rd", hash)
+			So(err, ShouldBeNil)
+			So(valid, ShouldBeTrue)
+			So(rehash, ShouldBeTrue)
+		}
+
+		So(hashers.Scheme(md5pw), ShouldEqual, PasswordSchemeMD5)
+		valid, rehash, _ := hashers.Check("pbkdf2", md5pw)
+		So(valid, ShouldBeTrue)
+		So(rehash, ShouldBeTrue)
+
+		valid, _, err := hashers.Check("P@ssw0rd", "unknown")
+		So(valid, ShouldBeFalse)
+		So(err, ShouldNotBeNil)
+	})
+}
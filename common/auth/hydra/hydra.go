@@ -174,11 +174,12 @@ func CreateAuthCode(ctx context.Context, consent *auth.ID, clientID, redirectURI
 	return resp.Code, nil
 }
 
-func PasswordCredentialsToken(ctx context.Context, username, password string) (*oauth2.Token, error) {
+func PasswordCredentialsToken(ctx context.Context, username, password, mfaCode string) (*oauth2.Token, error) {
 	c := auth.NewPasswordCredentialsTokenClient(grpc.GetClientConnFromCtx(ctx, common.ServiceOAuth))
 	resp, err := c.PasswordCredentialsToken(ctx, &auth.PasswordCredentialsTokenRequest{
 		Username: username,
 		Password: password,
+		MfaCode:  mfaCode,
 	})
 	if err != nil {
 		return nil, err
@@ -326,6 +326,10 @@ func (j *JWTVerifier) PasswordCredentialsToken(ctx context.Context, userName str
 		}
 	}
 	if token == nil {
+		// Password was valid but a second factor is missing
+		if e := errors2.FromError(err); e != nil && (e.Id == MfaErrorCodeRequired || e.Id == MfaErrorEnrollRequired) {
+			return nil, e
+		}
 		err = errors2.Unauthorized("empty.token", "could not validate password credentials")
 	}
 	return token, err
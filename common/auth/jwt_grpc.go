@@ -74,7 +74,7 @@ func (p *grpcProvider) GetType() ProviderType {
 }
 
 func (p *grpcProvider) PasswordCredentialsToken(ctx context.Context, userName string, password string) (*oauth2.Token, error) {
-	return hydra.PasswordCredentialsToken(ctx, userName, password)
+	return hydra.PasswordCredentialsToken(ctx, userName, password, mfaCodeFromContext(ctx))
 }
 
 func (p *grpcProvider) Exchange(ctx context.Context, code, codeVerifier string) (*oauth2.Token, error) {
@@ -179,10 +179,19 @@ func (p *oryprovider) PasswordCredentialsCode(ctx context.Context, userName stri
 		break
 	}
 
+	// No password connector may be configured when login is only allowed through upstream providers
+	if err == nil && !valid {
+		return "", errors.New("No password connector found")
+	}
+
 	if err != nil {
 		return "", err
 	}
 
+	if err := checkMfa(ctx, identity.Username); err != nil {
+		return "", err
+	}
+
 	// Searching login challenge
 	login, err := hydra.GetLogin(ctx, challenge)
 	if err != nil {
@@ -296,6 +305,10 @@ func (p *oryprovider) PasswordCredentialsToken(ctx context.Context, userName str
 		return nil, err
 	}
 
+	if err := checkMfa(ctx, identity.Username); err != nil {
+		return nil, err
+	}
+
 	// Searching login challenge
 	login, err := hydra.GetLogin(ctx, challenge)
 	if err != nil {
@@ -0,0 +1,299 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/auth/totp"
+	"github.com/pydio/cells/v4/common/client/grpc"
+	"github.com/pydio/cells/v4/common/config"
+	"github.com/pydio/cells/v4/common/crypto"
+	"github.com/pydio/cells/v4/common/log"
+	"github.com/pydio/cells/v4/common/proto/idm"
+	"github.com/pydio/cells/v4/common/service/errors"
+	json "github.com/pydio/cells/v4/common/utils/jsonx"
+	"github.com/pydio/cells/v4/common/utils/permissions"
+)
+
+const (
+	// MfaErrorCodeRequired is the error ID returned after a valid password when a one-time code is missing
+	MfaErrorCodeRequired = "mfa.code.required"
+	// MfaErrorEnrollRequired is the error ID returned after a valid password when MFA is required but no device is enrolled
+	MfaErrorEnrollRequired = "mfa.enroll.required"
+
+	mfaRequiredParameter  = "parameter:core.auth:MFA_REQUIRED"
+	mfaRecoveryCodesCount = 10
+	mfaSkew               = 1
+)
+
+type mfaCodeKey struct{}
+
+var (
+	mfaKey     []byte
+	mfaKeyLock sync.Mutex
+)
+
+func init() {
+	config.RegisterVaultKey("defaults", "mfa", "secureKey")
+}
+
+// WithMfaCode attaches a one-time code (or a recovery code) to the context, to be checked by password grants.
+func WithMfaCode(ctx context.Context, code string) context.Context {
+	if code == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, mfaCodeKey{}, code)
+}
+
+func mfaCodeFromContext(ctx context.Context) string {
+	code, _ := ctx.Value(mfaCodeKey{}).(string)
+	return code
+}
+
+// checkMfa is called once the password is validated, before any code or token is issued. Users who enrolled
+// a device or whose roles require MFA must provide a valid code, otherwise the grant is refused.
+func checkMfa(ctx context.Context, login string) error {
+	u, err := permissions.SearchUniqueUser(ctx, login, "")
+	if err != nil {
+		if errors.FromError(err).Code == 404 {
+			return nil
+		}
+		return err
+	}
+	if u.IsHidden() {
+		return nil
+	}
+	enrolled := MfaEnrolled(u)
+	if !enrolled && !MfaRequired(ctx, u) {
+		return nil
+	}
+	code := mfaCodeFromContext(ctx)
+	user := proto.Clone(u).(*idm.User)
+	if user.Attributes == nil {
+		user.Attributes = map[string]string{}
+	}
+
+	if !enrolled {
+		pending := user.Attributes[idm.UserAttrMfaTotpPending]
+		if code == "" || pending == "" {
+			return errors.Unauthorized(MfaErrorEnrollRequired, "Multi-factor authentication is required, please enroll a device")
+		}
+		if err := MfaActivate(user, pending, code); err != nil {
+			return err
+		}
+		delete(user.Attributes, idm.UserAttrMfaTotpPending)
+	} else if code == "" {
+		return errors.Unauthorized(MfaErrorCodeRequired, "Please provide a one-time code")
+	} else if err := MfaCheckCode(user, code); err != nil {
+		if !MfaConsumeRecoveryCode(user, code) {
+			return err
+		}
+	}
+	return MfaSaveUser(ctx, user)
+}
+
+// MfaEnrolled checks if the user has an active TOTP device.
+func MfaEnrolled(user *idm.User) bool {
+	return user.GetAttributes()[idm.UserAttrMfaTotp] != ""
+}
+
+// MfaRequired looks up the MFA_REQUIRED parameter in user roles, starting from the last one.
+func MfaRequired(ctx context.Context, user *idm.User) bool {
+	if len(user.GetRoles()) == 0 {
+		return false
+	}
+	acls, err := permissions.GetACLsForRoles(ctx, user.Roles, &idm.ACLAction{Name: mfaRequiredParameter})
+	if err != nil || len(acls) == 0 {
+		return false
+	}
+	for i := len(user.Roles) - 1; i >= 0; i-- {
+		for _, a := range acls {
+			if a.RoleID != user.Roles[i].Uuid || a.Action.Value == "-1" {
+				continue
+			}
+			var required bool
+			if e := json.Unmarshal([]byte(a.Action.Value), &required); e == nil {
+				return required
+			}
+		}
+	}
+	return false
+}
+
+// MfaActivate validates a first code against a sealed secret and stores it as the active device.
+func MfaActivate(user *idm.User, sealed, code string) error {
+	if sealed == "" {
+		return errors.BadRequest("mfa.enroll", "No pending device, please restart enrollment")
+	}
+	secret, err := openMfaSecret(sealed)
+	if err != nil {
+		return err
+	}
+	counter, ok := totp.Validate(secret, code, time.Now(), mfaSkew)
+	if !ok {
+		return errors.Unauthorized("mfa.invalid.code", "Invalid code")
+	}
+	user.Attributes[idm.UserAttrMfaTotp] = sealed
+	user.Attributes[idm.UserAttrMfaTotpCounter] = strconv.FormatInt(counter, 10)
+	return nil
+}
+
+// MfaCheckCode validates a code against the active device, refusing codes that were already used.
+func MfaCheckCode(user *idm.User, code string) error {
+	sealed := user.Attributes[idm.UserAttrMfaTotp]
+	if sealed == "" {
+		return errors.BadRequest("mfa.not.enrolled", "No device is enrolled")
+	}
+	secret, err := openMfaSecret(sealed)
+	if err != nil {
+		return err
+	}
+	counter, ok := totp.Validate(secret, code, time.Now(), mfaSkew)
+	if last, _ := strconv.ParseInt(user.Attributes[idm.UserAttrMfaTotpCounter], 10, 64); !ok || counter <= last {
+		return errors.Unauthorized("mfa.invalid.code", "Invalid code")
+	}
+	user.Attributes[idm.UserAttrMfaTotpCounter] = strconv.FormatInt(counter, 10)
+	return nil
+}
+
+// MfaNewRecoveryCodes replaces the recovery codes of the user and returns them in clear.
+func MfaNewRecoveryCodes(user *idm.User) ([]string, error) {
+	codes, err := totp.NewRecoveryCodes(mfaRecoveryCodesCount)
+	if err != nil {
+		return nil, err
+	}
+	var hashes []string
+	for _, c := range codes {
+		hashes = append(hashes, totp.HashRecoveryCode(c))
+	}
+	user.Attributes[idm.UserAttrMfaRecovery] = strings.Join(hashes, ",")
+	return codes, nil
+}
+
+// MfaConsumeRecoveryCode checks a recovery code and removes it from the user list.
+func MfaConsumeRecoveryCode(user *idm.User, code string) bool {
+	hash := totp.HashRecoveryCode(code)
+	var found bool
+	var remaining []string
+	for _, h := range strings.Split(user.Attributes[idm.UserAttrMfaRecovery], ",") {
+		if h == "" {
+			continue
+		}
+		if h == hash && !found {
+			found = true
+			continue
+		}
+		remaining = append(remaining, h)
+	}
+	if found {
+		user.Attributes[idm.UserAttrMfaRecovery] = strings.Join(remaining, ",")
+	}
+	return found
+}
+
+// MfaClear removes the device and the recovery codes of the user.
+func MfaClear(user *idm.User) {
+	delete(user.Attributes, idm.UserAttrMfaTotp)
+	delete(user.Attributes, idm.UserAttrMfaTotpPending)
+	delete(user.Attributes, idm.UserAttrMfaTotpCounter)
+	delete(user.Attributes, idm.UserAttrMfaRecovery)
+}
+
+// MfaSaveUser stores the MFA attributes of the user.
+func MfaSaveUser(ctx context.Context, user *idm.User) error {
+	userClient := idm.NewUserServiceClient(grpc.GetClientConnFromCtx(ctx, common.ServiceUser))
+	if _, err := userClient.CreateUser(ctx, &idm.CreateUserRequest{User: user}); err != nil {
+		log.Logger(ctx).Error("Cannot store MFA attributes", user.ZapLogin(), zap.Error(err))
+		return err
+	}
+	permissions.ForceClearUserCache(user.Login)
+	return nil
+}
+
+// NewMfaSecret generates a TOTP secret and its encrypted form, to be stored in user attributes.
+func NewMfaSecret() (secret, sealed string, err error) {
+	if secret, err = totp.NewSecret(); err != nil {
+		return
+	}
+	key, err := getMfaKey()
+	if err != nil {
+		return
+	}
+	data, err := crypto.Seal(key, []byte(secret))
+	if err != nil {
+		return
+	}
+	return secret, base64.StdEncoding.EncodeToString(data), nil
+}
+
+func openMfaSecret(sealed string) (string, error) {
+	key, err := getMfaKey()
+	if err != nil {
+		return "", err
+	}
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil || len(data) < 12 {
+		return "", errors.InternalServerError("mfa.secret", "Cannot read stored secret")
+	}
+	secret, err := crypto.Open(key, data[:12], data[12:])
+	if err != nil {
+		return "", errors.InternalServerError("mfa.secret", "Cannot decrypt stored secret")
+	}
+	return string(secret), nil
+}
+
+// getMfaKey loads or generates the key used to encrypt TOTP secrets, stored in the vault.
+func getMfaKey() ([]byte, error) {
+	mfaKeyLock.Lock()
+	defer mfaKeyLock.Unlock()
+	if len(mfaKey) > 0 {
+		return mfaKey, nil
+	}
+	cVal := config.Get("defaults", "mfa", "secureKey")
+	if cVal.String() == "" {
+		key, err := crypto.RandomBytes(32)
+		if err != nil {
+			return nil, err
+		}
+		if err := cVal.Set(base64.StdEncoding.EncodeToString(key)); err != nil {
+			return nil, err
+		}
+		if err := config.Save(common.PydioSystemUsername, "Creating random key for MFA secrets"); err != nil {
+			return nil, err
+		}
+		mfaKey = key
+	} else if key, err := base64.StdEncoding.DecodeString(cVal.String()); err == nil {
+		mfaKey = key
+	} else {
+		return nil, err
+	}
+	return mfaKey, nil
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/auth/totp"
+	"github.com/pydio/cells/v4/common/client/grpc"
+	"github.com/pydio/cells/v4/common/proto/idm"
+	"github.com/pydio/cells/v4/common/service/errors"
+	"github.com/pydio/cells/v4/common/utils/permissions"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCheckMfa(t *testing.T) {
+
+	mock := &usersMock{users: map[string]*idm.User{}}
+	grpc.RegisterMock(common.ServiceUser, &idm.UserServiceStub{UserServiceServer: mock})
+	ctx := context.Background()
+	mfaKey = make([]byte, 32)
+
+	secret, sealed, err := NewMfaSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mock.users["plain"] = &idm.User{Uuid: "plain-uuid", Login: "plain"}
+	mock.users["secured"] = &idm.User{Uuid: "secured-uuid", Login: "secured", Attributes: map[string]string{
+		idm.UserAttrMfaTotp: sealed,
+	}}
+	permissions.ForceClearUserCache("plain")
+	permissions.ForceClearUserCache("secured")
+
+	Convey("Users without MFA do not need a code", t, func() {
+		So(checkMfa(ctx, "plain"), ShouldBeNil)
+		So(checkMfa(ctx, "unknown"), ShouldBeNil)
+	})
+
+	Convey("Enrolled users must provide a code, that can only be used once", t, func() {
+		e := checkMfa(ctx, "secured")
+		So(e, ShouldNotBeNil)
+		So(errors.FromError(e).Id, ShouldEqual, MfaErrorCodeRequired)
+
+		So(checkMfa(WithMfaCode(ctx, "000000x"), "secured"), ShouldNotBeNil)
+
+		code, _ := totp.Code(secret, time.Now())
+		So(checkMfa(WithMfaCode(ctx, code), "secured"), ShouldBeNil)
+		So(checkMfa(WithMfaCode(ctx, code), "secured"), ShouldNotBeNil)
+	})
+
+	Convey("Recovery codes are accepted once", t, func() {
+		user := mock.users["secured"]
+		codes, e := MfaNewRecoveryCodes(user)
+		So(e, ShouldBeNil)
+		permissions.ForceClearUserCache("secured")
+
+		So(checkMfa(WithMfaCode(ctx, codes[0]), "secured"), ShouldBeNil)
+		So(checkMfa(WithMfaCode(ctx, codes[0]), "secured"), ShouldNotBeNil)
+		So(checkMfa(WithMfaCode(ctx, codes[1]), "secured"), ShouldBeNil)
+	})
+}
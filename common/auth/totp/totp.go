@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package totp implements time-based one-time passwords (RFC 6238) as generated by authenticator
+// applications, and single-use recovery codes.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Digits is the length of generated codes
+	Digits = 6
+	// Period is the validity of a code, in seconds
+	Period = 30
+
+	secretSize       = 20
+	recoveryCodeSize = 10
+)
+
+var (
+	b32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+)
+
+// NewSecret generates a random secret, base32 encoded as expected by authenticator applications.
+func NewSecret() (string, error) {
+	bb := make([]byte, secretSize)
+	if _, e := rand.Read(bb); e != nil {
+		return "", e
+	}
+	return b32.EncodeToString(bb), nil
+}
+
+// URL builds the otpauth:// URL that is usually displayed as a QR code for enrolling a device.
+func URL(issuer, account, secret string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprintf("%d", Digits))
+	v.Set("period", fmt.Sprintf("%d", Period))
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: v.Encode(),
+	}
+	return u.String()
+}
+
+// Code computes the code for the given secret at time t.
+func Code(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return hotp(key, counter(t)), nil
+}
+
+// Validate checks a code against the secret at time t, accepting skew periods before and after to
+// account for clock drift. It returns the matching counter, that callers should store to refuse codes replays.
+func Validate(secret, code string, t time.Time, skew int) (int64, bool) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false
+	}
+	code = strings.TrimSpace(code)
+	if len(code) != Digits {
+		return 0, false
+	}
+	c := counter(t)
+	for i := -skew; i <= skew; i++ {
+		if subtle.ConstantTimeCompare([]byte(hotp(key, c+int64(i))), []byte(code)) == 1 {
+			return c + int64(i), true
+		}
+	}
+	return 0, false
+}
+
+// NewRecoveryCodes generates n random single-use codes, formatted as xxxxx-xxxxx.
+func NewRecoveryCodes(n int) ([]string, error) {
+	var codes []string
+	for i := 0; i < n; i++ {
+		bb := make([]byte, recoveryCodeSize)
+		if _, e := rand.Read(bb); e != nil {
+			return nil, e
+		}
+		s := strings.ToLower(b32.EncodeToString(bb))[:recoveryCodeSize]
+		codes = append(codes, s[:5]+"-"+s[5:])
+	}
+	return codes, nil
+}
+
+// HashRecoveryCode returns the value that should be stored for a recovery code. Codes are random
+// enough for a simple digest, and are compared regardless of case and dashes.
+func HashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	h := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(h[:])
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	return b32.DecodeString(strings.ToUpper(strings.TrimRight(strings.ReplaceAll(secret, " ", ""), "=")))
+}
+
+func counter(t time.Time) int64 {
+	return t.Unix() / Period
+}
+
+// hotp implements RFC 4226 with SHA1, as used by RFC 6238.
+func hotp(key []byte, c int64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(c))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < Digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", Digits, value%mod)
+}
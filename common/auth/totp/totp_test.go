@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package totp
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// rfcSecret is the base32 encoding of the RFC 6238 SHA1 test key "12345678901234567890"
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	Convey("Test RFC 6238 vectors", t, func() {
+		for ts, expected := range map[int64]string{
+			59:         "287082",
+			1111111109: "081804",
+			1111111111: "050471",
+			1234567890: "005924",
+			2000000000: "279037",
+		} {
+			c, e := Code(rfcSecret, time.Unix(ts, 0))
+			So(e, ShouldBeNil)
+			So(c, ShouldEqual, expected)
+		}
+		_, e := Code("not base32!", time.Now())
+		So(e, ShouldNotBeNil)
+	})
+
+	Convey("Test validation window", t, func() {
+		now := time.Unix(1234567890, 0)
+		counter, ok := Validate(rfcSecret, "005924", now, 1)
+		So(ok, ShouldBeTrue)
+		So(counter, ShouldEqual, 1234567890/Period)
+
+		previous, _ := Code(rfcSecret, now.Add(-Period*time.Second))
+		_, ok = Validate(rfcSecret, previous, now, 1)
+		So(ok, ShouldBeTrue)
+		_, ok = Validate(rfcSecret, previous, now, 0)
+		So(ok, ShouldBeFalse)
+
+		old, _ := Code(rfcSecret, now.Add(-3*Period*time.Second))
+		_, ok = Validate(rfcSecret, old, now, 1)
+		So(ok, ShouldBeFalse)
+
+		_, ok = Validate(rfcSecret, "", now, 1)
+		So(ok, ShouldBeFalse)
+	})
+}
+
+func TestSecretsAndRecovery(t *testing.T) {
+	Convey("Test new secret and enrollment URL", t, func() {
+		s, e := NewSecret()
+		So(e, ShouldBeNil)
+		So(s, ShouldHaveLength, 32)
+		c, e := Code(s, time.Now())
+		So(e, ShouldBeNil)
+		_, ok := Validate(s, c, time.Now(), 1)
+		So(ok, ShouldBeTrue)
+
+		u, e := url.Parse(URL("Pydio Cells", "admin", s))
+		So(e, ShouldBeNil)
+		So(u.Scheme, ShouldEqual, "otpauth")
+		So(u.Host, ShouldEqual, "totp")
+		So(u.Path, ShouldEqual, "/Pydio Cells:admin")
+		So(u.Query().Get("secret"), ShouldEqual, s)
+	})
+
+	Convey("Test recovery codes", t, func() {
+		codes, e := NewRecoveryCodes(10)
+		So(e, ShouldBeNil)
+		So(codes, ShouldHaveLength, 10)
+		So(codes[0], ShouldHaveLength, 11)
+		So(codes[0], ShouldNotEqual, codes[1])
+		So(HashRecoveryCode(codes[0]), ShouldEqual, HashRecoveryCode(" "+codes[0][:5]+codes[0][6:]))
+		So(HashRecoveryCode(codes[0]), ShouldNotEqual, HashRecoveryCode(codes[1]))
+	})
+}
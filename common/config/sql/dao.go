@@ -50,8 +50,9 @@ var (
 	//go:embed migrations/*
 	migrationsFS embed.FS
 	queries      = map[string]interface{}{
-		"get": "select data from %%PREFIX%%_config where id = 1",
-		"set": "insert into %%PREFIX%%_config(id, data) values (1, ?) on duplicate key update data = ?",
+		"get":          "select data from %%PREFIX%%_config where id = 1",
+		"set":          "insert into %%PREFIX%%_config(id, data) values (1, ?) on duplicate key update data = ?",
+		"set-postgres": "insert into %%PREFIX%%_config(id, data) values (1, ?) on conflict (id) do update set data = ?",
 	}
 )
 
@@ -103,7 +104,11 @@ func (s *sqlimpl) Get() ([]byte, error) {
 }
 
 func (s *sqlimpl) Set(data []byte) error {
-	stmt, err := s.DAO.GetStmt("set")
+	setKey := "set"
+	if s.DAO.Driver() == "postgres" {
+		setKey = "set-postgres"
+	}
+	stmt, err := s.DAO.GetStmt(setKey)
 	if err != nil {
 		return err
 	}
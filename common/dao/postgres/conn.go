@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2019-2022. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/lib/pq"
+
+	"github.com/pydio/cells/v4/common/dao"
+	"github.com/pydio/cells/v4/common/service/errors"
+	commonsql "github.com/pydio/cells/v4/common/sql"
+)
+
+var (
+	postgresLock                 = &sync.Mutex{}
+	ConnectionsPercentPerRequest = 5
+	MaxConnectionsPercent        = 90
+	IdleConnectionsPercent       = 25
+
+	dbNameRegexp = regexp.MustCompile(`(^|\s)dbname=('[^']*'|\S*)`)
+)
+
+type conn struct {
+	conn *sql.DB
+}
+
+func (p *conn) Open(dsn string) (dao.Conn, error) {
+	var (
+		db *sql.DB
+	)
+
+	// Try to create the database to ensure it exists
+	rootDSN, dbName, err := splitDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	if dbName != "" {
+		if db, err = commonsql.GetSqlConnection("postgres", rootDSN); err != nil {
+			return nil, err
+		}
+		var exists bool
+		if err = db.QueryRow("select exists(select 1 from pg_database where datname = $1)", dbName).Scan(&exists); err != nil {
+			db.Close()
+			return nil, err
+		}
+		if !exists {
+			if _, err = db.Exec(fmt.Sprintf("create database %s", pq.QuoteIdentifier(dbName))); err != nil {
+				db.Close()
+				return nil, err
+			}
+		}
+		db.Close()
+	}
+
+	if db, err = commonsql.GetSqlConnection("postgres", dsn); err != nil {
+		return nil, err
+	}
+
+	p.conn = db
+
+	return db, nil
+}
+
+func (p *conn) GetConn() dao.Conn {
+	return p.conn
+}
+
+func (p *conn) getMaxTotalConnections() int {
+	db := p.conn
+
+	var num int
+
+	if err := db.QueryRow(`select setting::int from pg_settings where name = 'max_connections'`).Scan(&num); err != nil {
+		return 0
+	}
+
+	return (num * MaxConnectionsPercent) / 100
+}
+
+func (p *conn) SetMaxConnectionsForWeight(num int) {
+
+	postgresLock.Lock()
+	defer postgresLock.Unlock()
+
+	maxConns := p.getMaxTotalConnections() * (num * ConnectionsPercentPerRequest) / 100
+	maxIdleConns := maxConns * IdleConnectionsPercent / 100
+
+	p.conn.SetMaxOpenConns(maxConns)
+	p.conn.SetMaxIdleConns(maxIdleConns)
+}
+
+// splitDSN extracts the database name from a URL or key/value DSN and returns
+// an equivalent DSN pointing to the "postgres" maintenance database instead.
+func splitDSN(dsn string) (string, string, error) {
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		u, err := url.Parse(dsn)
+		if err != nil {
+			return "", "", err
+		}
+		dbName := strings.TrimPrefix(u.Path, "/")
+		u.Path = "/postgres"
+		return u.String(), dbName, nil
+	}
+	match := dbNameRegexp.FindStringSubmatch(dsn)
+	if match == nil {
+		return dsn, "", nil
+	}
+	dbName := strings.Trim(match[2], "'")
+	return dbNameRegexp.ReplaceAllString(dsn, "${1}dbname=postgres"), dbName, nil
+}
+
+// FilterDAOErrors hides sensitive information about the underlying table
+// when we receive a pq.Error.
+func FilterDAOErrors(err error) (error, bool) {
+	filtered := false
+	if err != nil {
+		if _, ok := err.(*pq.Error); ok {
+			err = errors.InternalServerError("dao.error", "DAO error received")
+			filtered = true
+		}
+	}
+	return err, filtered
+}
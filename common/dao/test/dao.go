@@ -3,8 +3,12 @@ package test
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
+	"net/url"
 	"os"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/pydio/cells/v4/common/dao"
 	"github.com/pydio/cells/v4/common/dao/bleve"
@@ -15,9 +19,42 @@ import (
 	_ "github.com/pydio/cells/v4/common/dao/bleve"
 	_ "github.com/pydio/cells/v4/common/dao/boltdb"
 	_ "github.com/pydio/cells/v4/common/dao/mongodb"
+	_ "github.com/pydio/cells/v4/common/dao/postgres"
 	_ "github.com/pydio/cells/v4/common/dao/sqlite"
 )
 
+var (
+	pgDbName = regexp.MustCompile(`(^|\s)dbname=('[^']*'|\S*)`)
+	// pgTestDatabase prefixes the postgres databases used by the current test process.
+	pgTestDatabase = fmt.Sprintf("cells_test_%d_%d", os.Getpid(), time.Now().Unix())
+)
+
+// SQLDriver returns the driver and DSN to be used by SQL DAO tests. If the CELLS_TEST_POSTGRES_DSN
+// environment variable is set, sqlite3 tests are switched to the postgres driver. The database name of
+// the DSN is replaced by a fresh one for each test process and sqlite DSN, that is created when the DAO is opened.
+func SQLDriver(driver, dsn string) (string, string) {
+	if pgEnv := os.Getenv("CELLS_TEST_POSTGRES_DSN"); driver == "sqlite3" && pgEnv != "" {
+		h := fnv.New32a()
+		h.Write([]byte(dsn))
+		return "postgres", postgresTestDSN(pgEnv, fmt.Sprintf("%s_%08x", pgTestDatabase, h.Sum32()))
+	}
+	return driver, dsn
+}
+
+func postgresTestDSN(dsn, dbName string) string {
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		if u, e := url.Parse(dsn); e == nil {
+			u.Path = "/" + dbName
+			return u.String()
+		}
+		return dsn
+	}
+	if pgDbName.MatchString(dsn) {
+		return pgDbName.ReplaceAllString(dsn, "${1}dbname="+dbName)
+	}
+	return strings.TrimSpace(dsn + " dbname=" + dbName)
+}
+
 func OnFileTestDAO(driver, dsn, prefix, altPrefix string, asIndexer bool, wrapper func(dao.DAO) dao.DAO) (dao.DAO, func(), error) {
 
 	cfg := configx.New()
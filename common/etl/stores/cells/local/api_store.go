@@ -552,7 +552,7 @@ func (apiStore *ApiStore) createShareLink(ctx context.Context, ownerUser *idm.Us
 	wsClient := idm.NewWorkspaceServiceClient(grpc.GetClientConnFromCtx(apiStore.runtime, common.ServiceWorkspace))
 	wsClient.CreateWorkspace(ctx, &idm.CreateWorkspaceRequest{Workspace: workspace})
 
-	err = shareClient.UpdateACLsForHiddenUser(ctx, user.Uuid, workspace.UUID, link.RootNodes, link.Permissions, "", false)
+	err = shareClient.UpdateACLsForHiddenUser(ctx, user.Uuid, workspace.UUID, link.RootNodes, link.Permissions, "", link.AccessStart, false)
 	if err != nil {
 		return err
 	}
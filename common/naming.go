@@ -220,6 +220,7 @@ const (
 	MetaFlagWorkspaceRepoId      = "repository_id"
 	MetaFlagWorkspaceRepoDisplay = "repository_display"
 	MetaFlagWorkspaceEventId     = "EventWorkspaceId"
+	MetaFlagStreamError          = "stream_error"
 )
 
 var (
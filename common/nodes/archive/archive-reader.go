@@ -23,7 +23,6 @@ package archive
 import (
 	"archive/tar"
 	"archive/zip"
-	"compress/gzip"
 	"context"
 	"fmt"
 	"io"
@@ -32,10 +31,11 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/pydio/cells/v4/common/nodes"
 
-	"github.com/krolaw/zipstream"
+	"github.com/bodgit/sevenzip"
 	"go.uber.org/zap"
 	"golang.org/x/text/unicode/norm"
 
@@ -44,10 +44,19 @@ import (
 	"github.com/pydio/cells/v4/common/log"
 	"github.com/pydio/cells/v4/common/nodes/models"
 	"github.com/pydio/cells/v4/common/proto/tree"
+	"github.com/pydio/cells/v4/common/utils/cache"
+)
+
+var (
+	// sevenZipIndexCache keeps the entries of 7z archives by archive ETag
+	sevenZipIndexCache = cache.NewShort(cache.WithEviction(10*time.Minute), cache.WithCleanWindow(20*time.Minute))
 )
 
 type Reader struct {
 	Router nodes.Handler
+
+	// Optional password used to decrypt WinZip AES encrypted zip entries
+	Password string
 }
 
 const UnCompressThreshold = int64(100)
@@ -68,16 +77,52 @@ func (a *Reader) openArchiveStream(ctx context.Context, archiveNode *tree.Node)
 
 }
 
-// ListChildrenZip extracts all children from a zip archive
+// localArchiveFile provides a local file for archive formats that require random access to their content.
+// Remote archives are downloaded to a temporary file, that is removed by the returned clean function.
+func (a *Reader) localArchiveFile(ctx context.Context, archiveNode *tree.Node) (string, int64, func(), error) {
+
+	if localFolder := archiveNode.GetStringMeta(common.MetaNamespaceNodeTestLocalFolder); localFolder != "" {
+		archiveName := filepath.Join(localFolder, archiveNode.Uuid)
+		s, e := os.Stat(archiveName)
+		if e != nil {
+			return "", 0, nil, e
+		}
+		return archiveName, s.Size(), func() {}, nil
+	}
+
+	remoteReader, openErr := a.Router.GetObject(ctx, archiveNode, &models.GetRequestData{StartOffset: 0, Length: -1})
+	if openErr != nil {
+		return "", 0, nil, openErr
+	}
+	defer remoteReader.Close()
+	// Create tmp file
+	file, e := ioutil.TempFile("", "pydio-archive-")
+	if e != nil {
+		return "", 0, nil, e
+	}
+	defer file.Close()
+	archiveName := file.Name()
+	clean := func() {
+		os.Remove(archiveName)
+	}
+	size, e := io.Copy(file, remoteReader)
+	if e != nil {
+		clean()
+		return "", 0, nil, e
+	}
+	return archiveName, size, clean, nil
+
+}
+
+// ListChildrenZip extracts all children from a zip archive. Only the central directory is read from the archive.
 func (a *Reader) ListChildrenZip(ctx context.Context, archiveNode *tree.Node, parentPath string, stat ...bool) ([]*tree.Node, error) {
 
 	var results []*tree.Node
 
-	archive, openErr := a.openArchiveStream(ctx, archiveNode)
+	index, openErr := a.loadZipIndex(ctx, archiveNode)
 	if openErr != nil {
 		return results, openErr
 	}
-	defer archive.Close()
 
 	isStat := false
 	if len(stat) > 0 && stat[0] {
@@ -89,12 +134,7 @@ func (a *Reader) ListChildrenZip(ctx context.Context, archiveNode *tree.Node, pa
 	}
 
 	folders := map[string]string{}
-	reader := zipstream.NewReader(archive)
-	for {
-		file, err := reader.Next()
-		if err == io.EOF || file == nil {
-			break
-		}
+	for _, file := range index.entries {
 
 		innerPath := strings.TrimPrefix(file.Name, "/")
 		innerPath = string(norm.NFC.Bytes([]byte(innerPath)))
@@ -129,8 +169,11 @@ func (a *Reader) ListChildrenZip(ctx context.Context, archiveNode *tree.Node, pa
 		}
 
 		nodeType := tree.NodeType_LEAF
+		size := int64(file.UncompressedSize64)
 		if strings.HasSuffix(innerPath, "/") {
 			nodeType = tree.NodeType_COLLECTION
+			// Folders may be deduced from a file entry, do not report the file size
+			size = 0
 			innerPath = strings.TrimSuffix(innerPath, "/")
 			if _, already := folders[innerPath]; already {
 				continue
@@ -140,9 +183,9 @@ func (a *Reader) ListChildrenZip(ctx context.Context, archiveNode *tree.Node, pa
 
 		node := &tree.Node{
 			Path:  archiveNode.Path + "/" + innerPath,
-			Size:  int64(file.UncompressedSize64),
+			Size:  size,
 			Type:  nodeType,
-			MTime: file.ModTime().Unix(),
+			MTime: file.Modified.Unix(),
 		}
 		results = append(results, node)
 		if isStat {
@@ -164,45 +207,16 @@ func (a *Reader) StatChildZip(ctx context.Context, archiveNode *tree.Node, inner
 
 }
 
-// ReadChildZip reads content of a file contained in a zip archive
+// ReadChildZip reads content of a file contained in a zip archive. Only the central directory and the
+// entry data are read from the archive.
 func (a *Reader) ReadChildZip(ctx context.Context, archiveNode *tree.Node, innerPath string) (io.ReadCloser, error) {
 
-	// We have to download whole archive to read its content
-	var archiveName string
-	if localFolder := archiveNode.GetStringMeta(common.MetaNamespaceNodeTestLocalFolder); localFolder != "" {
-		archiveName = filepath.Join(localFolder, archiveNode.Uuid)
-	} else {
-		remoteReader, openErr := a.Router.GetObject(ctx, archiveNode, &models.GetRequestData{StartOffset: 0, Length: -1})
-		if openErr != nil {
-			return nil, openErr
-		}
-		defer remoteReader.Close()
-		// Create tmp file
-		file, e := ioutil.TempFile("", "pydio-archive-")
-		if e != nil {
-			return nil, e
-		}
-		defer file.Close()
-		_, e2 := io.Copy(file, remoteReader)
-		if e2 != nil {
-			return nil, e2
-		}
-		file.Close()
-		remoteReader.Close()
-		archiveName = file.Name()
-		defer os.Remove(archiveName)
-	}
-
-	reader, err := zip.OpenReader(archiveName)
-	if err != nil {
-		return nil, err
+	index, e := a.loadZipIndex(ctx, archiveNode)
+	if e != nil {
+		return nil, e
 	}
-
-	for _, file := range reader.File {
-		if file.Name == innerPath || file.Name == "/"+innerPath {
-			fileReader, err := file.Open()
-			return fileReader, err
-		}
+	if entry, ok := index.find(innerPath); ok {
+		return a.openZipIndexEntry(ctx, archiveNode, entry)
 	}
 	return nil, nodes.ErrFileNotFound("File " + innerPath + " not found inside archive")
 
@@ -212,39 +226,14 @@ func (a *Reader) ReadChildZip(ctx context.Context, archiveNode *tree.Node, inner
 func (a *Reader) ExtractAllZip(ctx context.Context, archiveNode *tree.Node, targetNode *tree.Node, logChannels ...chan string) error {
 
 	// We have to download whole archive to read its content
-	var archiveName string
-	var archiveSize, uncompressed int64
+	var uncompressed int64
 	maxRatio := config.Get("defaults", "archiveMaxRatio").Default(UnCompressThreshold).Int64()
 
-	if localFolder := archiveNode.GetStringMeta(common.MetaNamespaceNodeTestLocalFolder); localFolder != "" {
-		archiveName = filepath.Join(localFolder, archiveNode.Uuid)
-		s, e := os.Stat(archiveName)
-		if e != nil {
-			return e
-		}
-		archiveSize = s.Size()
-	} else {
-		remoteReader, openErr := a.Router.GetObject(ctx, archiveNode, &models.GetRequestData{StartOffset: 0, Length: -1})
-		if openErr != nil {
-			return openErr
-		}
-		defer remoteReader.Close()
-		// Create tmp file
-		file, e := ioutil.TempFile("", "pydio-archive-")
-		if e != nil {
-			return e
-		}
-		defer file.Close()
-		_, e2 := io.Copy(file, remoteReader)
-		if e2 != nil {
-			return e2
-		}
-		file.Close()
-		remoteReader.Close()
-		archiveName = file.Name()
-		archiveSize = archiveNode.GetSize()
-		defer os.Remove(archiveName)
+	archiveName, archiveSize, clean, e := a.localArchiveFile(ctx, archiveNode)
+	if e != nil {
+		return e
 	}
+	defer clean()
 
 	reader, err := zip.OpenReader(archiveName)
 	if err != nil {
@@ -266,7 +255,7 @@ func (a *Reader) ExtractAllZip(ctx context.Context, archiveNode *tree.Node, targ
 				logChannels[0] <- "Creating directory " + strings.TrimSuffix(fName, "/")
 			}
 		} else {
-			fileReader, err := file.Open()
+			fileReader, err := openZipEntry(file, a.Password)
 			if err != nil {
 				return err
 			}
@@ -296,8 +285,8 @@ func (a *Reader) ExtractAllZip(ctx context.Context, archiveNode *tree.Node, targ
 
 }
 
-// ListChildrenTar extracts all children from a tar/tar.gz archive
-func (a *Reader) ListChildrenTar(ctx context.Context, gzipFormat bool, archiveNode *tree.Node, parentPath string, stat ...bool) ([]*tree.Node, error) {
+// ListChildrenTar extracts all children from a tar archive (compressed or not)
+func (a *Reader) ListChildrenTar(ctx context.Context, format string, archiveNode *tree.Node, parentPath string, stat ...bool) ([]*tree.Node, error) {
 
 	var results []*tree.Node
 
@@ -316,16 +305,12 @@ func (a *Reader) ListChildrenTar(ctx context.Context, gzipFormat bool, archiveNo
 		parentPath = strings.TrimSuffix(parentPath, "/") + "/"
 	}
 
-	var tarReader *tar.Reader
-	if gzipFormat {
-		uncompressedStream, err := gzip.NewReader(archive)
-		if err != nil {
-			return results, err
-		}
-		tarReader = tar.NewReader(uncompressedStream)
-	} else {
-		tarReader = tar.NewReader(archive)
+	uncompressedStream, err := uncompressTarStream(archive, format)
+	if err != nil {
+		return results, err
 	}
+	defer uncompressedStream.Close()
+	tarReader := tar.NewReader(uncompressedStream)
 
 	folders := map[string]string{}
 	log.Logger(ctx).Debug("TAR:LIST-START: " + parentPath)
@@ -401,10 +386,10 @@ func (a *Reader) ListChildrenTar(ctx context.Context, gzipFormat bool, archiveNo
 	return results, nil
 }
 
-// StatChildTar finds information about a given entry of a tar/tar.gz archive (by its internal path)
-func (a *Reader) StatChildTar(ctx context.Context, gzipFormat bool, archiveNode *tree.Node, innerPath string) (*tree.Node, error) {
+// StatChildTar finds information about a given entry of a tar archive (compressed or not) (by its internal path)
+func (a *Reader) StatChildTar(ctx context.Context, format string, archiveNode *tree.Node, innerPath string) (*tree.Node, error) {
 
-	nn, err := a.ListChildrenTar(ctx, gzipFormat, archiveNode, innerPath, true)
+	nn, err := a.ListChildrenTar(ctx, format, archiveNode, innerPath, true)
 	if err != nil || len(nn) == 0 {
 		return nil, nodes.ErrFileNotFound("File " + innerPath + " not found inside archive " + archiveNode.Path)
 	}
@@ -412,8 +397,8 @@ func (a *Reader) StatChildTar(ctx context.Context, gzipFormat bool, archiveNode
 
 }
 
-// ReadChildTar reads content of a file contained in a tar/tar.gz archive
-func (a *Reader) ReadChildTar(ctx context.Context, gzipFormat bool, writer io.WriteCloser, archiveNode *tree.Node, innerPath string) (int64, error) {
+// ReadChildTar reads content of a file contained in a tar archive (compressed or not)
+func (a *Reader) ReadChildTar(ctx context.Context, format string, writer io.WriteCloser, archiveNode *tree.Node, innerPath string) (int64, error) {
 
 	// We have to download whole archive to read its content
 	var inputStream io.ReadCloser
@@ -428,16 +413,12 @@ func (a *Reader) ReadChildTar(ctx context.Context, gzipFormat bool, writer io.Wr
 	}
 	defer inputStream.Close()
 
-	var tarReader *tar.Reader
-	if gzipFormat {
-		uncompressedStream, err := gzip.NewReader(inputStream)
-		if err != nil {
-			return 0, err
-		}
-		tarReader = tar.NewReader(uncompressedStream)
-	} else {
-		tarReader = tar.NewReader(inputStream)
+	uncompressedStream, err := uncompressTarStream(inputStream, format)
+	if err != nil {
+		return 0, err
 	}
+	defer uncompressedStream.Close()
+	tarReader := tar.NewReader(uncompressedStream)
 
 	for {
 		file, err := tarReader.Next()
@@ -459,8 +440,8 @@ func (a *Reader) ReadChildTar(ctx context.Context, gzipFormat bool, writer io.Wr
 
 }
 
-// ExtractAllTar extracts all files contained in a tar/tar.gz archive to a given location
-func (a *Reader) ExtractAllTar(ctx context.Context, gzipFormat bool, archiveNode *tree.Node, targetNode *tree.Node, logChannels ...chan string) error {
+// ExtractAllTar extracts all files contained in a tar archive (compressed or not) to a given location
+func (a *Reader) ExtractAllTar(ctx context.Context, format string, archiveNode *tree.Node, targetNode *tree.Node, logChannels ...chan string) error {
 
 	// We have to download whole archive to read its content
 	var inputStream io.ReadCloser
@@ -483,16 +464,12 @@ func (a *Reader) ExtractAllTar(ctx context.Context, gzipFormat bool, archiveNode
 	}
 	defer inputStream.Close()
 
-	var tarReader *tar.Reader
-	if gzipFormat {
-		uncompressedStream, err := gzip.NewReader(inputStream)
-		if err != nil {
-			return err
-		}
-		tarReader = tar.NewReader(uncompressedStream)
-	} else {
-		tarReader = tar.NewReader(inputStream)
+	uncompressedStream, err := uncompressTarStream(inputStream, format)
+	if err != nil {
+		return err
 	}
+	defer uncompressedStream.Close()
+	tarReader := tar.NewReader(uncompressedStream)
 
 	for {
 		file, err := tarReader.Next()
@@ -538,3 +515,268 @@ func (a *Reader) ExtractAllTar(ctx context.Context, gzipFormat bool, archiveNode
 	return nil
 
 }
+
+// sevenZipIndexEntry is an entry of a 7z archive, with its internal path normalized
+type sevenZipIndexEntry struct {
+	innerPath string
+	size      int64
+	isDir     bool
+	modified  time.Time
+}
+
+// load7zIndex lists the entries of a 7z archive. As 7z headers are stored at the end of the archive, it cannot
+// be streamed and is downloaded to a temporary file. Entries are cached by archive ETag.
+func (a *Reader) load7zIndex(ctx context.Context, archiveNode *tree.Node) ([]*sevenZipIndexEntry, error) {
+
+	etag := archiveNode.GetEtag()
+	cacheable := etag != "" && etag != common.NodeFlagEtagTemporary
+	if cacheable {
+		if cached, ok := sevenZipIndexCache.Get(etag); ok {
+			return cached.([]*sevenZipIndexEntry), nil
+		}
+	}
+	archiveName, _, clean, e := a.localArchiveFile(ctx, archiveNode)
+	if e != nil {
+		return nil, e
+	}
+	defer clean()
+	reader, err := sevenzip.OpenReader(archiveName)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	var entries []*sevenZipIndexEntry
+	for _, file := range reader.File {
+		entries = append(entries, &sevenZipIndexEntry{
+			innerPath: sevenZipInnerPath(file.Name),
+			size:      int64(file.UncompressedSize),
+			isDir:     file.FileInfo().IsDir(),
+			modified:  file.Modified,
+		})
+	}
+	if cacheable {
+		sevenZipIndexCache.Set(etag, entries)
+	}
+	return entries, nil
+}
+
+// sevenZipInnerPath normalizes the name of a 7z entry
+func sevenZipInnerPath(name string) string {
+	return string(norm.NFC.Bytes([]byte(strings.TrimPrefix(filepath.ToSlash(name), "/"))))
+}
+
+// ListChildren7z extracts all children from a 7z archive
+func (a *Reader) ListChildren7z(ctx context.Context, archiveNode *tree.Node, parentPath string, stat ...bool) ([]*tree.Node, error) {
+
+	var results []*tree.Node
+
+	entries, e := a.load7zIndex(ctx, archiveNode)
+	if e != nil {
+		return results, e
+	}
+
+	isStat := false
+	if len(stat) > 0 && stat[0] {
+		isStat = true
+	}
+
+	if !isStat && len(parentPath) > 0 {
+		parentPath = strings.TrimSuffix(parentPath, "/") + "/"
+	}
+
+	folders := map[string]string{}
+	for _, file := range entries {
+
+		innerPath := file.innerPath
+		isDir := file.isDir
+		size := file.size
+		if !isStat {
+			if !strings.HasPrefix(strings.TrimSuffix(innerPath, "/"), parentPath) {
+				continue
+			}
+
+			testPath := strings.TrimPrefix(strings.TrimSuffix(innerPath, "/"), parentPath)
+			if strings.Contains(testPath, "/") {
+				// Check if there is an unreported folder
+				f := strings.SplitN(testPath, "/", 2)
+				baseDir := f[0]
+				if _, already := folders[parentPath+baseDir]; !already {
+					// There might be an additional folder here
+					innerPath = parentPath + baseDir
+					isDir = true
+					size = 0
+				} else {
+					continue
+				}
+			}
+		} else {
+			if strings.TrimSuffix(innerPath, "/") != parentPath {
+				// unreported folder entry in path
+				if strings.HasPrefix(innerPath, parentPath+"/") {
+					innerPath = parentPath
+					isDir = true
+					size = 0
+				} else {
+					continue
+				}
+			}
+		}
+
+		nodeType := tree.NodeType_LEAF
+		if isDir {
+			nodeType = tree.NodeType_COLLECTION
+			innerPath = strings.TrimSuffix(innerPath, "/")
+			if _, already := folders[innerPath]; already {
+				continue
+			}
+			folders[innerPath] = innerPath
+		}
+
+		node := &tree.Node{
+			Path:  archiveNode.Path + "/" + innerPath,
+			Size:  size,
+			Type:  nodeType,
+			MTime: file.modified.Unix(),
+		}
+		results = append(results, node)
+		if isStat {
+			break
+		}
+	}
+
+	return results, nil
+}
+
+// StatChild7z finds information about a given entry of a 7z archive (by its internal path)
+func (a *Reader) StatChild7z(ctx context.Context, archiveNode *tree.Node, innerPath string) (*tree.Node, error) {
+
+	nn, err := a.ListChildren7z(ctx, archiveNode, innerPath, true)
+	if err != nil || len(nn) == 0 {
+		return nil, nodes.ErrFileNotFound("File " + innerPath + " not found inside archive " + archiveNode.Path)
+	}
+	return nn[0], nil
+
+}
+
+// ReadChild7z reads content of a file contained in a 7z archive. The cached index is checked first, to avoid
+// downloading the archive for missing entries.
+func (a *Reader) ReadChild7z(ctx context.Context, archiveNode *tree.Node, innerPath string) (io.ReadCloser, error) {
+
+	innerPath = sevenZipInnerPath(innerPath)
+	entries, e := a.load7zIndex(ctx, archiveNode)
+	if e != nil {
+		return nil, e
+	}
+	var found bool
+	for _, entry := range entries {
+		if entry.innerPath == innerPath && !entry.isDir {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, nodes.ErrFileNotFound("File " + innerPath + " not found inside archive")
+	}
+
+	archiveName, _, clean, e := a.localArchiveFile(ctx, archiveNode)
+	if e != nil {
+		return nil, e
+	}
+	reader, err := sevenzip.OpenReader(archiveName)
+	if err != nil {
+		clean()
+		return nil, err
+	}
+
+	for _, file := range reader.File {
+		if sevenZipInnerPath(file.Name) == innerPath {
+			fileReader, err := file.Open()
+			if err != nil {
+				reader.Close()
+				clean()
+				return nil, err
+			}
+			return &sevenZipEntryReader{ReadCloser: fileReader, archive: reader, clean: clean}, nil
+		}
+	}
+	reader.Close()
+	clean()
+	return nil, nodes.ErrFileNotFound("File " + innerPath + " not found inside archive")
+
+}
+
+// ExtractAll7z extracts all files contained in a 7z archive to a given location
+func (a *Reader) ExtractAll7z(ctx context.Context, archiveNode *tree.Node, targetNode *tree.Node, logChannels ...chan string) error {
+
+	var uncompressed int64
+	maxRatio := config.Get("defaults", "archiveMaxRatio").Default(UnCompressThreshold).Int64()
+
+	archiveName, archiveSize, clean, e := a.localArchiveFile(ctx, archiveNode)
+	if e != nil {
+		return e
+	}
+	defer clean()
+
+	reader, err := sevenzip.OpenReader(archiveName)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	for _, file := range reader.File {
+		fName := string(norm.NFC.Bytes([]byte(filepath.ToSlash(file.Name))))
+		pa := path.Join(targetNode.GetPath(), path.Clean("/"+strings.TrimSuffix(fName, "/")))
+		if file.FileInfo().IsDir() {
+			_, e := a.Router.CreateNode(ctx, &tree.CreateNodeRequest{Node: &tree.Node{Path: pa, Type: tree.NodeType_COLLECTION}})
+			if nodes.Is403(e) {
+				continue
+			}
+			if e != nil {
+				return e
+			}
+			if len(logChannels) > 0 {
+				logChannels[0] <- "Creating directory " + strings.TrimSuffix(fName, "/")
+			}
+			continue
+		}
+
+		uncompressed += int64(file.UncompressedSize)
+		if uncompressed/archiveSize > maxRatio {
+			log.Auditer(ctx).Error("Decompression of archive " + archiveNode.GetPath() + " was interrupted because compression ratio seems too high. It could be a zip bomb. You can set the defaults/archiveMaxRatio value to override default threshold (100).")
+			return fmt.Errorf("interrupting archive decompression: ratio seems too high, it could be a zip-bomb.")
+		}
+		fileReader, err := file.Open()
+		if err != nil {
+			return err
+		}
+		_, err = a.Router.PutObject(ctx, &tree.Node{Path: pa}, fileReader, &models.PutRequestData{Size: int64(file.UncompressedSize)})
+		fileReader.Close()
+		if nodes.Is403(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if len(logChannels) > 0 {
+			logChannels[0] <- "Writing new file " + strings.TrimSuffix(fName, "/")
+		}
+	}
+
+	return nil
+
+}
+
+// sevenZipEntryReader closes the archive and removes its temporary copy once the entry is read
+type sevenZipEntryReader struct {
+	io.ReadCloser
+	archive *sevenzip.ReadCloser
+	clean   func()
+}
+
+func (s *sevenZipEntryReader) Close() error {
+	e := s.ReadCloser.Close()
+	s.archive.Close()
+	s.clean()
+	return e
+}
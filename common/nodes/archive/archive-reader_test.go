@@ -28,6 +28,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
 	"go.uber.org/zap"
@@ -129,7 +130,7 @@ func TestReader_ListChildren(t *testing.T) {
 			Router: nodes.NewHandlerMock(),
 		}
 
-		results, e := archiveReader.ListChildrenTar(context.Background(), false, archiveNode, "actions")
+		results, e := archiveReader.ListChildrenTar(context.Background(), "tar", archiveNode, "actions")
 		So(e, ShouldBeNil)
 
 		log.Logger(context.Background()).Debug("Files Read", zap.Int("length", len(results)))
@@ -147,7 +148,7 @@ func TestReader_ListChildren(t *testing.T) {
 			Router: nodes.NewHandlerMock(),
 		}
 
-		results, e := archiveReader.ListChildrenTar(context.Background(), true, archiveNode, "actions")
+		results, e := archiveReader.ListChildrenTar(context.Background(), "tar.gz", archiveNode, "actions")
 		So(e, ShouldBeNil)
 
 		log.Logger(context.Background()).Debug("Files Read", zap.Int("length", len(results)))
@@ -165,7 +166,7 @@ func TestReader_ListChildren(t *testing.T) {
 			Router: nodes.NewHandlerMock(),
 		}
 
-		results, e := archiveReader.ListChildrenTar(context.Background(), true, archiveNode, "")
+		results, e := archiveReader.ListChildrenTar(context.Background(), "tar.gz", archiveNode, "")
 		So(e, ShouldBeNil)
 
 		log.Logger(context.Background()).Debug("Files Read", zap.Int("length", len(results)))
@@ -242,12 +243,12 @@ func TestReader_StatChild(t *testing.T) {
 			Router: nodes.NewHandlerMock(),
 		}
 		{
-			_, e := archiveReader.StatChildTar(context.Background(), false, archiveNode, "actions/nonexistingfile.go")
+			_, e := archiveReader.StatChildTar(context.Background(), "tar", archiveNode, "actions/nonexistingfile.go")
 			So(e, ShouldNotBeNil)
 			So(errors.FromError(e).Code, ShouldEqual, 404)
 		}
 		{
-			stat, e := archiveReader.StatChildTar(context.Background(), false, archiveNode, "actions/interfaces.go")
+			stat, e := archiveReader.StatChildTar(context.Background(), "tar", archiveNode, "actions/interfaces.go")
 			So(e, ShouldBeNil)
 			So(stat, ShouldResemble, &tree.Node{
 				Path:  "fake-path/actions/interfaces.go",
@@ -257,7 +258,7 @@ func TestReader_StatChild(t *testing.T) {
 			})
 		}
 		{
-			stat, e := archiveReader.StatChildTar(context.Background(), false, archiveNode, "actions/images")
+			stat, e := archiveReader.StatChildTar(context.Background(), "tar", archiveNode, "actions/images")
 			So(e, ShouldBeNil)
 			So(stat, ShouldResemble, &tree.Node{
 				Path:  "fake-path/actions/images",
@@ -278,12 +279,12 @@ func TestReader_StatChild(t *testing.T) {
 			Router: nodes.NewHandlerMock(),
 		}
 		{
-			_, e := archiveReader.StatChildTar(context.Background(), true, archiveNode, "actions/nonexistingfile.go")
+			_, e := archiveReader.StatChildTar(context.Background(), "tar.gz", archiveNode, "actions/nonexistingfile.go")
 			So(e, ShouldNotBeNil)
 			So(errors.FromError(e).Code, ShouldEqual, 404)
 		}
 		{
-			stat, e := archiveReader.StatChildTar(context.Background(), true, archiveNode, "actions/interfaces.go")
+			stat, e := archiveReader.StatChildTar(context.Background(), "tar.gz", archiveNode, "actions/interfaces.go")
 			So(e, ShouldBeNil)
 			So(stat, ShouldResemble, &tree.Node{
 				Path:  "fake-path/actions/interfaces.go",
@@ -293,7 +294,7 @@ func TestReader_StatChild(t *testing.T) {
 			})
 		}
 		{
-			stat, e := archiveReader.StatChildTar(context.Background(), true, archiveNode, "actions/images")
+			stat, e := archiveReader.StatChildTar(context.Background(), "tar.gz", archiveNode, "actions/images")
 			So(e, ShouldBeNil)
 			So(stat, ShouldResemble, &tree.Node{
 				Path:  "fake-path/actions/images",
@@ -314,7 +315,7 @@ func TestReader_StatChild(t *testing.T) {
 			Router: nodes.NewHandlerMock(),
 		}
 		{
-			stat, e := archiveReader.StatChildTar(context.Background(), true, archiveNode, "AFolder")
+			stat, e := archiveReader.StatChildTar(context.Background(), "tar.gz", archiveNode, "AFolder")
 			So(e, ShouldBeNil)
 			So(stat, ShouldResemble, &tree.Node{
 				Path:  "fake-path/AFolder",
@@ -369,7 +370,7 @@ func TestReader_ReadChild(t *testing.T) {
 		defer tmpWriter.Close()
 		defer os.Remove(tmpName)
 
-		written, e := archiveReader.ReadChildTar(context.Background(), false, tmpWriter, archiveNode, "actions/interfaces.go")
+		written, e := archiveReader.ReadChildTar(context.Background(), "tar", tmpWriter, archiveNode, "actions/interfaces.go")
 		So(e, ShouldBeNil)
 		So(written, ShouldEqual, 449)
 
@@ -390,7 +391,7 @@ func TestReader_ReadChild(t *testing.T) {
 		defer tmpWriter.Close()
 		defer os.Remove(tmpName)
 
-		written, e := archiveReader.ReadChildTar(context.Background(), true, tmpWriter, archiveNode, "actions/interfaces.go")
+		written, e := archiveReader.ReadChildTar(context.Background(), "tar.gz", tmpWriter, archiveNode, "actions/interfaces.go")
 		So(e, ShouldBeNil)
 		So(written, ShouldEqual, 449)
 
@@ -428,7 +429,7 @@ func TestReader_ExtractAll(t *testing.T) {
 			Router: nodes.NewHandlerMock(),
 		}
 
-		er := archiveReader.ExtractAllTar(context.Background(), false, archiveNode, &tree.Node{
+		er := archiveReader.ExtractAllTar(context.Background(), "tar", archiveNode, &tree.Node{
 			Path: "path/to/target",
 		})
 		So(er, ShouldBeNil)
@@ -445,7 +446,7 @@ func TestReader_ExtractAll(t *testing.T) {
 			Router: nodes.NewHandlerMock(),
 		}
 
-		er := archiveReader.ExtractAllTar(context.Background(), true, archiveNode, &tree.Node{
+		er := archiveReader.ExtractAllTar(context.Background(), "tar.gz", archiveNode, &tree.Node{
 			Path: "path/to/target",
 		})
 		So(er, ShouldBeNil)
@@ -453,3 +454,31 @@ func TestReader_ExtractAll(t *testing.T) {
 	})
 
 }
+
+func TestReader_7zIndex(t *testing.T) {
+
+	Convey("7z entries are read from the cached index", t, func() {
+		archiveNode := &tree.Node{Path: "archive.7z", Uuid: uuid.New(), Etag: uuid.New()}
+		sevenZipIndexCache.Set(archiveNode.Etag, []*sevenZipIndexEntry{
+			{innerPath: sevenZipInnerPath("/folder/"), isDir: true, modified: time.Now()},
+			{innerPath: sevenZipInnerPath("folder/café.txt"), size: 4, modified: time.Now()},
+		})
+		reader := &Reader{}
+
+		nn, e := reader.ListChildren7z(context.Background(), archiveNode, "folder")
+		So(e, ShouldBeNil)
+		So(nn, ShouldHaveLength, 1)
+		So(nn[0].Path, ShouldEqual, "archive.7z/folder/café.txt")
+		So(nn[0].Size, ShouldEqual, 4)
+
+		n, e := reader.StatChild7z(context.Background(), archiveNode, "folder")
+		So(e, ShouldBeNil)
+		So(n.IsLeaf(), ShouldBeFalse)
+
+		// Missing entries are detected without downloading the archive
+		_, e = reader.ReadChild7z(context.Background(), archiveNode, "folder/missing.txt")
+		So(errors.FromError(e).Code, ShouldEqual, 404)
+		_, e = reader.ReadChild7z(context.Background(), archiveNode, "folder")
+		So(errors.FromError(e).Code, ShouldEqual, 404)
+	})
+}
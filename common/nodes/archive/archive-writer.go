@@ -23,8 +23,8 @@ package archive
 import (
 	"archive/tar"
 	"archive/zip"
-	"compress/gzip"
 	"context"
+	"errors"
 	"io"
 	"path"
 	"strings"
@@ -37,11 +37,22 @@ import (
 	"github.com/pydio/cells/v4/common/proto/tree"
 )
 
+// ErrSkipUntilNotFound is returned by TarSelection when the SkipUntil entry cannot be found in the selection anymore
+var ErrSkipUntilNotFound = errors.New("cannot find last archived entry in selection")
+
 type Writer struct {
 	Router nodes.Handler
 
 	// Optional filter when listing nodes to build the archive
 	WalkFilter nodes.WalkFilterFunc
+
+	// Optional internal path of an entry already written by a previous run: TarSelection skips
+	// all entries up to this one, to be appended to a partial archive.
+	SkipUntil string
+	// Optional callback called by TarSelection each time an entry is fully written to the output.
+	OnEntry func(internalPath string)
+	// Optional password: ZipSelection then encrypts all entries with WinZip AES-256.
+	Password string
 }
 
 func (w *Writer) commonRoot(nodes []*tree.Node) string {
@@ -61,6 +72,9 @@ func (w *Writer) ZipSelection(ctx context.Context, output io.Writer, selection [
 
 	z := zip.NewWriter(output)
 	defer z.Close()
+	if w.Password != "" {
+		z.RegisterCompressor(zipMethodAES, aesZipCompressor(w.Password))
+	}
 	var totalSizeWritten int64
 
 	// Make sure to load root nodes
@@ -111,6 +125,9 @@ func (w *Writer) ZipSelection(ctx context.Context, output io.Writer, selection [
 			}
 			header.SetMode(0777)
 			header.Modified = n.GetModTime()
+			if w.Password != "" {
+				encryptZipHeader(header)
+			}
 			r, e1 := w.Router.GetObject(ctx, n, &models.GetRequestData{StartOffset: 0, Length: -1})
 			if nodes.Is403(e1) {
 				// IGNORE
@@ -151,25 +168,23 @@ func (w *Writer) ZipSelection(ctx context.Context, output io.Writer, selection [
 	return totalSizeWritten, nil
 }
 
-// TarSelection creates a .tar or .tar.gz archive from nodes selection
-func (w *Writer) TarSelection(ctx context.Context, output io.Writer, gzipFile bool, selection []*tree.Node, logsChannel ...chan string) (int64, error) {
+// TarSelection creates a tar archive from nodes selection, compressed according to format (tar, tar.gz, tar.bz2, tar.xz or tar.zst)
+func (w *Writer) TarSelection(ctx context.Context, output io.Writer, format string, selection []*tree.Node, logsChannel ...chan string) (int64, error) {
 
-	var tw *tar.Writer
 	var totalSizeWritten int64
 
-	if gzipFile {
-		// set up the gzip writer
-		gw := gzip.NewWriter(output)
-		defer gw.Close()
-
-		tw = tar.NewWriter(gw)
-		defer tw.Close()
-	} else {
-		tw = tar.NewWriter(output)
-		defer tw.Close()
+	// set up the compressor
+	compressor, er := NewTarCompressor(output, format)
+	if er != nil {
+		return 0, er
 	}
+	defer compressor.Close()
+
+	tw := tar.NewWriter(compressor)
+	defer tw.Close()
 
 	parentRoot := w.commonRoot(selection)
+	skipping := w.SkipUntil != ""
 
 	for _, node := range selection {
 
@@ -182,6 +197,12 @@ func (w *Writer) TarSelection(ctx context.Context, output io.Writer, gzipFile bo
 		err := w.Router.ListNodesWithCallback(ctx, request, func(ctx context.Context, n *tree.Node, err error) error {
 
 			internalPath := strings.TrimPrefix(n.Path, parentRoot)
+			if skipping {
+				if internalPath == w.SkipUntil {
+					skipping = false
+				}
+				return nil
+			}
 			header := &tar.Header{
 				Name:    internalPath,
 				ModTime: n.GetModTime(),
@@ -213,6 +234,12 @@ func (w *Writer) TarSelection(ctx context.Context, output io.Writer, gzipFile bo
 
 			size, _ := io.Copy(tw, reader)
 			totalSizeWritten += size
+			if w.OnEntry != nil {
+				if e := tw.Flush(); e != nil {
+					return e
+				}
+				w.OnEntry(internalPath)
+			}
 
 			if len(logsChannel) > 0 {
 				logsChannel[0] <- "File " + internalPath + " added to archive"
@@ -228,6 +255,9 @@ func (w *Writer) TarSelection(ctx context.Context, output io.Writer, gzipFile bo
 		}
 
 	}
+	if skipping {
+		return totalSizeWritten, ErrSkipUntilNotFound
+	}
 
 	return totalSizeWritten, nil
 
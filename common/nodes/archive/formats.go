@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package archive
+
+import (
+	"compress/bzip2"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	dsbzip2 "github.com/dsnet/compress/bzip2"
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+)
+
+// Supported archive formats, as used in file extensions and in virtual paths pointing inside an archive
+const (
+	FormatZip    = "zip"
+	FormatTar    = "tar"
+	FormatTarGz  = "tar.gz"
+	FormatTarBz2 = "tar.bz2"
+	FormatTarXz  = "tar.xz"
+	FormatTarZst = "tar.zst"
+	Format7z     = "7z"
+)
+
+// Formats lists all archive formats that can be browsed.
+var Formats = []string{FormatZip, FormatTar, FormatTarGz, FormatTarBz2, FormatTarXz, FormatTarZst, Format7z}
+
+// IsTarFormat checks if format is a tar archive, compressed or not.
+func IsTarFormat(format string) bool {
+	return format == FormatTar || strings.HasPrefix(format, FormatTar+".")
+}
+
+// FormatFromPath finds the archive format from the file extension, or returns an empty string.
+func FormatFromPath(nodePath string) string {
+	lower := strings.ToLower(nodePath)
+	found := ""
+	for _, f := range Formats {
+		// Keep the longest match, e.g. tar.gz instead of tar
+		if strings.HasSuffix(lower, "."+f) && len(f) > len(found) {
+			found = f
+		}
+	}
+	return found
+}
+
+// uncompressTarStream wraps stream with the decompressor matching the tar format.
+func uncompressTarStream(stream io.Reader, format string) (io.ReadCloser, error) {
+	switch format {
+	case FormatTar:
+		return ioutil.NopCloser(stream), nil
+	case FormatTarGz:
+		return gzip.NewReader(stream)
+	case FormatTarBz2:
+		return ioutil.NopCloser(bzip2.NewReader(stream)), nil
+	case FormatTarXz:
+		r, e := xz.NewReader(stream)
+		if e != nil {
+			return nil, e
+		}
+		return ioutil.NopCloser(r), nil
+	case FormatTarZst:
+		d, e := zstd.NewReader(stream, zstd.WithDecoderConcurrency(1))
+		if e != nil {
+			return nil, e
+		}
+		return d.IOReadCloser(), nil
+	}
+	return nil, fmt.Errorf("unsupported tar format %s", format)
+}
+
+// TarCompressor compresses a tar stream with the algorithm matching the archive format.
+// The compressed stream can be ended and started again with Restart: the output is then a
+// concatenation of compressed streams, which is still read as one by the decompressors.
+type TarCompressor struct {
+	format string
+	output io.Writer
+	stream io.WriteCloser
+}
+
+// NewTarCompressor creates a TarCompressor writing to output. For uncompressed tar, data is written as is.
+func NewTarCompressor(output io.Writer, format string) (*TarCompressor, error) {
+	t := &TarCompressor{format: format, output: output}
+	if e := t.start(); e != nil {
+		return nil, e
+	}
+	return t, nil
+}
+
+func (t *TarCompressor) start() (e error) {
+	switch t.format {
+	case FormatTar:
+		t.stream = nil
+	case FormatTarGz:
+		t.stream = gzip.NewWriter(t.output)
+	case FormatTarBz2:
+		t.stream, e = dsbzip2.NewWriter(t.output, &dsbzip2.WriterConfig{Level: dsbzip2.DefaultCompression})
+	case FormatTarXz:
+		t.stream, e = xz.NewWriter(t.output)
+	case FormatTarZst:
+		t.stream, e = zstd.NewWriter(t.output, zstd.WithEncoderConcurrency(1))
+	default:
+		e = fmt.Errorf("unsupported tar format %s", t.format)
+	}
+	return
+}
+
+// Write implements io.Writer
+func (t *TarCompressor) Write(p []byte) (int, error) {
+	if t.stream == nil {
+		return t.output.Write(p)
+	}
+	return t.stream.Write(p)
+}
+
+// Restart ends the current compressed stream and starts a new one: all data written so far is
+// flushed to the output and can be decompressed on its own.
+func (t *TarCompressor) Restart() error {
+	if e := t.Close(); e != nil {
+		return e
+	}
+	return t.start()
+}
+
+// Close ends the compressed stream. It does not close the underlying output.
+func (t *TarCompressor) Close() error {
+	if t.stream == nil {
+		return nil
+	}
+	return t.stream.Close()
+}
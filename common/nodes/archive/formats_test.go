@@ -0,0 +1,194 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/nodes"
+	"github.com/pydio/cells/v4/common/proto/tree"
+	"github.com/pydio/cells/v4/common/utils/uuid"
+)
+
+// writeTempTar builds a tar archive with a file and a folder, restarting the compressed stream between entries.
+func writeTempTar(format string) (*tree.Node, string, error) {
+	buf := &bytes.Buffer{}
+	compressor, e := NewTarCompressor(buf, format)
+	if e != nil {
+		return nil, "", e
+	}
+	tw := tar.NewWriter(compressor)
+	mTime := time.Unix(1506070808, 0)
+	if e := tw.WriteHeader(&tar.Header{Name: "AFolder/", Typeflag: tar.TypeDir, Mode: 0777, ModTime: mTime}); e != nil {
+		return nil, "", e
+	}
+	if e := tw.Flush(); e != nil {
+		return nil, "", e
+	}
+	if e := compressor.Restart(); e != nil {
+		return nil, "", e
+	}
+	content := []byte("hello world")
+	if e := tw.WriteHeader(&tar.Header{Name: "AFolder/file.txt", Typeflag: tar.TypeReg, Mode: 0777, Size: int64(len(content)), ModTime: mTime}); e != nil {
+		return nil, "", e
+	}
+	if _, e := tw.Write(content); e != nil {
+		return nil, "", e
+	}
+	if e := tw.Close(); e != nil {
+		return nil, "", e
+	}
+	if e := compressor.Close(); e != nil {
+		return nil, "", e
+	}
+
+	nodeUuid := uuid.New()
+	tmpArchive := filepath.Join(os.TempDir(), nodeUuid)
+	if e := ioutil.WriteFile(tmpArchive, buf.Bytes(), 0755); e != nil {
+		return nil, "", e
+	}
+	archiveNode := &tree.Node{
+		Path: "fake-path",
+		Uuid: nodeUuid,
+	}
+	archiveNode.MustSetMeta(common.MetaNamespaceNodeTestLocalFolder, os.TempDir())
+	return archiveNode, tmpArchive, nil
+}
+
+func TestFormats(t *testing.T) {
+
+	Convey("Detect format from path", t, func() {
+		So(FormatFromPath("path/to/archive.zip"), ShouldEqual, FormatZip)
+		So(FormatFromPath("path/to/archive.tar"), ShouldEqual, FormatTar)
+		So(FormatFromPath("path/to/archive.tar.gz"), ShouldEqual, FormatTarGz)
+		So(FormatFromPath("path/to/archive.TAR.ZST"), ShouldEqual, FormatTarZst)
+		So(FormatFromPath("path/to/archive.tar.xz"), ShouldEqual, FormatTarXz)
+		So(FormatFromPath("path/to/archive.tar.bz2"), ShouldEqual, FormatTarBz2)
+		So(FormatFromPath("path/to/archive.7z"), ShouldEqual, Format7z)
+		So(FormatFromPath("path/to/file.gz"), ShouldBeEmpty)
+		So(IsTarFormat(FormatTarXz), ShouldBeTrue)
+		So(IsTarFormat(FormatTar), ShouldBeTrue)
+		So(IsTarFormat(Format7z), ShouldBeFalse)
+	})
+
+	Convey("Detect archive virtual paths", t, func() {
+		a := &Handler{}
+		ok, format, archivePath, innerPath := a.isArchivePath("path/to/archive.tar.zst/AFolder/file.txt")
+		So(ok, ShouldBeTrue)
+		So(format, ShouldEqual, FormatTarZst)
+		So(archivePath, ShouldEqual, "path/to/archive.tar.zst")
+		So(innerPath, ShouldEqual, "AFolder/file.txt")
+		ok, format, _, innerPath = a.isArchivePath("path/to/archive.7z")
+		So(ok, ShouldBeTrue)
+		So(format, ShouldEqual, Format7z)
+		So(innerPath, ShouldBeEmpty)
+	})
+
+	for _, format := range []string{FormatTarGz, FormatTarBz2, FormatTarXz, FormatTarZst} {
+
+		Convey("List, stat and read "+format+" archive", t, func() {
+
+			archiveNode, tmpArchive, e := writeTempTar(format)
+			So(e, ShouldBeNil)
+			defer os.Remove(tmpArchive)
+
+			archiveReader := &Reader{
+				Router: nodes.NewHandlerMock(),
+			}
+			results, e := archiveReader.ListChildrenTar(context.Background(), format, archiveNode, "AFolder")
+			So(e, ShouldBeNil)
+			So(results, ShouldHaveLength, 1)
+			So(results[0].Path, ShouldEqual, "fake-path/AFolder/file.txt")
+
+			stat, e := archiveReader.StatChildTar(context.Background(), format, archiveNode, "AFolder/file.txt")
+			So(e, ShouldBeNil)
+			So(stat, ShouldResemble, &tree.Node{
+				Path:  "fake-path/AFolder/file.txt",
+				Type:  tree.NodeType_LEAF,
+				Size:  11,
+				MTime: 1506070808,
+			})
+
+			tmpFile := filepath.Join(os.TempDir(), uuid.New())
+			tmpWriter, _ := os.OpenFile(tmpFile, os.O_CREATE|os.O_WRONLY, 0755)
+			defer os.Remove(tmpFile)
+			written, e := archiveReader.ReadChildTar(context.Background(), format, tmpWriter, archiveNode, "AFolder/file.txt")
+			So(e, ShouldBeNil)
+			So(written, ShouldEqual, 11)
+			content, _ := ioutil.ReadFile(tmpFile)
+			So(string(content), ShouldEqual, "hello world")
+
+		})
+
+	}
+
+	Convey("List, stat and read 7z archive with missing folders", t, func() {
+
+		archiveNode, tmpArchive, e := getTempArchive("MissingFolders.7z")
+		So(e, ShouldBeNil)
+		defer os.Remove(tmpArchive)
+
+		archiveReader := &Reader{
+			Router: nodes.NewHandlerMock(),
+		}
+		results, e := archiveReader.ListChildren7z(context.Background(), archiveNode, "")
+		So(e, ShouldBeNil)
+		So(results, ShouldHaveLength, 2)
+
+		results, e = archiveReader.ListChildren7z(context.Background(), archiveNode, "AFolder")
+		So(e, ShouldBeNil)
+		So(results, ShouldHaveLength, 1)
+		So(results[0].Path, ShouldEqual, "fake-path/AFolder/sub")
+		So(results[0].Type, ShouldEqual, tree.NodeType_COLLECTION)
+
+		stat, e := archiveReader.StatChild7z(context.Background(), archiveNode, "AFolder")
+		So(e, ShouldBeNil)
+		So(stat, ShouldResemble, &tree.Node{
+			Path:  "fake-path/AFolder",
+			Type:  tree.NodeType_COLLECTION,
+			MTime: 1506070808,
+		})
+		stat, e = archiveReader.StatChild7z(context.Background(), archiveNode, "Dir/a.txt")
+		So(e, ShouldBeNil)
+		So(stat.Size, ShouldEqual, 6)
+		_, e = archiveReader.StatChild7z(context.Background(), archiveNode, "Dir/missing.txt")
+		So(e, ShouldNotBeNil)
+
+		reader, e := archiveReader.ReadChild7z(context.Background(), archiveNode, "AFolder/sub/b.txt")
+		So(e, ShouldBeNil)
+		content, e := ioutil.ReadAll(reader)
+		So(e, ShouldBeNil)
+		So(reader.Close(), ShouldBeNil)
+		So(string(content), ShouldEqual, "world!\n")
+
+	})
+
+}
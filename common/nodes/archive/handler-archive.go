@@ -83,16 +83,15 @@ func (a *Handler) GetObject(ctx context.Context, node *tree.Node, requestData *m
 		archiveNode := statResp.Node
 		log.Logger(ctx).Debug("[ARCHIVE:GET] "+archivePath+" -- "+innerPath, zap.Any("archiveNode", archiveNode))
 
-		if format == "zip" {
+		switch format {
+		case FormatZip:
 			return extractor.ReadChildZip(ctx, archiveNode, innerPath)
-		} else {
+		case Format7z:
+			return extractor.ReadChild7z(ctx, archiveNode, innerPath)
+		default:
 			reader, writer := io.Pipe()
-			gzip := false
-			if format == "tar.gz" {
-				gzip = true
-			}
 			go func() {
-				extractor.ReadChildTar(ctx, gzip, writer, archiveNode, innerPath)
+				extractor.ReadChildTar(ctx, format, writer, archiveNode, innerPath)
 			}()
 			return reader, nil
 		}
@@ -107,7 +106,7 @@ func (a *Handler) GetObject(ctx context.Context, node *tree.Node, requestData *m
 				return readCloser, er
 			}
 			if ok && len(selection) > 0 {
-				ext := strings.Trim(path.Ext(originalPath), ".")
+				ext := FormatFromPath(originalPath)
 				r, w := io.Pipe()
 				go func() {
 					defer w.Close()
@@ -152,14 +151,13 @@ func (a *Handler) ReadNode(ctx context.Context, in *tree.ReadNodeRequest, opts .
 
 		var statNode *tree.Node
 		var err error
-		if format == "zip" {
+		switch format {
+		case FormatZip:
 			statNode, err = extractor.StatChildZip(ctx, archiveNode, innerPath)
-		} else {
-			gzip := false
-			if format == "tar.gz" {
-				gzip = true
-			}
-			statNode, err = extractor.StatChildTar(ctx, gzip, archiveNode, innerPath)
+		case Format7z:
+			statNode, err = extractor.StatChild7z(ctx, archiveNode, innerPath)
+		default:
+			statNode, err = extractor.StatChildTar(ctx, format, archiveNode, innerPath)
 		}
 		if err == nil {
 			if statNode.Size == 0 {
@@ -228,14 +226,13 @@ func (a *Handler) ListNodes(ctx context.Context, in *tree.ListNodesRequest, opts
 		log.Logger(ctx).Debug("[ARCHIVE:LIST] "+archivePath+" -- "+innerPath, zap.Any("archiveNode", archiveNode))
 		var children []*tree.Node
 		var err error
-		if format == "zip" {
+		switch format {
+		case FormatZip:
 			children, err = extractor.ListChildrenZip(ctx, archiveNode, innerPath)
-		} else {
-			gzip := false
-			if format == "tar.gz" {
-				gzip = true
-			}
-			children, err = extractor.ListChildrenTar(ctx, gzip, archiveNode, innerPath)
+		case Format7z:
+			children, err = extractor.ListChildren7z(ctx, archiveNode, innerPath)
+		default:
+			children, err = extractor.ListChildrenTar(ctx, format, archiveNode, innerPath)
 		}
 		streamer := nodes.NewWrappingStreamer(ctx)
 		if err != nil {
@@ -255,8 +252,7 @@ func (a *Handler) ListNodes(ctx context.Context, in *tree.ListNodesRequest, opts
 }
 
 func (a *Handler) isArchivePath(nodePath string) (ok bool, format string, archivePath string, innerPath string) {
-	formats := []string{"zip", "tar", "tar.gz"}
-	for _, f := range formats {
+	for _, f := range Formats {
 		test := strings.SplitN(nodePath, "."+f+"/", 2)
 		if len(test) == 2 {
 			return true, f, test[0] + "." + f, test[1]
@@ -310,7 +306,7 @@ func (a *Handler) generateArchiveFromFolder(ctx context.Context, writer io.Write
 
 		n, er := a.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Path: noExt}})
 		if er == nil && n != nil {
-			ext := strings.Trim(path.Ext(nodePath), ".")
+			ext := FormatFromPath(nodePath)
 			err := a.generateArchiveFromSelection(ctx, writer, []*tree.Node{n.Node}, ext)
 			return true, err
 		}
@@ -326,15 +322,12 @@ func (a *Handler) generateArchiveFromSelection(ctx context.Context, writer io.Wr
 		Router: a,
 	}
 	var err error
-	if format == "zip" {
+	if format == FormatZip {
 		log.Logger(ctx).Debug("This is a zip, create a zip on the fly")
 		_, err = archiveWriter.ZipSelection(ctx, writer, selection)
-	} else if format == "tar" {
-		log.Logger(ctx).Debug("This is a tar, create a tar on the fly")
-		_, err = archiveWriter.TarSelection(ctx, writer, false, selection)
-	} else if format == "gz" {
-		log.Logger(ctx).Debug("This is a tar.gz, create a tar.gz on the fly")
-		_, err = archiveWriter.TarSelection(ctx, writer, true, selection)
+	} else if IsTarFormat(format) {
+		log.Logger(ctx).Debug("This is a " + format + ", create a " + format + " on the fly")
+		_, err = archiveWriter.TarSelection(ctx, writer, format, selection)
 	}
 
 	return err
@@ -0,0 +1,334 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package archive
+
+import (
+	"archive/zip"
+	"compress/flate"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// WinZip AES encryption, see https://www.winzip.com/en/support/aes-encryption/
+const (
+	zipFlagEncrypted = 0x1
+	zipMethodAES     = 99
+	zipExtraAES      = 0x9901
+
+	aesStrength256 = 3
+	aesPwvSize     = 2
+	aesMacSize     = 10
+	aesIterations  = 1000
+)
+
+var (
+	// ErrZipPasswordRequired is returned when reading an encrypted zip entry without password
+	ErrZipPasswordRequired = errors.New("zip entry is encrypted, a password is required")
+	// ErrZipWrongPassword is returned when the password does not match the encrypted zip entry
+	ErrZipWrongPassword = errors.New("wrong password for encrypted zip entry")
+	// ErrZipAuthentication is returned when the authentication code of an encrypted zip entry does not match its content
+	ErrZipAuthentication = errors.New("encrypted zip entry failed authentication, it may have been corrupted or tampered")
+	// ErrZipUnsupportedEncryption is returned for encrypted zip entries that do not use WinZip AES encryption
+	ErrZipUnsupportedEncryption = errors.New("unsupported zip encryption, only WinZip AES is supported")
+)
+
+// aesKeySize returns the AES key size for a given WinZip strength value. Salt size is half the key size.
+func aesKeySize(strength byte) int {
+	switch strength {
+	case 1:
+		return 16
+	case 2:
+		return 24
+	case 3:
+		return 32
+	}
+	return 0
+}
+
+// aesDeriveKeys derives the encryption key, authentication key and password verification value from a password
+func aesDeriveKeys(password string, salt []byte, keySize int) (encKey, macKey, pwv []byte) {
+	keys := pbkdf2.Key([]byte(password), salt, aesIterations, 2*keySize+aesPwvSize, sha1.New)
+	return keys[:keySize], keys[keySize : 2*keySize], keys[2*keySize:]
+}
+
+// encryptZipHeader marks a zip header as WinZip AES-256 (AE-1) encrypted, the actual compression being deflate.
+// Entries must be written with a zip.Writer using aesZipCompressor for the zipMethodAES method.
+func encryptZipHeader(header *zip.FileHeader) {
+	extra := make([]byte, 11)
+	binary.LittleEndian.PutUint16(extra[0:], zipExtraAES)
+	binary.LittleEndian.PutUint16(extra[2:], 7)
+	binary.LittleEndian.PutUint16(extra[4:], 1)
+	copy(extra[6:], "AE")
+	extra[8] = aesStrength256
+	binary.LittleEndian.PutUint16(extra[9:], zip.Deflate)
+	header.Extra = append(header.Extra, extra...)
+	header.Flags |= zipFlagEncrypted
+	header.Method = zipMethodAES
+}
+
+// aesZipCompressor returns a zip.Compressor deflating then encrypting entries with AES-256. Each entry
+// gets its own random salt.
+func aesZipCompressor(password string) zip.Compressor {
+	return func(w io.Writer) (io.WriteCloser, error) {
+		keySize := aesKeySize(aesStrength256)
+		salt := make([]byte, keySize/2)
+		if _, e := rand.Read(salt); e != nil {
+			return nil, e
+		}
+		encKey, macKey, pwv := aesDeriveKeys(password, salt, keySize)
+		block, e := aes.NewCipher(encKey)
+		if e != nil {
+			return nil, e
+		}
+		enc := &aesEncrypter{w: w, prefix: append(salt, pwv...), ctr: newWinzipCTR(block), mac: hmac.New(sha1.New, macKey)}
+		fw, e := flate.NewWriter(enc, flate.DefaultCompression)
+		if e != nil {
+			return nil, e
+		}
+		return &aesZipWriter{Writer: fw, enc: enc}, nil
+	}
+}
+
+// openZipEntry opens an entry of a zip archive, decrypting it with password if it is WinZip AES encrypted
+func openZipEntry(file *zip.File, password string) (io.ReadCloser, error) {
+	if file.Flags&zipFlagEncrypted == 0 {
+		return file.Open()
+	}
+	if file.Method != zipMethodAES {
+		return nil, ErrZipUnsupportedEncryption
+	}
+	raw, e := file.OpenRaw()
+	if e != nil {
+		return nil, e
+	}
+	return decryptZipEntry(&file.FileHeader, raw, password)
+}
+
+// decryptZipEntry decrypts and decompresses the raw content of a WinZip AES encrypted entry
+func decryptZipEntry(file *zip.FileHeader, raw io.Reader, password string) (io.ReadCloser, error) {
+	if password == "" {
+		return nil, ErrZipPasswordRequired
+	}
+	version, strength, method, ok := parseAESExtra(file.Extra)
+	keySize := aesKeySize(strength)
+	if !ok || keySize == 0 {
+		return nil, ErrZipUnsupportedEncryption
+	}
+	header := make([]byte, keySize/2+aesPwvSize)
+	if _, e := io.ReadFull(raw, header); e != nil {
+		return nil, e
+	}
+	encKey, macKey, pwv := aesDeriveKeys(password, header[:keySize/2], keySize)
+	if !hmac.Equal(pwv, header[keySize/2:]) {
+		return nil, ErrZipWrongPassword
+	}
+	dataSize := int64(file.CompressedSize64) - int64(len(header)) - aesMacSize
+	if dataSize < 0 {
+		return nil, zip.ErrFormat
+	}
+	block, e := aes.NewCipher(encKey)
+	if e != nil {
+		return nil, e
+	}
+	dec := &aesDecrypter{r: io.LimitReader(raw, dataSize), raw: raw, ctr: newWinzipCTR(block), mac: hmac.New(sha1.New, macKey)}
+	reader := &aesZipReader{dec: dec}
+	switch method {
+	case zip.Store:
+		reader.rc = ioutil.NopCloser(dec)
+	case zip.Deflate:
+		reader.rc = flate.NewReader(dec)
+	default:
+		return nil, zip.ErrAlgorithm
+	}
+	// AE-2 entries do not store a CRC, only AE-1 entries are checked
+	if version == 1 {
+		reader.crc = crc32.NewIEEE()
+		reader.expectedCRC = file.CRC32
+	}
+	return reader, nil
+}
+
+// parseAESExtra finds the WinZip AES extra field and returns its vendor version, strength and actual compression method
+func parseAESExtra(extra []byte) (version uint16, strength byte, method uint16, ok bool) {
+	for len(extra) >= 4 {
+		tag := binary.LittleEndian.Uint16(extra[0:])
+		size := int(binary.LittleEndian.Uint16(extra[2:]))
+		if len(extra) < 4+size {
+			break
+		}
+		if tag == zipExtraAES && size >= 7 && string(extra[6:8]) == "AE" {
+			return binary.LittleEndian.Uint16(extra[4:]), extra[8], binary.LittleEndian.Uint16(extra[9:]), true
+		}
+		extra = extra[4+size:]
+	}
+	return
+}
+
+// winzipCTR implements AES in CTR mode as used by WinZip: the counter is little-endian and starts at 1
+type winzipCTR struct {
+	block   cipher.Block
+	counter [aes.BlockSize]byte
+	stream  [aes.BlockSize]byte
+	pos     int
+}
+
+func newWinzipCTR(block cipher.Block) *winzipCTR {
+	return &winzipCTR{block: block, pos: aes.BlockSize}
+}
+
+func (c *winzipCTR) XORKeyStream(dst, src []byte) {
+	for i := range src {
+		if c.pos == aes.BlockSize {
+			for j := range c.counter {
+				c.counter[j]++
+				if c.counter[j] != 0 {
+					break
+				}
+			}
+			c.block.Encrypt(c.stream[:], c.counter[:])
+			c.pos = 0
+		}
+		dst[i] = src[i] ^ c.stream[c.pos]
+		c.pos++
+	}
+}
+
+// aesEncrypter encrypts data and computes its authentication code. Salt and password verification value
+// are written as a prefix before the first encrypted bytes, as the zip.Writer creates the compressor before
+// writing the entry header.
+type aesEncrypter struct {
+	w      io.Writer
+	prefix []byte
+	ctr    *winzipCTR
+	mac    hash.Hash
+}
+
+func (a *aesEncrypter) writePrefix() error {
+	if a.prefix == nil {
+		return nil
+	}
+	_, e := a.w.Write(a.prefix)
+	a.prefix = nil
+	return e
+}
+
+func (a *aesEncrypter) Write(p []byte) (int, error) {
+	if e := a.writePrefix(); e != nil {
+		return 0, e
+	}
+	buf := make([]byte, len(p))
+	a.ctr.XORKeyStream(buf, p)
+	a.mac.Write(buf)
+	if _, e := a.w.Write(buf); e != nil {
+		return 0, e
+	}
+	return len(p), nil
+}
+
+// aesZipWriter compresses data before encryption, and appends the authentication code on Close
+type aesZipWriter struct {
+	*flate.Writer
+	enc *aesEncrypter
+}
+
+func (a *aesZipWriter) Close() error {
+	if e := a.Writer.Close(); e != nil {
+		return e
+	}
+	if e := a.enc.writePrefix(); e != nil {
+		return e
+	}
+	_, e := a.enc.w.Write(a.enc.mac.Sum(nil)[:aesMacSize])
+	return e
+}
+
+// aesDecrypter decrypts data and checks the authentication code stored after it
+type aesDecrypter struct {
+	r        io.Reader
+	raw      io.Reader
+	ctr      *winzipCTR
+	mac      hash.Hash
+	verified bool
+}
+
+func (a *aesDecrypter) Read(p []byte) (int, error) {
+	n, err := a.r.Read(p)
+	a.mac.Write(p[:n])
+	a.ctr.XORKeyStream(p[:n], p[:n])
+	return n, err
+}
+
+func (a *aesDecrypter) verify() error {
+	if a.verified {
+		return nil
+	}
+	if _, e := io.Copy(ioutil.Discard, a); e != nil {
+		return e
+	}
+	code := make([]byte, aesMacSize)
+	if _, e := io.ReadFull(a.raw, code); e != nil {
+		return e
+	}
+	if !hmac.Equal(code, a.mac.Sum(nil)[:aesMacSize]) {
+		return ErrZipAuthentication
+	}
+	a.verified = true
+	return nil
+}
+
+// aesZipReader reads a decrypted entry, checking its authentication code and CRC once fully read
+type aesZipReader struct {
+	rc          io.ReadCloser
+	dec         *aesDecrypter
+	crc         hash.Hash32
+	expectedCRC uint32
+}
+
+func (a *aesZipReader) Read(p []byte) (int, error) {
+	n, err := a.rc.Read(p)
+	if a.crc != nil {
+		a.crc.Write(p[:n])
+	}
+	if err == io.EOF {
+		if e := a.dec.verify(); e != nil {
+			return n, e
+		}
+		if a.crc != nil && a.crc.Sum32() != a.expectedCRC {
+			return n, zip.ErrChecksum
+		}
+	}
+	return n, err
+}
+
+func (a *aesZipReader) Close() error {
+	return a.rc.Close()
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/v4/common/nodes"
+)
+
+func TestZipAES(t *testing.T) {
+
+	content := []byte("some content that should be encrypted, some content that should be encrypted")
+
+	encrypted := func() []byte {
+		buf := &bytes.Buffer{}
+		z := zip.NewWriter(buf)
+		z.RegisterCompressor(zipMethodAES, aesZipCompressor("secret"))
+		for _, name := range []string{"file.txt", "empty.txt"} {
+			header := &zip.FileHeader{Name: name, Method: zip.Deflate}
+			encryptZipHeader(header)
+			w, e := z.CreateHeader(header)
+			So(e, ShouldBeNil)
+			if name == "file.txt" {
+				_, e = w.Write(content)
+				So(e, ShouldBeNil)
+			}
+		}
+		So(z.Close(), ShouldBeNil)
+		return buf.Bytes()
+	}
+
+	Convey("Write and read an AES encrypted zip", t, func() {
+		data := encrypted()
+		So(bytes.Contains(data, content), ShouldBeFalse)
+
+		reader, e := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		So(e, ShouldBeNil)
+		So(reader.File, ShouldHaveLength, 2)
+		So(reader.File[0].Method, ShouldEqual, zipMethodAES)
+
+		rc, e := openZipEntry(reader.File[0], "secret")
+		So(e, ShouldBeNil)
+		read, e := ioutil.ReadAll(rc)
+		So(e, ShouldBeNil)
+		So(read, ShouldResemble, content)
+		So(rc.Close(), ShouldBeNil)
+
+		rc, e = openZipEntry(reader.File[1], "secret")
+		So(e, ShouldBeNil)
+		read, e = ioutil.ReadAll(rc)
+		So(e, ShouldBeNil)
+		So(read, ShouldBeEmpty)
+
+		_, e = openZipEntry(reader.File[0], "wrong")
+		So(e, ShouldEqual, ErrZipWrongPassword)
+		_, e = openZipEntry(reader.File[0], "")
+		So(e, ShouldEqual, ErrZipPasswordRequired)
+	})
+
+	Convey("Detect tampered AES encrypted zip", t, func() {
+		data := encrypted()
+		reader, e := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		So(e, ShouldBeNil)
+		offset, e := reader.File[0].DataOffset()
+		So(e, ShouldBeNil)
+		// Flip a byte after salt and password verification value
+		data[offset+20] ^= 0xff
+
+		rc, e := openZipEntry(reader.File[0], "secret")
+		So(e, ShouldBeNil)
+		_, e = ioutil.ReadAll(rc)
+		So(e, ShouldNotBeNil)
+	})
+
+	Convey("Read AES encrypted zip created by another tool", t, func() {
+		archiveNode, tmpArchive, e := getTempArchive("Encrypted.zip")
+		So(e, ShouldBeNil)
+		defer os.Remove(tmpArchive)
+
+		archiveReader := &Reader{
+			Router: nodes.NewHandlerMock(),
+		}
+		_, e = archiveReader.ReadChildZip(context.Background(), archiveNode, "r.txt")
+		So(e, ShouldEqual, ErrZipPasswordRequired)
+
+		archiveReader.Password = "secret"
+		rc, e := archiveReader.ReadChildZip(context.Background(), archiveNode, "r.txt")
+		So(e, ShouldBeNil)
+		read, e := ioutil.ReadAll(rc)
+		So(e, ShouldBeNil)
+		So(string(read), ShouldEqual, "hello reference\n")
+		So(rc.Close(), ShouldBeNil)
+	})
+
+}
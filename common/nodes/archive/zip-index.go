@@ -0,0 +1,400 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package archive
+
+import (
+	"archive/zip"
+	"compress/flate"
+	"context"
+	"encoding/binary"
+	"hash"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/log"
+	"github.com/pydio/cells/v4/common/nodes/models"
+	"github.com/pydio/cells/v4/common/proto/tree"
+	"github.com/pydio/cells/v4/common/utils/cache"
+)
+
+// Zip structures, see https://pkware.cachefly.net/webdocs/casestudies/APPNOTE.TXT
+const (
+	zipFileHeaderSig = 0x04034b50
+	zipDirHeaderSig  = 0x02014b50
+	zipDirEndSig     = 0x06054b50
+	zipDir64LocSig   = 0x07064b50
+	zipDir64EndSig   = 0x06064b50
+
+	zipFileHeaderLen = 30
+	zipDirHeaderLen  = 46
+	zipDirEndLen     = 22
+	zipDir64LocLen   = 20
+	zipDir64EndLen   = 56
+	zipMaxCommentLen = 65535
+
+	zipExtraZip64     = 0x0001
+	zipExtraTimestamp = 0x5455
+)
+
+var (
+	// zipIndexCache keeps parsed central directories by archive ETag
+	zipIndexCache = cache.NewShort(cache.WithEviction(10*time.Minute), cache.WithCleanWindow(20*time.Minute))
+)
+
+// zipIndex is the parsed central directory of a zip archive
+type zipIndex struct {
+	entries []*zipIndexEntry
+}
+
+// zipIndexEntry is a central directory entry, along with the offset of its local header in the archive
+type zipIndexEntry struct {
+	zip.FileHeader
+	headerOffset int64
+}
+
+// find looks up an entry by its internal path
+func (z *zipIndex) find(innerPath string) (*zipIndexEntry, bool) {
+	for _, entry := range z.entries {
+		if entry.Name == innerPath || entry.Name == "/"+innerPath {
+			return entry, true
+		}
+	}
+	return nil, false
+}
+
+// openArchiveRange opens a reader on a byte range of the archive
+func (a *Reader) openArchiveRange(ctx context.Context, archiveNode *tree.Node, offset, length int64) (io.ReadCloser, error) {
+	if length == 0 {
+		return ioutil.NopCloser(strings.NewReader("")), nil
+	}
+	if localFolder := archiveNode.GetStringMeta(common.MetaNamespaceNodeTestLocalFolder); localFolder != "" {
+		f, e := os.Open(filepath.Join(localFolder, archiveNode.Uuid))
+		if e != nil {
+			return nil, e
+		}
+		if _, e := f.Seek(offset, io.SeekStart); e != nil {
+			f.Close()
+			return nil, e
+		}
+		return &zipEntryReader{Reader: io.LimitReader(f, length), closers: []io.Closer{f}}, nil
+	}
+	return a.Router.GetObject(ctx, archiveNode, &models.GetRequestData{StartOffset: offset, Length: length})
+}
+
+// readArchiveRange fully reads a byte range of the archive
+func (a *Reader) readArchiveRange(ctx context.Context, archiveNode *tree.Node, offset, length int64) ([]byte, error) {
+	rc, e := a.openArchiveRange(ctx, archiveNode, offset, length)
+	if e != nil {
+		return nil, e
+	}
+	defer rc.Close()
+	data := make([]byte, length)
+	if _, e := io.ReadFull(rc, data); e != nil {
+		return nil, e
+	}
+	return data, nil
+}
+
+// archiveSize returns the size of the archive, stating the local file in test environment
+func (a *Reader) archiveSize(archiveNode *tree.Node) (int64, error) {
+	if localFolder := archiveNode.GetStringMeta(common.MetaNamespaceNodeTestLocalFolder); localFolder != "" {
+		s, e := os.Stat(filepath.Join(localFolder, archiveNode.Uuid))
+		if e != nil {
+			return 0, e
+		}
+		return s.Size(), nil
+	}
+	return archiveNode.GetSize(), nil
+}
+
+// loadZipIndex reads the central directory of a zip archive with ranged requests, without downloading the
+// entries content. Parsed indexes are cached by archive ETag.
+func (a *Reader) loadZipIndex(ctx context.Context, archiveNode *tree.Node) (*zipIndex, error) {
+
+	etag := archiveNode.GetEtag()
+	cacheable := etag != "" && etag != common.NodeFlagEtagTemporary
+	if cacheable {
+		if cached, ok := zipIndexCache.Get(etag); ok {
+			return cached.(*zipIndex), nil
+		}
+	}
+	size, e := a.archiveSize(archiveNode)
+	if e != nil {
+		return nil, e
+	}
+
+	// Read the end of central directory record, that may be followed by a comment
+	tailLen := int64(zipDirEndLen + zipMaxCommentLen)
+	if tailLen > size {
+		tailLen = size
+	}
+	tailOffset := size - tailLen
+	tail, e := a.readArchiveRange(ctx, archiveNode, tailOffset, tailLen)
+	if e != nil {
+		return nil, e
+	}
+	// readRange avoids new requests for data that is already contained in the tail
+	readRange := func(offset, length int64) ([]byte, error) {
+		if offset < 0 || length < 0 || offset+length > size {
+			return nil, zip.ErrFormat
+		}
+		if offset >= tailOffset {
+			return tail[offset-tailOffset : offset-tailOffset+length], nil
+		}
+		return a.readArchiveRange(ctx, archiveNode, offset, length)
+	}
+
+	end := -1
+	for i := len(tail) - zipDirEndLen; i >= 0; i-- {
+		if binary.LittleEndian.Uint32(tail[i:]) == zipDirEndSig {
+			end = i
+			break
+		}
+	}
+	if end < 0 {
+		return nil, zip.ErrFormat
+	}
+	records := uint64(binary.LittleEndian.Uint16(tail[end+10:]))
+	dirSize := uint64(binary.LittleEndian.Uint32(tail[end+12:]))
+	dirOffset := uint64(binary.LittleEndian.Uint32(tail[end+16:]))
+	if records == 0xffff || dirSize == 0xffffffff || dirOffset == 0xffffffff {
+		// Zip64 archive: locator is right before the end record, and points to the zip64 end record
+		// If there is no locator, values are kept as is: the archive may have exactly 65535 entries
+		if loc, e := readRange(tailOffset+int64(end)-zipDir64LocLen, zipDir64LocLen); e == nil && binary.LittleEndian.Uint32(loc) == zipDir64LocSig {
+			end64, e := readRange(int64(binary.LittleEndian.Uint64(loc[8:])), zipDir64EndLen)
+			if e != nil {
+				return nil, e
+			}
+			if binary.LittleEndian.Uint32(end64) != zipDir64EndSig {
+				return nil, zip.ErrFormat
+			}
+			records = binary.LittleEndian.Uint64(end64[32:])
+			dirSize = binary.LittleEndian.Uint64(end64[40:])
+			dirOffset = binary.LittleEndian.Uint64(end64[48:])
+		}
+	}
+	if dirSize > uint64(size) || dirOffset > uint64(size) {
+		return nil, zip.ErrFormat
+	}
+	dir, e := readRange(int64(dirOffset), int64(dirSize))
+	if e != nil {
+		return nil, e
+	}
+	index, e := parseZipDirectory(dir)
+	if e != nil {
+		return nil, e
+	}
+	// Like archive/zip, only compare the low 16 bits, as some writers overflow the 16 bits counter
+	if uint16(len(index.entries)) != uint16(records) {
+		return nil, zip.ErrFormat
+	}
+	log.Logger(ctx).Debug("Loaded zip index", zap.String("archive", archiveNode.GetPath()), zap.Int("entries", len(index.entries)), zap.Uint64("directory size", dirSize))
+	if cacheable {
+		zipIndexCache.Set(etag, index)
+	}
+	return index, nil
+}
+
+// parseZipDirectory parses all entries of a zip central directory
+func parseZipDirectory(dir []byte) (*zipIndex, error) {
+	index := &zipIndex{}
+	for len(dir) > 0 {
+		if len(dir) < zipDirHeaderLen || binary.LittleEndian.Uint32(dir) != zipDirHeaderSig {
+			return nil, zip.ErrFormat
+		}
+		entry := &zipIndexEntry{
+			FileHeader: zip.FileHeader{
+				CreatorVersion:     binary.LittleEndian.Uint16(dir[4:]),
+				ReaderVersion:      binary.LittleEndian.Uint16(dir[6:]),
+				Flags:              binary.LittleEndian.Uint16(dir[8:]),
+				Method:             binary.LittleEndian.Uint16(dir[10:]),
+				ModifiedTime:       binary.LittleEndian.Uint16(dir[12:]),
+				ModifiedDate:       binary.LittleEndian.Uint16(dir[14:]),
+				CRC32:              binary.LittleEndian.Uint32(dir[16:]),
+				CompressedSize64:   uint64(binary.LittleEndian.Uint32(dir[20:])),
+				UncompressedSize64: uint64(binary.LittleEndian.Uint32(dir[24:])),
+				ExternalAttrs:      binary.LittleEndian.Uint32(dir[38:]),
+			},
+			headerOffset: int64(binary.LittleEndian.Uint32(dir[42:])),
+		}
+		nameLen := int(binary.LittleEndian.Uint16(dir[28:]))
+		extraLen := int(binary.LittleEndian.Uint16(dir[30:]))
+		commentLen := int(binary.LittleEndian.Uint16(dir[32:]))
+		recordLen := zipDirHeaderLen + nameLen + extraLen + commentLen
+		if len(dir) < recordLen {
+			return nil, zip.ErrFormat
+		}
+		entry.Name = string(dir[zipDirHeaderLen : zipDirHeaderLen+nameLen])
+		entry.Extra = dir[zipDirHeaderLen+nameLen : zipDirHeaderLen+nameLen+extraLen]
+		entry.Comment = string(dir[zipDirHeaderLen+nameLen+extraLen : recordLen])
+		if e := entry.parseExtra(); e != nil {
+			return nil, e
+		}
+		index.entries = append(index.entries, entry)
+		dir = dir[recordLen:]
+	}
+	return index, nil
+}
+
+// parseExtra reads zip64 sizes and offset and extended timestamp from the extra fields
+func (z *zipIndexEntry) parseExtra() error {
+	extra := z.Extra
+	for len(extra) >= 4 {
+		tag := binary.LittleEndian.Uint16(extra)
+		size := int(binary.LittleEndian.Uint16(extra[2:]))
+		if len(extra) < 4+size {
+			return zip.ErrFormat
+		}
+		field := extra[4 : 4+size]
+		switch tag {
+		case zipExtraZip64:
+			// Only values that are saturated in the directory record are present, in this order
+			next := func() (uint64, bool) {
+				if len(field) < 8 {
+					return 0, false
+				}
+				v := binary.LittleEndian.Uint64(field)
+				field = field[8:]
+				return v, true
+			}
+			var ok bool
+			if z.UncompressedSize64 == 0xffffffff {
+				if z.UncompressedSize64, ok = next(); !ok {
+					return zip.ErrFormat
+				}
+			}
+			if z.CompressedSize64 == 0xffffffff {
+				if z.CompressedSize64, ok = next(); !ok {
+					return zip.ErrFormat
+				}
+			}
+			if z.headerOffset == 0xffffffff {
+				offset, ok := next()
+				if !ok {
+					return zip.ErrFormat
+				}
+				z.headerOffset = int64(offset)
+			}
+		case zipExtraTimestamp:
+			if size >= 5 && field[0]&0x1 != 0 {
+				z.Modified = time.Unix(int64(int32(binary.LittleEndian.Uint32(field[1:]))), 0).UTC()
+			}
+		}
+		extra = extra[4+size:]
+	}
+	if z.Modified.IsZero() {
+		z.Modified = msDosTime(z.ModifiedDate, z.ModifiedTime)
+	}
+	return nil
+}
+
+// msDosTime converts MS-DOS date and time to a time.Time, in UTC as no timezone is stored
+func msDosTime(dosDate, dosTime uint16) time.Time {
+	return time.Date(
+		int(dosDate>>9+1980),
+		time.Month(dosDate>>5&0xf),
+		int(dosDate&0x1f),
+		int(dosTime>>11),
+		int(dosTime>>5&0x3f),
+		int(dosTime&0x1f*2),
+		0,
+		time.UTC,
+	)
+}
+
+// openZipIndexEntry reads the local header of an entry to locate its data, and opens a ranged reader on its
+// compressed content only. Content is decrypted and/or decompressed on the fly.
+func (a *Reader) openZipIndexEntry(ctx context.Context, archiveNode *tree.Node, entry *zipIndexEntry) (io.ReadCloser, error) {
+	header, e := a.readArchiveRange(ctx, archiveNode, entry.headerOffset, zipFileHeaderLen)
+	if e != nil {
+		return nil, e
+	}
+	if binary.LittleEndian.Uint32(header) != zipFileHeaderSig {
+		return nil, zip.ErrFormat
+	}
+	dataOffset := entry.headerOffset + zipFileHeaderLen + int64(binary.LittleEndian.Uint16(header[26:])) + int64(binary.LittleEndian.Uint16(header[28:]))
+	body, e := a.openArchiveRange(ctx, archiveNode, dataOffset, int64(entry.CompressedSize64))
+	if e != nil {
+		return nil, e
+	}
+	if entry.Flags&zipFlagEncrypted != 0 {
+		if entry.Method != zipMethodAES {
+			body.Close()
+			return nil, ErrZipUnsupportedEncryption
+		}
+		decrypted, e := decryptZipEntry(&entry.FileHeader, body, a.Password)
+		if e != nil {
+			body.Close()
+			return nil, e
+		}
+		return &zipEntryReader{Reader: decrypted, closers: []io.Closer{decrypted, body}}, nil
+	}
+	reader := &zipEntryReader{closers: []io.Closer{body}, crc: crc32.NewIEEE(), expectedCRC: entry.CRC32}
+	switch entry.Method {
+	case zip.Store:
+		reader.Reader = body
+	case zip.Deflate:
+		decompressor := flate.NewReader(body)
+		reader.Reader = decompressor
+		reader.closers = append([]io.Closer{decompressor}, reader.closers...)
+	default:
+		body.Close()
+		return nil, zip.ErrAlgorithm
+	}
+	return reader, nil
+}
+
+// zipEntryReader reads an entry content, optionally checking its CRC, and closes all underlying readers
+type zipEntryReader struct {
+	io.Reader
+	closers     []io.Closer
+	crc         hash.Hash32
+	expectedCRC uint32
+}
+
+func (z *zipEntryReader) Read(p []byte) (int, error) {
+	n, err := z.Reader.Read(p)
+	if z.crc != nil {
+		z.crc.Write(p[:n])
+		if err == io.EOF && z.crc.Sum32() != z.expectedCRC {
+			return n, zip.ErrChecksum
+		}
+	}
+	return n, err
+}
+
+func (z *zipEntryReader) Close() error {
+	var err error
+	for _, c := range z.closers {
+		if e := c.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
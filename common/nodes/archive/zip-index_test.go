@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/v4/common/nodes"
+	"github.com/pydio/cells/v4/common/nodes/models"
+	"github.com/pydio/cells/v4/common/proto/tree"
+)
+
+// rangeHandler serves ranges of an in-memory archive and records requested lengths
+type rangeHandler struct {
+	nodes.Handler
+	data     []byte
+	requests []int64
+}
+
+func (r *rangeHandler) GetObject(ctx context.Context, node *tree.Node, requestData *models.GetRequestData) (io.ReadCloser, error) {
+	end := int64(len(r.data))
+	if requestData.Length >= 0 {
+		end = requestData.StartOffset + requestData.Length
+	}
+	r.requests = append(r.requests, end-requestData.StartOffset)
+	return ioutil.NopCloser(bytes.NewReader(r.data[requestData.StartOffset:end])), nil
+}
+
+func makeTestZip(entries int, large bool) []byte {
+	buf := &bytes.Buffer{}
+	z := zip.NewWriter(buf)
+	mTime := time.Unix(1506070808, 0)
+	if large {
+		w, _ := z.CreateHeader(&zip.FileHeader{Name: "folder/large.bin", Method: zip.Store, Modified: mTime})
+		w.Write(bytes.Repeat([]byte("0123456789"), 100000))
+	}
+	for i := 0; i < entries; i++ {
+		w, _ := z.CreateHeader(&zip.FileHeader{Name: fmt.Sprintf("folder/file-%d.txt", i), Method: zip.Deflate, Modified: mTime})
+		w.Write([]byte(fmt.Sprintf("content of file %d", i)))
+	}
+	z.SetComment("archive comment")
+	z.Close()
+	return buf.Bytes()
+}
+
+func TestZipIndex(t *testing.T) {
+
+	Convey("List and read zip entries with ranged requests", t, func() {
+		handler := &rangeHandler{Handler: nodes.NewHandlerMock(), data: makeTestZip(10, true)}
+		archiveNode := &tree.Node{Path: "archive.zip", Size: int64(len(handler.data)), Etag: "zip-index-test-1"}
+		reader := &Reader{Router: handler}
+
+		children, e := reader.ListChildrenZip(context.Background(), archiveNode, "folder")
+		So(e, ShouldBeNil)
+		So(children, ShouldHaveLength, 11)
+		So(children[0].Path, ShouldEqual, "archive.zip/folder/large.bin")
+		So(children[0].Size, ShouldEqual, 1000000)
+		So(children[0].MTime, ShouldEqual, 1506070808)
+		So(handler.requests, ShouldHaveLength, 1)
+
+		// Index is cached by ETag
+		stat, e := reader.StatChildZip(context.Background(), archiveNode, "folder")
+		So(e, ShouldBeNil)
+		So(stat.Type, ShouldEqual, tree.NodeType_COLLECTION)
+		So(handler.requests, ShouldHaveLength, 1)
+
+		rc, e := reader.ReadChildZip(context.Background(), archiveNode, "folder/file-3.txt")
+		So(e, ShouldBeNil)
+		content, e := ioutil.ReadAll(rc)
+		So(e, ShouldBeNil)
+		So(rc.Close(), ShouldBeNil)
+		So(string(content), ShouldEqual, "content of file 3")
+		// Local header and entry data only
+		So(handler.requests, ShouldHaveLength, 3)
+		So(handler.requests[1], ShouldEqual, zipFileHeaderLen)
+		So(handler.requests[2], ShouldBeLessThan, 100)
+
+		rc, e = reader.ReadChildZip(context.Background(), archiveNode, "folder/large.bin")
+		So(e, ShouldBeNil)
+		content, e = ioutil.ReadAll(rc)
+		So(e, ShouldBeNil)
+		So(content, ShouldHaveLength, 1000000)
+
+		_, e = reader.ReadChildZip(context.Background(), archiveNode, "folder/missing.txt")
+		So(e, ShouldNotBeNil)
+	})
+
+	Convey("Detect corrupted entries", t, func() {
+		data := makeTestZip(1, false)
+		handler := &rangeHandler{Handler: nodes.NewHandlerMock(), data: data}
+		archiveNode := &tree.Node{Path: "archive.zip", Size: int64(len(data))}
+		reader := &Reader{Router: handler}
+		rc, e := reader.ReadChildZip(context.Background(), archiveNode, "folder/file-0.txt")
+		So(e, ShouldBeNil)
+		_, e = ioutil.ReadAll(rc)
+		So(e, ShouldBeNil)
+
+		// Flip a byte of the compressed content, decompression or CRC check should fail
+		zr, e := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		So(e, ShouldBeNil)
+		offset, e := zr.File[0].DataOffset()
+		So(e, ShouldBeNil)
+		data[offset+5] ^= 0xff
+		rc, e = reader.ReadChildZip(context.Background(), archiveNode, "folder/file-0.txt")
+		So(e, ShouldBeNil)
+		_, e = ioutil.ReadAll(rc)
+		So(e, ShouldNotBeNil)
+	})
+
+	Convey("Read zip64 central directory", t, func() {
+		handler := &rangeHandler{Handler: nodes.NewHandlerMock(), data: makeTestZip(70000, false)}
+		archiveNode := &tree.Node{Path: "archive.zip", Size: int64(len(handler.data))}
+		reader := &Reader{Router: handler}
+
+		index, e := reader.loadZipIndex(context.Background(), archiveNode)
+		So(e, ShouldBeNil)
+		So(index.entries, ShouldHaveLength, 70000)
+
+		rc, e := reader.ReadChildZip(context.Background(), archiveNode, "folder/file-69999.txt")
+		So(e, ShouldBeNil)
+		content, e := ioutil.ReadAll(rc)
+		So(e, ShouldBeNil)
+		So(string(content), ShouldEqual, "content of file 69999")
+	})
+
+}
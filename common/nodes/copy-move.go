@@ -44,9 +44,19 @@ import (
 )
 
 type (
-	sessionIDKey struct{}
+	sessionIDKey      struct{}
+	copyMoveCursorKey struct{}
 )
 
+// CopyMoveCursor is passed through the context to a recursive copy or move operation to restart it after the
+// last processed child, and to be notified of its progress.
+type CopyMoveCursor struct {
+	// After is the path of the last processed child, relative to the source node
+	After string
+	// Processed is called with the relative path of the last child such as all previous children are processed
+	Processed func(relPath string, count int)
+}
+
 const (
 	// Consider move takes 1s per 100 MB of data to copy
 	lockExpirationRatioSize   = 1024 * 1024 * 100
@@ -64,6 +74,17 @@ func GetSessionID(ctx context.Context) (string, bool) {
 	return res, ok
 }
 
+// WithCopyMoveCursor returns a context carrying a CopyMoveCursor
+func WithCopyMoveCursor(ctx context.Context, cursor *CopyMoveCursor) context.Context {
+	return context.WithValue(ctx, copyMoveCursorKey{}, cursor)
+}
+
+// GetCopyMoveCursor returns the CopyMoveCursor in context, if any
+func GetCopyMoveCursor(ctx context.Context) (*CopyMoveCursor, bool) {
+	res, ok := ctx.Value(copyMoveCursorKey{}).(*CopyMoveCursor)
+	return res, ok && res != nil
+}
+
 func extractDSFlat(ctx context.Context, handler Handler, sourceNode, targetNode *tree.Node) (innerFlat, srcFlat, targetFlat bool) {
 	if router, ok := handler.(Client); ok {
 		// We passed a router, call is external, use WrapCallback
@@ -328,6 +349,41 @@ func CopyMoveNodes(ctx context.Context, router Handler, sourceNode *tree.Node, t
 		wg := &sync.WaitGroup{}
 		queue := make(chan struct{}, 4)
 
+		// Find where a previous run stopped, and track children processed in order
+		cursor, hasCursor := GetCopyMoveCursor(ctx)
+		skipUntil := -1
+		if hasCursor && cursor.After != "" {
+			for idx, childNode := range children {
+				if strings.TrimPrefix(childNode.Path, prefixPathSrc+"/") == cursor.After {
+					skipUntil = idx
+					break
+				}
+			}
+			if skipUntil > -1 {
+				taskLogger.Info(fmt.Sprintf("Resuming copy/move after %s (%d children already processed)", cursor.After, skipUntil+1))
+			}
+		}
+		cursorLock := &sync.Mutex{}
+		completed := make(map[int]bool)
+		watermark := skipUntil
+		markCompleted := func(idx int) {
+			if !hasCursor || cursor.Processed == nil {
+				return
+			}
+			cursorLock.Lock()
+			defer cursorLock.Unlock()
+			completed[idx] = true
+			moved := false
+			for completed[watermark+1] {
+				delete(completed, watermark+1)
+				watermark++
+				moved = true
+			}
+			if moved {
+				cursor.Processed(strings.TrimPrefix(children[watermark].Path, prefixPathSrc+"/"), watermark+1)
+			}
+		}
+
 		t := time.Now()
 		var lastNode *tree.Node
 		var errs []error
@@ -341,8 +397,13 @@ func CopyMoveNodes(ctx context.Context, router Handler, sourceNode *tree.Node, t
 				lastNode = childNode
 				continue
 			}
+			if idx <= skipUntil {
+				childrenMoved++
+				continue
+			}
 			// copy for inner function
 			childNode := childNode
+			childIdx := idx
 
 			wg.Add(1)
 			queue <- struct{}{}
@@ -355,11 +416,13 @@ func CopyMoveNodes(ctx context.Context, router Handler, sourceNode *tree.Node, t
 				if Is403(e) {
 					childrenMoved++
 					taskLogger.Info("-- Ignoring " + childNode.Path + " (" + e.Error() + ")")
+					markCompleted(childIdx)
 				} else if e != nil {
 					errs = append(errs, e)
 				} else {
 					childrenMoved++
 					taskLogger.Info("-- Copy/Move Success for " + childNode.Path)
+					markCompleted(childIdx)
 				}
 			}()
 
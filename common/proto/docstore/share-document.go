@@ -28,6 +28,7 @@ type TargetUserEntry struct {
 // HashDocument is a Json Marshallable representation of a document, compatible with legacy.
 type ShareDocument struct {
 	ShareType             string                      `json:"SHARE_TYPE"`
+	StartTime             int64                       `json:"START_TIME,omitempty"`
 	ExpireTime            int64                       `json:"EXPIRE_TIME"`
 	ShortFormUrl          string                      `json:"SHORT_FORM_URL"`
 	RepositoryId          string                      `json:"REPOSITORY"`
@@ -32,6 +32,13 @@ const (
 	UserAttrPassHashed    = UserAttrPrivatePrefix + "password_hashed"
 	UserAttrLabelLike     = UserAttrPrivatePrefix + "labelLike"
 	UserAttrOrigin        = UserAttrPrivatePrefix + "origin"
+	// UserAttrPasswordScheme is not stored: it can only be used in queries, to find users by password hashing scheme
+	UserAttrPasswordScheme = UserAttrPrivatePrefix + "password_scheme"
+
+	UserAttrMfaTotp        = UserAttrPrivatePrefix + "mfa_totp"
+	UserAttrMfaTotpPending = UserAttrPrivatePrefix + "mfa_totp_pending"
+	UserAttrMfaTotpCounter = UserAttrPrivatePrefix + "mfa_totp_counter"
+	UserAttrMfaRecovery    = UserAttrPrivatePrefix + "mfa_recovery"
 
 	UserAttrDisplayName = "displayName"
 	UserAttrProfile     = "profile"
@@ -252,6 +252,7 @@ const (
 	Command_RunOnce  Command = 5
 	Command_Inactive Command = 6
 	Command_Active   Command = 7
+	Command_DryRun   Command = 8
 )
 
 // Enum value maps for Command.
@@ -265,6 +266,7 @@ var (
 		5: "RunOnce",
 		6: "Inactive",
 		7: "Active",
+		8: "DryRun",
 	}
 	Command_value = map[string]int32{
 		"None":     0,
@@ -275,6 +277,7 @@ var (
 		"RunOnce":  5,
 		"Inactive": 6,
 		"Active":   7,
+		"DryRun":   8,
 	}
 )
 
@@ -1027,6 +1030,13 @@ type Schedule struct {
 	Iso8601Schedule string `protobuf:"bytes,1,opt,name=Iso8601Schedule,proto3" json:"Iso8601Schedule,omitempty"`
 	// Minimum time between two runs
 	Iso8601MinDelta string `protobuf:"bytes,3,opt,name=Iso8601MinDelta,proto3" json:"Iso8601MinDelta,omitempty"`
+	// Cron expression (minute hour day-of-month month day-of-week), for instance "30 2 * * MON-FRI".
+	// Day-of-week also accepts "SUN#1" (first Sunday of the month) or "FRIL" (last Friday of the month).
+	// When set, it takes precedence over Iso8601Schedule.
+	Cron string `protobuf:"bytes,4,opt,name=Cron,proto3" json:"Cron,omitempty"`
+	// IANA time zone used to evaluate the Cron expression, for instance "Europe/Paris".
+	// Defaults to the server local time zone.
+	Timezone string `protobuf:"bytes,5,opt,name=Timezone,proto3" json:"Timezone,omitempty"`
 }
 
 func (x *Schedule) Reset() {
@@ -1075,6 +1085,20 @@ func (x *Schedule) GetIso8601MinDelta() string {
 	return ""
 }
 
+func (x *Schedule) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *Schedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
 type Action struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1338,6 +1362,8 @@ type Job struct {
 	Parameters []*JobParameter `protobuf:"bytes,19,rep,name=Parameters,proto3" json:"Parameters,omitempty"`
 	// Additional dependencies that may be required when running the job
 	ResourcesDependencies []*anypb.Any `protobuf:"bytes,22,rep,name=ResourcesDependencies,proto3" json:"ResourcesDependencies,omitempty"`
+	// Inbound webhook configuration, to let external systems start this job over HTTP
+	Webhook *JobWebhook `protobuf:"bytes,23,opt,name=Webhook,proto3" json:"Webhook,omitempty"`
 }
 
 func (x *Job) Reset() {
@@ -1519,6 +1545,73 @@ func (x *Job) GetResourcesDependencies() []*anypb.Any {
 	return nil
 }
 
+func (x *Job) GetWebhook() *JobWebhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type JobWebhook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Accept requests on the job webhook endpoint
+	Enabled bool `protobuf:"varint,1,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+	// Shared secret used to verify the HMAC-SHA256 signature of the timestamp and request body.
+	// It is moved to the configuration vault when the job is saved and never sent back: leave it
+	// empty to keep the current secret. Without secret, requests must be authenticated with a
+	// personal token of the job owner or an admin.
+	HmacSecret string `protobuf:"bytes,2,opt,name=HmacSecret,proto3" json:"HmacSecret,omitempty"`
+}
+
+func (x *JobWebhook) Reset() {
+	*x = JobWebhook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cells_jobs_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobWebhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobWebhook) ProtoMessage() {}
+
+func (x *JobWebhook) ProtoReflect() protoreflect.Message {
+	mi := &file_cells_jobs_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobWebhook.ProtoReflect.Descriptor instead.
+func (*JobWebhook) Descriptor() ([]byte, []int) {
+	return file_cells_jobs_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *JobWebhook) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *JobWebhook) GetHmacSecret() string {
+	if x != nil {
+		return x.HmacSecret
+	}
+	return ""
+}
+
 type JobParameter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1541,7 +1634,7 @@ type JobParameter struct {
 func (x *JobParameter) Reset() {
 	*x = JobParameter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[12]
+		mi := &file_cells_jobs_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1554,7 +1647,7 @@ func (x *JobParameter) String() string {
 func (*JobParameter) ProtoMessage() {}
 
 func (x *JobParameter) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[12]
+	mi := &file_cells_jobs_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1567,7 +1660,7 @@ func (x *JobParameter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobParameter.ProtoReflect.Descriptor instead.
 func (*JobParameter) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{12}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *JobParameter) GetName() string {
@@ -1625,7 +1718,7 @@ type JobChangeEvent struct {
 func (x *JobChangeEvent) Reset() {
 	*x = JobChangeEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[13]
+		mi := &file_cells_jobs_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1638,7 +1731,7 @@ func (x *JobChangeEvent) String() string {
 func (*JobChangeEvent) ProtoMessage() {}
 
 func (x *JobChangeEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[13]
+	mi := &file_cells_jobs_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1651,7 +1744,7 @@ func (x *JobChangeEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobChangeEvent.ProtoReflect.Descriptor instead.
 func (*JobChangeEvent) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{13}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *JobChangeEvent) GetJobUpdated() *Job {
@@ -1680,7 +1773,7 @@ type TaskChangeEvent struct {
 func (x *TaskChangeEvent) Reset() {
 	*x = TaskChangeEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[14]
+		mi := &file_cells_jobs_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1693,7 +1786,7 @@ func (x *TaskChangeEvent) String() string {
 func (*TaskChangeEvent) ProtoMessage() {}
 
 func (x *TaskChangeEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[14]
+	mi := &file_cells_jobs_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1706,7 +1799,7 @@ func (x *TaskChangeEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskChangeEvent.ProtoReflect.Descriptor instead.
 func (*TaskChangeEvent) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{14}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *TaskChangeEvent) GetTaskUpdated() *Task {
@@ -1729,12 +1822,14 @@ type PutJobRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Job *Job `protobuf:"bytes,1,opt,name=Job,proto3" json:"Job,omitempty"`
+	// Optional message stored with the new revision of the job
+	RevisionMessage string `protobuf:"bytes,2,opt,name=RevisionMessage,proto3" json:"RevisionMessage,omitempty"`
 }
 
 func (x *PutJobRequest) Reset() {
 	*x = PutJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[15]
+		mi := &file_cells_jobs_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1747,7 +1842,7 @@ func (x *PutJobRequest) String() string {
 func (*PutJobRequest) ProtoMessage() {}
 
 func (x *PutJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[15]
+	mi := &file_cells_jobs_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1760,7 +1855,7 @@ func (x *PutJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutJobRequest.ProtoReflect.Descriptor instead.
 func (*PutJobRequest) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{15}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *PutJobRequest) GetJob() *Job {
@@ -1770,6 +1865,13 @@ func (x *PutJobRequest) GetJob() *Job {
 	return nil
 }
 
+func (x *PutJobRequest) GetRevisionMessage() string {
+	if x != nil {
+		return x.RevisionMessage
+	}
+	return ""
+}
+
 type PutJobResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1781,7 +1883,7 @@ type PutJobResponse struct {
 func (x *PutJobResponse) Reset() {
 	*x = PutJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[16]
+		mi := &file_cells_jobs_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1794,7 +1896,7 @@ func (x *PutJobResponse) String() string {
 func (*PutJobResponse) ProtoMessage() {}
 
 func (x *PutJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[16]
+	mi := &file_cells_jobs_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1807,7 +1909,7 @@ func (x *PutJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutJobResponse.ProtoReflect.Descriptor instead.
 func (*PutJobResponse) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{16}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *PutJobResponse) GetJob() *Job {
@@ -1829,7 +1931,7 @@ type GetJobRequest struct {
 func (x *GetJobRequest) Reset() {
 	*x = GetJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[17]
+		mi := &file_cells_jobs_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1842,7 +1944,7 @@ func (x *GetJobRequest) String() string {
 func (*GetJobRequest) ProtoMessage() {}
 
 func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[17]
+	mi := &file_cells_jobs_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1855,7 +1957,7 @@ func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
 func (*GetJobRequest) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{17}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetJobRequest) GetJobID() string {
@@ -1883,7 +1985,7 @@ type GetJobResponse struct {
 func (x *GetJobResponse) Reset() {
 	*x = GetJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[18]
+		mi := &file_cells_jobs_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1896,7 +1998,7 @@ func (x *GetJobResponse) String() string {
 func (*GetJobResponse) ProtoMessage() {}
 
 func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[18]
+	mi := &file_cells_jobs_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1909,7 +2011,7 @@ func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
 func (*GetJobResponse) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{18}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetJobResponse) GetJob() *Job {
@@ -1931,7 +2033,7 @@ type DeleteJobRequest struct {
 func (x *DeleteJobRequest) Reset() {
 	*x = DeleteJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[19]
+		mi := &file_cells_jobs_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1944,7 +2046,7 @@ func (x *DeleteJobRequest) String() string {
 func (*DeleteJobRequest) ProtoMessage() {}
 
 func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[19]
+	mi := &file_cells_jobs_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1957,7 +2059,7 @@ func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
 func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{19}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DeleteJobRequest) GetJobID() string {
@@ -1986,7 +2088,7 @@ type DeleteJobResponse struct {
 func (x *DeleteJobResponse) Reset() {
 	*x = DeleteJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[20]
+		mi := &file_cells_jobs_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1999,7 +2101,7 @@ func (x *DeleteJobResponse) String() string {
 func (*DeleteJobResponse) ProtoMessage() {}
 
 func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[20]
+	mi := &file_cells_jobs_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2012,7 +2114,7 @@ func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteJobResponse.ProtoReflect.Descriptor instead.
 func (*DeleteJobResponse) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{20}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DeleteJobResponse) GetSuccess() bool {
@@ -2053,7 +2155,7 @@ type ListJobsRequest struct {
 func (x *ListJobsRequest) Reset() {
 	*x = ListJobsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[21]
+		mi := &file_cells_jobs_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2066,7 +2168,7 @@ func (x *ListJobsRequest) String() string {
 func (*ListJobsRequest) ProtoMessage() {}
 
 func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[21]
+	mi := &file_cells_jobs_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2079,7 +2181,7 @@ func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
 func (*ListJobsRequest) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{21}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ListJobsRequest) GetOwner() string {
@@ -2142,7 +2244,7 @@ type ListJobsResponse struct {
 func (x *ListJobsResponse) Reset() {
 	*x = ListJobsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[22]
+		mi := &file_cells_jobs_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2155,7 +2257,7 @@ func (x *ListJobsResponse) String() string {
 func (*ListJobsResponse) ProtoMessage() {}
 
 func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[22]
+	mi := &file_cells_jobs_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2168,7 +2270,7 @@ func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
 func (*ListJobsResponse) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{22}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ListJobsResponse) GetJob() *Job {
@@ -2192,7 +2294,7 @@ type ListTasksRequest struct {
 func (x *ListTasksRequest) Reset() {
 	*x = ListTasksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[23]
+		mi := &file_cells_jobs_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2205,7 +2307,7 @@ func (x *ListTasksRequest) String() string {
 func (*ListTasksRequest) ProtoMessage() {}
 
 func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[23]
+	mi := &file_cells_jobs_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2218,7 +2320,7 @@ func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
 func (*ListTasksRequest) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{23}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListTasksRequest) GetJobID() string {
@@ -2246,7 +2348,7 @@ type ListTasksResponse struct {
 func (x *ListTasksResponse) Reset() {
 	*x = ListTasksResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[24]
+		mi := &file_cells_jobs_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2259,7 +2361,7 @@ func (x *ListTasksResponse) String() string {
 func (*ListTasksResponse) ProtoMessage() {}
 
 func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[24]
+	mi := &file_cells_jobs_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2272,7 +2374,7 @@ func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
 func (*ListTasksResponse) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{24}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ListTasksResponse) GetTask() *Task {
@@ -2293,7 +2395,7 @@ type PutTaskRequest struct {
 func (x *PutTaskRequest) Reset() {
 	*x = PutTaskRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[25]
+		mi := &file_cells_jobs_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2306,7 +2408,7 @@ func (x *PutTaskRequest) String() string {
 func (*PutTaskRequest) ProtoMessage() {}
 
 func (x *PutTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[25]
+	mi := &file_cells_jobs_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2319,7 +2421,7 @@ func (x *PutTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutTaskRequest.ProtoReflect.Descriptor instead.
 func (*PutTaskRequest) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{25}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *PutTaskRequest) GetTask() *Task {
@@ -2340,7 +2442,7 @@ type PutTaskResponse struct {
 func (x *PutTaskResponse) Reset() {
 	*x = PutTaskResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[26]
+		mi := &file_cells_jobs_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2353,7 +2455,7 @@ func (x *PutTaskResponse) String() string {
 func (*PutTaskResponse) ProtoMessage() {}
 
 func (x *PutTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[26]
+	mi := &file_cells_jobs_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2366,7 +2468,7 @@ func (x *PutTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutTaskResponse.ProtoReflect.Descriptor instead.
 func (*PutTaskResponse) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{26}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *PutTaskResponse) GetTask() *Task {
@@ -2394,7 +2496,7 @@ type DeleteTasksRequest struct {
 func (x *DeleteTasksRequest) Reset() {
 	*x = DeleteTasksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[27]
+		mi := &file_cells_jobs_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2407,7 +2509,7 @@ func (x *DeleteTasksRequest) String() string {
 func (*DeleteTasksRequest) ProtoMessage() {}
 
 func (x *DeleteTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[27]
+	mi := &file_cells_jobs_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2420,7 +2522,7 @@ func (x *DeleteTasksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTasksRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTasksRequest) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{27}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *DeleteTasksRequest) GetJobId() string {
@@ -2462,7 +2564,7 @@ type DeleteTasksResponse struct {
 func (x *DeleteTasksResponse) Reset() {
 	*x = DeleteTasksResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[28]
+		mi := &file_cells_jobs_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2475,7 +2577,7 @@ func (x *DeleteTasksResponse) String() string {
 func (*DeleteTasksResponse) ProtoMessage() {}
 
 func (x *DeleteTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[28]
+	mi := &file_cells_jobs_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2488,7 +2590,7 @@ func (x *DeleteTasksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTasksResponse.ProtoReflect.Descriptor instead.
 func (*DeleteTasksResponse) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{28}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *DeleteTasksResponse) GetDeleted() []string {
@@ -2509,7 +2611,7 @@ type DetectStuckTasksRequest struct {
 func (x *DetectStuckTasksRequest) Reset() {
 	*x = DetectStuckTasksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[29]
+		mi := &file_cells_jobs_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2522,7 +2624,7 @@ func (x *DetectStuckTasksRequest) String() string {
 func (*DetectStuckTasksRequest) ProtoMessage() {}
 
 func (x *DetectStuckTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[29]
+	mi := &file_cells_jobs_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2535,7 +2637,7 @@ func (x *DetectStuckTasksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetectStuckTasksRequest.ProtoReflect.Descriptor instead.
 func (*DetectStuckTasksRequest) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{29}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *DetectStuckTasksRequest) GetSince() int32 {
@@ -2556,7 +2658,7 @@ type DetectStuckTasksResponse struct {
 func (x *DetectStuckTasksResponse) Reset() {
 	*x = DetectStuckTasksResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[30]
+		mi := &file_cells_jobs_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2569,7 +2671,7 @@ func (x *DetectStuckTasksResponse) String() string {
 func (*DetectStuckTasksResponse) ProtoMessage() {}
 
 func (x *DetectStuckTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[30]
+	mi := &file_cells_jobs_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2582,7 +2684,7 @@ func (x *DetectStuckTasksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetectStuckTasksResponse.ProtoReflect.Descriptor instead.
 func (*DetectStuckTasksResponse) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{30}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *DetectStuckTasksResponse) GetFixedTaskIds() []string {
@@ -2592,47 +2694,42 @@ func (x *DetectStuckTasksResponse) GetFixedTaskIds() []string {
 	return nil
 }
 
-type Task struct {
+type JobRevision struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID            string     `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	JobID         string     `protobuf:"bytes,2,opt,name=JobID,proto3" json:"JobID,omitempty"`
-	Status        TaskStatus `protobuf:"varint,3,opt,name=Status,proto3,enum=jobs.TaskStatus" json:"Status,omitempty"`
-	StatusMessage string     `protobuf:"bytes,4,opt,name=StatusMessage,proto3" json:"StatusMessage,omitempty"`
-	TriggerOwner  string     `protobuf:"bytes,5,opt,name=TriggerOwner,proto3" json:"TriggerOwner,omitempty"`
-	StartTime     int32      `protobuf:"varint,6,opt,name=StartTime,proto3" json:"StartTime,omitempty"`
-	EndTime       int32      `protobuf:"varint,7,opt,name=EndTime,proto3" json:"EndTime,omitempty"`
-	// Can be interrupted
-	CanStop bool `protobuf:"varint,8,opt,name=CanStop,proto3" json:"CanStop,omitempty"`
-	// Can be paused/resumed
-	CanPause bool `protobuf:"varint,9,opt,name=CanPause,proto3" json:"CanPause,omitempty"`
-	// Tasks publish a progress
-	HasProgress bool `protobuf:"varint,10,opt,name=HasProgress,proto3" json:"HasProgress,omitempty"`
-	// Float value of the progress between 0 and 1
-	Progress float32 `protobuf:"fixed32,11,opt,name=Progress,proto3" json:"Progress,omitempty"`
-	// Logs of all the actions performed
-	ActionsLogs []*ActionLog `protobuf:"bytes,12,rep,name=ActionsLogs,proto3" json:"ActionsLogs,omitempty"`
-}
-
-func (x *Task) Reset() {
-	*x = Task{}
+	// Id of the job
+	JobID string `protobuf:"bytes,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	// Incremental revision number
+	Revision int32 `protobuf:"varint,2,opt,name=Revision,proto3" json:"Revision,omitempty"`
+	// Date of the change
+	Time int32 `protobuf:"varint,3,opt,name=Time,proto3" json:"Time,omitempty"`
+	// User who saved this revision
+	Author string `protobuf:"bytes,4,opt,name=Author,proto3" json:"Author,omitempty"`
+	// Optional message describing the change
+	Message string `protobuf:"bytes,5,opt,name=Message,proto3" json:"Message,omitempty"`
+	// Full job definition at this revision
+	Job *Job `protobuf:"bytes,6,opt,name=Job,proto3" json:"Job,omitempty"`
+}
+
+func (x *JobRevision) Reset() {
+	*x = JobRevision{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[31]
+		mi := &file_cells_jobs_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Task) String() string {
+func (x *JobRevision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Task) ProtoMessage() {}
+func (*JobRevision) ProtoMessage() {}
 
-func (x *Task) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[31]
+func (x *JobRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_cells_jobs_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2643,102 +2740,556 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Task.ProtoReflect.Descriptor instead.
-func (*Task) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use JobRevision.ProtoReflect.Descriptor instead.
+func (*JobRevision) Descriptor() ([]byte, []int) {
+	return file_cells_jobs_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *Task) GetID() string {
+func (x *JobRevision) GetJobID() string {
 	if x != nil {
-		return x.ID
+		return x.JobID
 	}
 	return ""
 }
 
-func (x *Task) GetJobID() string {
+func (x *JobRevision) GetRevision() int32 {
 	if x != nil {
-		return x.JobID
+		return x.Revision
 	}
-	return ""
+	return 0
 }
 
-func (x *Task) GetStatus() TaskStatus {
+func (x *JobRevision) GetTime() int32 {
 	if x != nil {
-		return x.Status
+		return x.Time
 	}
-	return TaskStatus_Unknown
+	return 0
 }
 
-func (x *Task) GetStatusMessage() string {
+func (x *JobRevision) GetAuthor() string {
 	if x != nil {
-		return x.StatusMessage
+		return x.Author
 	}
 	return ""
 }
 
-func (x *Task) GetTriggerOwner() string {
+func (x *JobRevision) GetMessage() string {
 	if x != nil {
-		return x.TriggerOwner
+		return x.Message
 	}
 	return ""
 }
 
-func (x *Task) GetStartTime() int32 {
+func (x *JobRevision) GetJob() *Job {
 	if x != nil {
-		return x.StartTime
+		return x.Job
 	}
-	return 0
+	return nil
 }
 
-func (x *Task) GetEndTime() int32 {
-	if x != nil {
-		return x.EndTime
-	}
-	return 0
+type ListJobRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id of the job
+	JobID string `protobuf:"bytes,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	// Start listing at a given position, most recent revisions first
+	Offset int32 `protobuf:"varint,2,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	// Limit the number of results
+	Limit int32 `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
 }
 
-func (x *Task) GetCanStop() bool {
-	if x != nil {
-		return x.CanStop
+func (x *ListJobRevisionsRequest) Reset() {
+	*x = ListJobRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cells_jobs_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *Task) GetCanPause() bool {
-	if x != nil {
-		return x.CanPause
-	}
-	return false
+func (x *ListJobRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobRevisionsRequest) ProtoMessage() {}
+
+func (x *ListJobRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cells_jobs_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_cells_jobs_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListJobRevisionsRequest) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+func (x *ListJobRevisionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListJobRevisionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListJobRevisionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revisions []*JobRevision `protobuf:"bytes,1,rep,name=Revisions,proto3" json:"Revisions,omitempty"`
+}
+
+func (x *ListJobRevisionsResponse) Reset() {
+	*x = ListJobRevisionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cells_jobs_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJobRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobRevisionsResponse) ProtoMessage() {}
+
+func (x *ListJobRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cells_jobs_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_cells_jobs_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListJobRevisionsResponse) GetRevisions() []*JobRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type RestoreJobRevisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id of the job
+	JobID string `protobuf:"bytes,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	// Revision to restore
+	Revision int32 `protobuf:"varint,2,opt,name=Revision,proto3" json:"Revision,omitempty"`
+}
+
+func (x *RestoreJobRevisionRequest) Reset() {
+	*x = RestoreJobRevisionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cells_jobs_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreJobRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreJobRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreJobRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cells_jobs_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreJobRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreJobRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_cells_jobs_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RestoreJobRevisionRequest) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+func (x *RestoreJobRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type RestoreJobRevisionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job *Job `protobuf:"bytes,1,opt,name=Job,proto3" json:"Job,omitempty"`
+}
+
+func (x *RestoreJobRevisionResponse) Reset() {
+	*x = RestoreJobRevisionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cells_jobs_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreJobRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreJobRevisionResponse) ProtoMessage() {}
+
+func (x *RestoreJobRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cells_jobs_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreJobRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreJobRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_cells_jobs_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RestoreJobRevisionResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type Task struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID            string     `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	JobID         string     `protobuf:"bytes,2,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	Status        TaskStatus `protobuf:"varint,3,opt,name=Status,proto3,enum=jobs.TaskStatus" json:"Status,omitempty"`
+	StatusMessage string     `protobuf:"bytes,4,opt,name=StatusMessage,proto3" json:"StatusMessage,omitempty"`
+	TriggerOwner  string     `protobuf:"bytes,5,opt,name=TriggerOwner,proto3" json:"TriggerOwner,omitempty"`
+	StartTime     int32      `protobuf:"varint,6,opt,name=StartTime,proto3" json:"StartTime,omitempty"`
+	EndTime       int32      `protobuf:"varint,7,opt,name=EndTime,proto3" json:"EndTime,omitempty"`
+	// Can be interrupted
+	CanStop bool `protobuf:"varint,8,opt,name=CanStop,proto3" json:"CanStop,omitempty"`
+	// Can be paused/resumed
+	CanPause bool `protobuf:"varint,9,opt,name=CanPause,proto3" json:"CanPause,omitempty"`
+	// Tasks publish a progress
+	HasProgress bool `protobuf:"varint,10,opt,name=HasProgress,proto3" json:"HasProgress,omitempty"`
+	// Float value of the progress between 0 and 1
+	Progress float32 `protobuf:"fixed32,11,opt,name=Progress,proto3" json:"Progress,omitempty"`
+	// Logs of all the actions performed
+	ActionsLogs []*ActionLog `protobuf:"bytes,12,rep,name=ActionsLogs,proto3" json:"ActionsLogs,omitempty"`
+	// Progress saved by resumable actions
+	Checkpoints []*TaskCheckpoint `protobuf:"bytes,13,rep,name=Checkpoints,proto3" json:"Checkpoints,omitempty"`
+	// Actions were not run but only described what they would do
+	DryRun bool `protobuf:"varint,14,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+	// Parameters values used for this run, kept to resume the task
+	RunParameters map[string]string `protobuf:"bytes,15,rep,name=RunParameters,proto3" json:"RunParameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Raw body received by the webhook that triggered this run, kept to resume the task
+	RunPayload []byte `protobuf:"bytes,16,opt,name=RunPayload,proto3" json:"RunPayload,omitempty"`
+	// Run was triggered through the job webhook
+	Webhook bool `protobuf:"varint,17,opt,name=Webhook,proto3" json:"Webhook,omitempty"`
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cells_jobs_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_cells_jobs_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_cells_jobs_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Task) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Task) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+func (x *Task) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_Unknown
+}
+
+func (x *Task) GetStatusMessage() string {
+	if x != nil {
+		return x.StatusMessage
+	}
+	return ""
+}
+
+func (x *Task) GetTriggerOwner() string {
+	if x != nil {
+		return x.TriggerOwner
+	}
+	return ""
+}
+
+func (x *Task) GetStartTime() int32 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *Task) GetEndTime() int32 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *Task) GetCanStop() bool {
+	if x != nil {
+		return x.CanStop
+	}
+	return false
+}
+
+func (x *Task) GetCanPause() bool {
+	if x != nil {
+		return x.CanPause
+	}
+	return false
 }
 
 func (x *Task) GetHasProgress() bool {
 	if x != nil {
-		return x.HasProgress
+		return x.HasProgress
+	}
+	return false
+}
+
+func (x *Task) GetProgress() float32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *Task) GetActionsLogs() []*ActionLog {
+	if x != nil {
+		return x.ActionsLogs
+	}
+	return nil
+}
+
+func (x *Task) GetCheckpoints() []*TaskCheckpoint {
+	if x != nil {
+		return x.Checkpoints
+	}
+	return nil
+}
+
+func (x *Task) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
 	}
 	return false
 }
 
-func (x *Task) GetProgress() float32 {
+func (x *Task) GetRunParameters() map[string]string {
 	if x != nil {
-		return x.Progress
+		return x.RunParameters
 	}
-	return 0
+	return nil
 }
 
-func (x *Task) GetActionsLogs() []*ActionLog {
+func (x *Task) GetRunPayload() []byte {
 	if x != nil {
-		return x.ActionsLogs
+		return x.RunPayload
 	}
 	return nil
 }
 
+func (x *Task) GetWebhook() bool {
+	if x != nil {
+		return x.Webhook
+	}
+	return false
+}
+
+// TaskCheckpoint records the progress of a resumable action, to resume a task interrupted by a restart
+type TaskCheckpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path of the action in the job actions tree
+	ActionPath string `protobuf:"bytes,1,opt,name=ActionPath,proto3" json:"ActionPath,omitempty"`
+	// Hash of the input last processed by the action, as a same action can run on many inputs
+	InputHash string `protobuf:"bytes,2,opt,name=InputHash,proto3" json:"InputHash,omitempty"`
+	// Action-specific cursor pointing to the last processed item of a selection or a walk
+	Cursor string `protobuf:"bytes,3,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
+	// Number of items processed so far
+	Processed int64 `protobuf:"varint,4,opt,name=Processed,proto3" json:"Processed,omitempty"`
+	// Action has fully processed this input
+	Done bool `protobuf:"varint,5,opt,name=Done,proto3" json:"Done,omitempty"`
+	// Last update time
+	Time int32 `protobuf:"varint,6,opt,name=Time,proto3" json:"Time,omitempty"`
+}
+
+func (x *TaskCheckpoint) Reset() {
+	*x = TaskCheckpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cells_jobs_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskCheckpoint) ProtoMessage() {}
+
+func (x *TaskCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_cells_jobs_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskCheckpoint.ProtoReflect.Descriptor instead.
+func (*TaskCheckpoint) Descriptor() ([]byte, []int) {
+	return file_cells_jobs_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TaskCheckpoint) GetActionPath() string {
+	if x != nil {
+		return x.ActionPath
+	}
+	return ""
+}
+
+func (x *TaskCheckpoint) GetInputHash() string {
+	if x != nil {
+		return x.InputHash
+	}
+	return ""
+}
+
+func (x *TaskCheckpoint) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *TaskCheckpoint) GetProcessed() int64 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *TaskCheckpoint) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *TaskCheckpoint) GetTime() int32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
 // Command sent to control a job or a task
 type CtrlCommand struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Type of command to send (None, Pause, Resume, Stop, Delete, RunOnce, Inactive, Active)
+	// Type of command to send (None, Pause, Resume, Stop, Delete, RunOnce, Inactive, Active, DryRun)
 	Cmd Command `protobuf:"varint,1,opt,name=Cmd,proto3,enum=jobs.Command" json:"Cmd,omitempty"`
 	// Id of the job
 	JobId string `protobuf:"bytes,2,opt,name=JobId,proto3" json:"JobId,omitempty"`
@@ -2746,14 +3297,14 @@ type CtrlCommand struct {
 	TaskId string `protobuf:"bytes,3,opt,name=TaskId,proto3" json:"TaskId,omitempty"`
 	// Owner of the job
 	OwnerId string `protobuf:"bytes,4,opt,name=OwnerId,proto3" json:"OwnerId,omitempty"`
-	// Parameters used for RunOnce command
+	// Parameters used for RunOnce and DryRun commands
 	RunParameters map[string]string `protobuf:"bytes,5,rep,name=RunParameters,proto3" json:"RunParameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *CtrlCommand) Reset() {
 	*x = CtrlCommand{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[32]
+		mi := &file_cells_jobs_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2766,7 +3317,7 @@ func (x *CtrlCommand) String() string {
 func (*CtrlCommand) ProtoMessage() {}
 
 func (x *CtrlCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[32]
+	mi := &file_cells_jobs_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2779,7 +3330,7 @@ func (x *CtrlCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CtrlCommand.ProtoReflect.Descriptor instead.
 func (*CtrlCommand) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{32}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *CtrlCommand) GetCmd() Command {
@@ -2829,7 +3380,7 @@ type CtrlCommandResponse struct {
 func (x *CtrlCommandResponse) Reset() {
 	*x = CtrlCommandResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[33]
+		mi := &file_cells_jobs_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2842,7 +3393,7 @@ func (x *CtrlCommandResponse) String() string {
 func (*CtrlCommandResponse) ProtoMessage() {}
 
 func (x *CtrlCommandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[33]
+	mi := &file_cells_jobs_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2855,7 +3406,7 @@ func (x *CtrlCommandResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CtrlCommandResponse.ProtoReflect.Descriptor instead.
 func (*CtrlCommandResponse) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{33}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *CtrlCommandResponse) GetMsg() string {
@@ -2878,7 +3429,7 @@ type ActionLog struct {
 func (x *ActionLog) Reset() {
 	*x = ActionLog{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[34]
+		mi := &file_cells_jobs_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2891,7 +3442,7 @@ func (x *ActionLog) String() string {
 func (*ActionLog) ProtoMessage() {}
 
 func (x *ActionLog) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[34]
+	mi := &file_cells_jobs_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2904,7 +3455,7 @@ func (x *ActionLog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActionLog.ProtoReflect.Descriptor instead.
 func (*ActionLog) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{34}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ActionLog) GetAction() *Action {
@@ -2945,12 +3496,18 @@ type JobTriggerEvent struct {
 	RunTaskId string `protobuf:"bytes,4,opt,name=RunTaskId,proto3" json:"RunTaskId,omitempty"`
 	// Use specific parameters values for this run
 	RunParameters map[string]string `protobuf:"bytes,5,rep,name=RunParameters,proto3" json:"RunParameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Raw body received by the webhook that triggered this run
+	RunPayload []byte `protobuf:"bytes,6,opt,name=RunPayload,proto3" json:"RunPayload,omitempty"`
+	// Run was triggered through the job webhook
+	Webhook bool `protobuf:"varint,7,opt,name=Webhook,proto3" json:"Webhook,omitempty"`
+	// Evaluate selectors and filters, but only describe what each action would do
+	DryRun bool `protobuf:"varint,8,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
 }
 
 func (x *JobTriggerEvent) Reset() {
 	*x = JobTriggerEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[35]
+		mi := &file_cells_jobs_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2963,7 +3520,7 @@ func (x *JobTriggerEvent) String() string {
 func (*JobTriggerEvent) ProtoMessage() {}
 
 func (x *JobTriggerEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[35]
+	mi := &file_cells_jobs_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2976,7 +3533,7 @@ func (x *JobTriggerEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobTriggerEvent.ProtoReflect.Descriptor instead.
 func (*JobTriggerEvent) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{35}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *JobTriggerEvent) GetJobID() string {
@@ -3014,6 +3571,27 @@ func (x *JobTriggerEvent) GetRunParameters() map[string]string {
 	return nil
 }
 
+func (x *JobTriggerEvent) GetRunPayload() []byte {
+	if x != nil {
+		return x.RunPayload
+	}
+	return nil
+}
+
+func (x *JobTriggerEvent) GetWebhook() bool {
+	if x != nil {
+		return x.Webhook
+	}
+	return false
+}
+
+func (x *JobTriggerEvent) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 // Standard output of an action. Success value is required
 // other are optional
 type ActionOutput struct {
@@ -3040,7 +3618,7 @@ type ActionOutput struct {
 func (x *ActionOutput) Reset() {
 	*x = ActionOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[36]
+		mi := &file_cells_jobs_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3053,7 +3631,7 @@ func (x *ActionOutput) String() string {
 func (*ActionOutput) ProtoMessage() {}
 
 func (x *ActionOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[36]
+	mi := &file_cells_jobs_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3066,7 +3644,7 @@ func (x *ActionOutput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActionOutput.ProtoReflect.Descriptor instead.
 func (*ActionOutput) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{36}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ActionOutput) GetSuccess() bool {
@@ -3155,7 +3733,7 @@ type ActionOutputSingleQuery struct {
 func (x *ActionOutputSingleQuery) Reset() {
 	*x = ActionOutputSingleQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[37]
+		mi := &file_cells_jobs_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3168,7 +3746,7 @@ func (x *ActionOutputSingleQuery) String() string {
 func (*ActionOutputSingleQuery) ProtoMessage() {}
 
 func (x *ActionOutputSingleQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[37]
+	mi := &file_cells_jobs_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3181,7 +3759,7 @@ func (x *ActionOutputSingleQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActionOutputSingleQuery.ProtoReflect.Descriptor instead.
 func (*ActionOutputSingleQuery) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{37}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ActionOutputSingleQuery) GetIsSuccess() bool {
@@ -3306,7 +3884,7 @@ type ActionMessage struct {
 func (x *ActionMessage) Reset() {
 	*x = ActionMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_jobs_proto_msgTypes[38]
+		mi := &file_cells_jobs_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3319,7 +3897,7 @@ func (x *ActionMessage) String() string {
 func (*ActionMessage) ProtoMessage() {}
 
 func (x *ActionMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_jobs_proto_msgTypes[38]
+	mi := &file_cells_jobs_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3332,7 +3910,7 @@ func (x *ActionMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActionMessage.ProtoReflect.Descriptor instead.
 func (*ActionMessage) Descriptor() ([]byte, []int) {
-	return file_cells_jobs_proto_rawDescGZIP(), []int{38}
+	return file_cells_jobs_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *ActionMessage) GetEvent() *anypb.Any {
@@ -3497,451 +4075,538 @@ var file_cells_jobs_proto_rawDesc = []byte{
 	0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
 	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x64, 0x6d, 0x2e,
 	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5e, 0x0a, 0x08, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x49, 0x73, 0x6f, 0x38, 0x36, 0x30,
-	0x31, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x49, 0x73, 0x6f, 0x38, 0x36, 0x30, 0x31, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x12, 0x28, 0x0a, 0x0f, 0x49, 0x73, 0x6f, 0x38, 0x36, 0x30, 0x31, 0x4d, 0x69, 0x6e, 0x44, 0x65,
-	0x6c, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x49, 0x73, 0x6f, 0x38, 0x36,
-	0x30, 0x31, 0x4d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x22, 0xa1, 0x08, 0x0a, 0x06, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
-	0x06, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x42,
-	0x79, 0x70, 0x61, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x41, 0x66,
-	0x74, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x42, 0x72, 0x65, 0x61, 0x6b,
-	0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x53, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a,
-	0x6f, 0x62, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x52, 0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x12, 0x39, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0d, 0x55, 0x73,
-	0x65, 0x72, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x0b, 0x4e,
-	0x6f, 0x64, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x53, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x12, 0x35, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x55, 0x73,
-	0x65, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x0b, 0x49, 0x64, 0x6d,
-	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x49, 0x64, 0x6d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x52, 0x0b, 0x49, 0x64, 0x6d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2f,
-	0x0a, 0x09, 0x49, 0x64, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x49, 0x64, 0x6d, 0x53, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x49, 0x64, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
-	0x48, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x6f,
-	0x62, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x12, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x44, 0x0a, 0x10, 0x44, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x12, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x10, 0x44,
-	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
-	0x48, 0x0a, 0x12, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x6f,
-	0x62, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x12, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x11, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x11, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x12, 0x39, 0x0a, 0x0d, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x54,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0d, 0x54, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x0a, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x34, 0x0a, 0x0e, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x3e, 0x0a, 0x13, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6a,
-	0x6f, 0x62, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x46, 0x61, 0x69, 0x6c,
-	0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
-	0x3d, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf7,
-	0x06, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8e, 0x01, 0x0a, 0x08, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x49, 0x73, 0x6f, 0x38, 0x36,
+	0x30, 0x31, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x49, 0x73, 0x6f, 0x38, 0x36, 0x30, 0x31, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x49, 0x73, 0x6f, 0x38, 0x36, 0x30, 0x31, 0x4d, 0x69, 0x6e, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x49, 0x73, 0x6f, 0x38,
+	0x36, 0x30, 0x31, 0x4d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x43,
+	0x72, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x72, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0xa1, 0x08, 0x0a, 0x06,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x4c, 0x61, 0x6e, 0x67, 0x75,
-	0x61, 0x67, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x26, 0x0a, 0x07,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x4d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x4d, 0x61,
-	0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2c, 0x0a, 0x11,
-	0x54, 0x61, 0x73, 0x6b, 0x73, 0x53, 0x69, 0x6c, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x53, 0x69,
-	0x6c, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x54, 0x61,
-	0x73, 0x6b, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6a, 0x6f, 0x62, 0x73,
-	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x3d, 0x0a, 0x0f,
-	0x4e, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4e, 0x6f, 0x64,
-	0x65, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0f, 0x4e, 0x6f, 0x64, 0x65,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0f, 0x55,
-	0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x10,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x09, 0x49, 0x64,
-	0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x49, 0x64, 0x6d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x52, 0x09, 0x49, 0x64, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x11, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
-	0x11, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x12, 0x44, 0x0a, 0x10, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0b,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x39, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0d, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x0b,
+	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x0b, 0x49, 0x64,
+	0x6d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x49, 0x64, 0x6d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x0b, 0x49, 0x64, 0x6d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x2f, 0x0a, 0x09, 0x49, 0x64, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x49, 0x64, 0x6d, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x49, 0x64, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x48, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a,
 	0x6f, 0x62, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x10, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6a,
-	0x6f, 0x62, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x52, 0x0a, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x4a, 0x0a, 0x15,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
-	0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
-	0x79, 0x52, 0x15, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x44, 0x65, 0x70, 0x65,
-	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x0c, 0x4a, 0x6f, 0x62,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4a, 0x73, 0x6f, 0x6e, 0x43,
-	0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4a, 0x73,
-	0x6f, 0x6e, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x0e, 0x4a, 0x6f, 0x62,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x0a, 0x4a,
-	0x6f, 0x62, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x09, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x0a, 0x4a, 0x6f, 0x62, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4a, 0x6f, 0x62, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x5c, 0x0a, 0x0f, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x0b, 0x54, 0x61, 0x73,
-	0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
-	0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x0b, 0x54, 0x61, 0x73, 0x6b,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x18, 0x02,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x12, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x44, 0x0a, 0x10, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x10,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x48, 0x0a, 0x12, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a,
+	0x6f, 0x62, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x12, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x11, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x11,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x39, 0x0a, 0x0d, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0d, 0x54,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x0a,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x34, 0x0a, 0x0e, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x3e, 0x0a, 0x13, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xa3, 0x07, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x4c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x26, 0x0a,
+	0x07, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x4d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x4d,
+	0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2c, 0x0a,
+	0x11, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x53, 0x69, 0x6c, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x53,
+	0x69, 0x6c, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6a, 0x6f, 0x62,
+	0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x3d, 0x0a,
+	0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0f, 0x4e, 0x6f, 0x64,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0f,
+	0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0f, 0x55, 0x73, 0x65, 0x72,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x09, 0x49,
+	0x64, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x49, 0x64, 0x6d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x09, 0x49, 0x64, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x11,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x10, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x10, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x0a, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x4a, 0x0a,
+	0x15, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
+	0x6e, 0x79, 0x52, 0x15, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x44, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6a, 0x6f, 0x62,
+	0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x07, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x22, 0x46, 0x0a, 0x0a, 0x4a, 0x6f, 0x62, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x48, 0x6d, 0x61, 0x63, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x48, 0x6d, 0x61, 0x63, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xae, 0x01,
+	0x0a, 0x0c, 0x4a, 0x6f, 0x62, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x61,
+	0x6e, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x4d,
+	0x61, 0x6e, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x4a, 0x73, 0x6f, 0x6e, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x4a, 0x73, 0x6f, 0x6e, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x22, 0x5b,
+	0x0a, 0x0e, 0x4a, 0x6f, 0x62, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x29, 0x0a, 0x0a, 0x4a, 0x6f, 0x62, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x52,
-	0x03, 0x4a, 0x6f, 0x62, 0x22, 0x2c, 0x0a, 0x0d, 0x50, 0x75, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x4a,
-	0x6f, 0x62, 0x22, 0x2d, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x09, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x4a, 0x6f,
-	0x62, 0x22, 0x55, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x09, 0x4c, 0x6f, 0x61, 0x64,
-	0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6a, 0x6f,
-	0x62, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x09, 0x4c,
-	0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x2d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x4a, 0x6f,
+	0x0a, 0x4a, 0x6f, 0x62, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x5c, 0x0a, 0x0f, 0x54,
+	0x61, 0x73, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2c,
+	0x0a, 0x0b, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x0b, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x03,
+	0x4a, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6a, 0x6f, 0x62, 0x73,
+	0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x4a, 0x6f, 0x62, 0x22, 0x56, 0x0a, 0x0d, 0x50, 0x75, 0x74,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x4a, 0x6f,
 	0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4a,
-	0x6f, 0x62, 0x52, 0x03, 0x4a, 0x6f, 0x62, 0x22, 0x4e, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4a,
-	0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49,
-	0x44, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4a, 0x6f,
-	0x62, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x22, 0x4f, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf1, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
-	0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x6e, 0x6c, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x6e,
-	0x6c, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x4f, 0x6e, 0x6c, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x4f, 0x6e,
-	0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x09, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x54, 0x61, 0x73,
-	0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x09, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73,
-	0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x06, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x61,
-	0x73, 0x6b, 0x73, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0b, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x54, 0x61, 0x73, 0x6b, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x2f, 0x0a, 0x10,
-	0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1b, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x4a, 0x6f, 0x62, 0x22, 0x52, 0x0a,
-	0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x6f, 0x62, 0x52, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x28, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x2d, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x4a, 0x6f, 0x62,
+	0x22, 0x55, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x54,
-	0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x33, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b,
-	0x52, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x22, 0x30, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x54, 0x61, 0x73,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x54, 0x61,
-	0x73, 0x6b, 0x52, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x22, 0x31, 0x0a, 0x0f, 0x50, 0x75, 0x74, 0x54,
-	0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x54,
-	0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6a, 0x6f, 0x62, 0x73,
-	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x22, 0x8c, 0x01, 0x0a, 0x12,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x73, 0x6b,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44,
-	0x12, 0x28, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e,
-	0x32, 0x10, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72,
-	0x75, 0x6e, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x50, 0x72, 0x75, 0x6e, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x2f, 0x0a, 0x13, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x2f, 0x0a, 0x17, 0x44,
+	0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x09, 0x4c, 0x6f, 0x61, 0x64, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6a, 0x6f, 0x62,
+	0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x09, 0x4c, 0x6f,
+	0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x2d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x4a, 0x6f, 0x62,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4a, 0x6f,
+	0x62, 0x52, 0x03, 0x4a, 0x6f, 0x62, 0x22, 0x4e, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f,
+	0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44,
+	0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4a, 0x6f, 0x62,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x22, 0x4f, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf1, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
+	0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x6e, 0x6c,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x4f, 0x6e, 0x6c,
+	0x79, 0x12, 0x2e, 0x0a, 0x09, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x09, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x61, 0x73,
+	0x6b, 0x73, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x54, 0x61, 0x73, 0x6b, 0x73, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x2f, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1b, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6a,
+	0x6f, 0x62, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x4a, 0x6f, 0x62, 0x22, 0x52, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x54, 0x61,
+	0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x33, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x04, 0x54, 0x61, 0x73, 0x6b, 0x22, 0x30, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x22, 0x31, 0x0a, 0x0f, 0x50, 0x75, 0x74, 0x54, 0x61,
+	0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x54, 0x61,
+	0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x22, 0x8c, 0x01, 0x0a, 0x12, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x12,
+	0x28, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x10, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72, 0x75,
+	0x6e, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x50,
+	0x72, 0x75, 0x6e, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x2f, 0x0a, 0x13, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x2f, 0x0a, 0x17, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x53, 0x74, 0x75, 0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x3e, 0x0a, 0x18, 0x44,
 	0x65, 0x74, 0x65, 0x63, 0x74, 0x53, 0x74, 0x75, 0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x3e, 0x0a, 0x18,
-	0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x53, 0x74, 0x75, 0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x46, 0x69, 0x78, 0x65,
-	0x64, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
-	0x46, 0x69, 0x78, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x73, 0x22, 0xff, 0x02, 0x0a,
-	0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x06, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6a, 0x6f,
-	0x62, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x54,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12,
-	0x1c, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
-	0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x61, 0x6e, 0x53, 0x74,
-	0x6f, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x43, 0x61, 0x6e, 0x53, 0x74, 0x6f,
-	0x70, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x43, 0x61, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x48, 0x61, 0x73, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x48, 0x61, 0x73, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x31, 0x0a, 0x0b, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x6f, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f,
-	0x67, 0x52, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x6f, 0x67, 0x73, 0x22, 0x84,
-	0x02, 0x0a, 0x0b, 0x43, 0x74, 0x72, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1f,
-	0x0a, 0x03, 0x43, 0x6d, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6a, 0x6f,
-	0x62, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x03, 0x43, 0x6d, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x4a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x0d, 0x52, 0x75, 0x6e, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x43, 0x74, 0x72, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x2e, 0x52, 0x75, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x52, 0x75, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
-	0x65, 0x72, 0x73, 0x1a, 0x40, 0x0a, 0x12, 0x52, 0x75, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
-	0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x27, 0x0a, 0x13, 0x43, 0x74, 0x72, 0x6c, 0x43, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x4d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0xa5,
-	0x01, 0x0a, 0x09, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x12, 0x24, 0x0a, 0x06,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6a,
-	0x6f, 0x62, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x46, 0x69, 0x78, 0x65, 0x64,
+	0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x46,
+	0x69, 0x78, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x0b,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x4a,
+	0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x4a, 0x6f, 0x62,
+	0x22, 0x5d, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4a,
+	0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0x4b, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4d, 0x0a, 0x19,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x39, 0x0a, 0x1a, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x4a, 0x6f, 0x62,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4a, 0x6f,
+	0x62, 0x52, 0x03, 0x4a, 0x6f, 0x62, 0x22, 0x90, 0x05, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x61, 0x6e, 0x53, 0x74, 0x6f, 0x70, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x43, 0x61, 0x6e, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x43,
+	0x61, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x43,
+	0x61, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x48, 0x61, 0x73, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x48, 0x61,
+	0x73, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x31, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x4c, 0x6f, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x6f, 0x62,
+	0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x52, 0x0b, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x43, 0x0a, 0x0d, 0x52, 0x75, 0x6e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x75, 0x6e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d,
+	0x52, 0x75, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x52, 0x75, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x52, 0x75, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x1a, 0x40, 0x0a, 0x12, 0x52, 0x75, 0x6e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xac, 0x01, 0x0a, 0x0e, 0x54, 0x61,
+	0x73, 0x6b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x44, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x44, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x84, 0x02, 0x0a, 0x0b, 0x43, 0x74, 0x72,
+	0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x03, 0x43, 0x6d, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x03, 0x43, 0x6d, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x4a, 0x0a, 0x0d, 0x52, 0x75, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e,
+	0x43, 0x74, 0x72, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x52, 0x75, 0x6e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d,
+	0x52, 0x75, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x40, 0x0a,
+	0x12, 0x52, 0x75, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x27, 0x0a, 0x13, 0x43, 0x74, 0x72, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0xa5, 0x01, 0x0a, 0x09, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x12, 0x24, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0c,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9b, 0x02, 0x0a, 0x0f, 0x4a, 0x6f, 0x62, 0x54, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f,
-	0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44,
-	0x12, 0x2a, 0x0a, 0x08, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x52, 0x08, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x52, 0x75, 0x6e, 0x4e, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x52, 0x75,
-	0x6e, 0x4e, 0x6f, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x75, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x49,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x52, 0x75, 0x6e, 0x54, 0x61, 0x73, 0x6b,
-	0x49, 0x64, 0x12, 0x4e, 0x0a, 0x0d, 0x52, 0x75, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
-	0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6a, 0x6f, 0x62, 0x73,
-	0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x2e, 0x52, 0x75, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x0d, 0x52, 0x75, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
-	0x72, 0x73, 0x1a, 0x40, 0x0a, 0x12, 0x52, 0x75, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
-	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0xce, 0x01, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x52, 0x61, 0x77, 0x42, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x07, 0x52, 0x61, 0x77, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x42, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x73, 0x6f,
-	0x6e, 0x42, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x4a, 0x73, 0x6f,
-	0x6e, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x67, 0x6e, 0x6f, 0x72,
-	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xc9, 0x04, 0x0a, 0x17, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x2a, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x65, 0x67,
-	0x65, 0x78, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x65, 0x67, 0x65, 0x78, 0x70, 0x12, 0x3c, 0x0a, 0x19, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x47, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x19,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x47, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x12, 0x3c, 0x0a, 0x19, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6d, 0x61, 0x6c, 0x6c,
-	0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x19, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6d, 0x61, 0x6c,
-	0x6c, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x4a, 0x73, 0x6f, 0x6e, 0x42,
-	0x6f, 0x64, 0x79, 0x52, 0x65, 0x67, 0x65, 0x78, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x65, 0x67, 0x65, 0x78, 0x70, 0x12,
-	0x26, 0x0a, 0x0e, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x48, 0x61, 0x73, 0x4b, 0x65,
-	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x6f, 0x64,
-	0x79, 0x48, 0x61, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x17, 0x4a, 0x73, 0x6f, 0x6e, 0x42,
-	0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x47, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x54, 0x68,
-	0x61, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x6f,
-	0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x47, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x54, 0x68, 0x61,
-	0x6e, 0x12, 0x38, 0x0a, 0x17, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a,
-	0x65, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x17, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a, 0x65,
-	0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x54,
-	0x61, 0x73, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x47, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0a, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x47, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x54,
-	0x61, 0x73, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0a, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x67, 0x65, 0x78, 0x70,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x67, 0x65, 0x78, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x46, 0x72, 0x65,
-	0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x46, 0x72, 0x65, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x10, 0x0a, 0x03, 0x4e, 0x6f, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x4e, 0x6f,
-	0x74, 0x22, 0x8b, 0x03, 0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x20, 0x0a, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
-	0x2e, 0x74, 0x72, 0x65, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x4e, 0x6f, 0x64, 0x65,
-	0x73, 0x12, 0x1f, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x09, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x12, 0x1f, 0x0a, 0x05, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x09, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x52, 0x6f,
-	0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x0a, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x57, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x41, 0x63, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x08, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x41, 0x43, 0x4c, 0x52, 0x04, 0x41, 0x63, 0x6c,
-	0x73, 0x12, 0x30, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
-	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x4f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x52, 0x0b, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2a,
-	0x3d, 0x0a, 0x0f, 0x49, 0x64, 0x6d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
-	0x52, 0x6f, 0x6c, 0x65, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x63, 0x6c, 0x10, 0x03, 0x2a, 0x34,
-	0x0a, 0x16, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x10, 0x01, 0x2a, 0x39, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4d,
-	0x65, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a,
-	0x0b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x10, 0x00, 0x12, 0x0f,
-	0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x55, 0x73, 0x65, 0x72, 0x10, 0x01, 0x2a,
-	0x7b, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a,
-	0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x64,
-	0x6c, 0x65, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x10,
-	0x02, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x10, 0x03, 0x12,
-	0x0f, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x65, 0x64, 0x10, 0x04,
-	0x12, 0x0a, 0x0a, 0x06, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x10, 0x05, 0x12, 0x07, 0x0a, 0x03,
-	0x41, 0x6e, 0x79, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x07,
-	0x12, 0x0a, 0x0a, 0x06, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x10, 0x08, 0x2a, 0x67, 0x0a, 0x07,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10,
-	0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x61, 0x75, 0x73, 0x65, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
-	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70,
-	0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x10, 0x04, 0x12, 0x0b,
-	0x0a, 0x07, 0x52, 0x75, 0x6e, 0x4f, 0x6e, 0x63, 0x65, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x49,
-	0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x10, 0x07, 0x32, 0xd2, 0x04, 0x0a, 0x0a, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x50, 0x75, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x13,
-	0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x50, 0x75, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x50, 0x75, 0x74, 0x4a, 0x6f,
-	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x06, 0x47,
-	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x13, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6a, 0x6f, 0x62,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x3e, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12,
-	0x16, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x15,
-	0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
-	0x01, 0x12, 0x38, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x2e, 0x6a,
-	0x6f, 0x62, 0x73, 0x2e, 0x50, 0x75, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x50, 0x75, 0x74, 0x54, 0x61, 0x73,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0d, 0x50,
-	0x75, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x14, 0x2e, 0x6a,
-	0x6f, 0x62, 0x73, 0x2e, 0x50, 0x75, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x50, 0x75, 0x74, 0x54, 0x61, 0x73,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12,
-	0x40, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x16, 0x2e, 0x6a,
-	0x6f, 0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
-	0x01, 0x12, 0x44, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73,
-	0x12, 0x18, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61,
-	0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6a, 0x6f, 0x62,
-	0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x10, 0x44, 0x65, 0x74, 0x65, 0x63,
-	0x74, 0x53, 0x74, 0x75, 0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x6a, 0x6f,
-	0x62, 0x73, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x53, 0x74, 0x75, 0x63, 0x6b, 0x54, 0x61,
-	0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6a, 0x6f, 0x62,
-	0x73, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x53, 0x74, 0x75, 0x63, 0x6b, 0x54, 0x61, 0x73,
-	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x48, 0x0a, 0x0b, 0x54, 0x61,
-	0x73, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x12, 0x11, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x43, 0x74, 0x72, 0x6c,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x1a, 0x19, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x43,
-	0x74, 0x72, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x70, 0x79, 0x64, 0x69, 0x6f, 0x2f, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x2f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6a, 0x6f, 0x62, 0x73,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a,
+	0x6f, 0x62, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x0d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0xed, 0x02, 0x0a, 0x0f, 0x4a, 0x6f, 0x62, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x08, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6a,
+	0x6f, 0x62, 0x73, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x75, 0x6e, 0x4e, 0x6f, 0x77,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x52, 0x75, 0x6e, 0x4e, 0x6f, 0x77, 0x12, 0x1c,
+	0x0a, 0x09, 0x52, 0x75, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x52, 0x75, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x0d,
+	0x52, 0x75, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x75, 0x6e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x52,
+	0x75, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x52, 0x75, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x52, 0x75, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x1a, 0x40,
+	0x0a, 0x12, 0x52, 0x75, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xce, 0x01, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x52,
+	0x61, 0x77, 0x42, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x52, 0x61,
+	0x77, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42,
+	0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x6f, 0x64,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x6f, 0x64,
+	0x79, 0x12, 0x20, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0xc9, 0x04, 0x0a, 0x17, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x49, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x49, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x65, 0x67, 0x65, 0x78, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x64,
+	0x79, 0x52, 0x65, 0x67, 0x65, 0x78, 0x70, 0x12, 0x3c, 0x0a, 0x19, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x47, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72,
+	0x54, 0x68, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x19, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x47, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x72, 0x54, 0x68, 0x61, 0x6e, 0x12, 0x3c, 0x0a, 0x19, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42,
+	0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x68,
+	0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x19, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x42, 0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x54,
+	0x68, 0x61, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x52,
+	0x65, 0x67, 0x65, 0x78, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x4a, 0x73, 0x6f,
+	0x6e, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x65, 0x67, 0x65, 0x78, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x4a,
+	0x73, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x48, 0x61, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x48, 0x61, 0x73,
+	0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x17, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x53,
+	0x69, 0x7a, 0x65, 0x47, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x69,
+	0x7a, 0x65, 0x47, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x12, 0x38, 0x0a,
+	0x17, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6d, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x17,
+	0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6d, 0x61, 0x6c,
+	0x6c, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x54,
+	0x69, 0x6d, 0x65, 0x47, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x54, 0x61, 0x73,
+	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x47, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x54,
+	0x69, 0x6d, 0x65, 0x4c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x54, 0x61, 0x73,
+	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x67, 0x65, 0x78, 0x70, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x67, 0x65, 0x78, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x46, 0x72, 0x65, 0x65, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x46, 0x72,
+	0x65, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x4e,
+	0x6f, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x4e, 0x6f, 0x74, 0x22, 0x8b, 0x03,
+	0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x2a, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x4e,
+	0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x74, 0x72, 0x65,
+	0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1f, 0x0a,
+	0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x69,
+	0x64, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1f,
+	0x0a, 0x05, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x69, 0x64, 0x6d, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12,
+	0x2e, 0x0a, 0x0a, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x0a, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12,
+	0x1c, 0x0a, 0x04, 0x41, 0x63, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e,
+	0x69, 0x64, 0x6d, 0x2e, 0x41, 0x43, 0x4c, 0x52, 0x04, 0x41, 0x63, 0x6c, 0x73, 0x12, 0x30, 0x0a,
+	0x0a, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x34, 0x0a, 0x0b, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6a, 0x6f, 0x62,
+	0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0b,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2a, 0x3d, 0x0a, 0x0f, 0x49,
+	0x64, 0x6d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08,
+	0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65,
+	0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x10,
+	0x02, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x63, 0x6c, 0x10, 0x03, 0x2a, 0x34, 0x0a, 0x16, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x10, 0x01,
+	0x2a, 0x39, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x55, 0x73, 0x65, 0x72, 0x10, 0x01, 0x2a, 0x7b, 0x0a, 0x0a, 0x54,
+	0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x64, 0x6c, 0x65, 0x10, 0x01,
+	0x12, 0x0b, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x0c, 0x0a,
+	0x08, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x65, 0x64, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x10, 0x05, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x6e, 0x79, 0x10,
+	0x06, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x10, 0x08, 0x2a, 0x73, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x09, 0x0a,
+	0x05, 0x50, 0x61, 0x75, 0x73, 0x65, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x10, 0x03, 0x12, 0x0a,
+	0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x75,
+	0x6e, 0x4f, 0x6e, 0x63, 0x65, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x6e, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x10,
+	0x07, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x10, 0x08, 0x32, 0x82, 0x06,
+	0x0a, 0x0a, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x06,
+	0x50, 0x75, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x13, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x50, 0x75,
+	0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6a, 0x6f,
+	0x62, 0x73, 0x2e, 0x50, 0x75, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x13, 0x2e,
+	0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x09, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x16, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x4c, 0x69,
+	0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x15, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e,
+	0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6a,
+	0x6f, 0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59,
+	0x0a, 0x12, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x07, 0x50, 0x75, 0x74,
+	0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x50, 0x75, 0x74, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6a, 0x6f, 0x62,
+	0x73, 0x2e, 0x50, 0x75, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0d, 0x50, 0x75, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x14, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x50, 0x75, 0x74, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6a, 0x6f, 0x62,
+	0x73, 0x2e, 0x50, 0x75, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x40, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x12, 0x16, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6a,
+	0x6f, 0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x0b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x18, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x51, 0x0a, 0x10, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x53, 0x74, 0x75, 0x63, 0x6b, 0x54, 0x61,
+	0x73, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x53, 0x74, 0x75, 0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x53, 0x74, 0x75, 0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0x48, 0x0a, 0x0b, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x39, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x11, 0x2e, 0x6a,
+	0x6f, 0x62, 0x73, 0x2e, 0x43, 0x74, 0x72, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x1a,
+	0x19, 0x2e, 0x6a, 0x6f, 0x62, 0x73, 0x2e, 0x43, 0x74, 0x72, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2a, 0x5a, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x79, 0x64, 0x69, 0x6f,
+	0x2f, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3957,79 +4622,87 @@ func file_cells_jobs_proto_rawDescGZIP() []byte {
 }
 
 var file_cells_jobs_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_cells_jobs_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_cells_jobs_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_cells_jobs_proto_goTypes = []interface{}{
-	(IdmSelectorType)(0),             // 0: jobs.IdmSelectorType
-	(DataSourceSelectorType)(0),      // 1: jobs.DataSourceSelectorType
-	(ContextMetaFilterType)(0),       // 2: jobs.ContextMetaFilterType
-	(TaskStatus)(0),                  // 3: jobs.TaskStatus
-	(Command)(0),                     // 4: jobs.Command
-	(*NodesSelector)(nil),            // 5: jobs.NodesSelector
-	(*IdmSelector)(nil),              // 6: jobs.IdmSelector
-	(*UsersSelector)(nil),            // 7: jobs.UsersSelector
-	(*DataSourceSelector)(nil),       // 8: jobs.DataSourceSelector
-	(*TriggerFilterQuery)(nil),       // 9: jobs.TriggerFilterQuery
-	(*TriggerFilter)(nil),            // 10: jobs.TriggerFilter
-	(*ActionOutputFilter)(nil),       // 11: jobs.ActionOutputFilter
-	(*ContextMetaFilter)(nil),        // 12: jobs.ContextMetaFilter
-	(*ContextMetaSingleQuery)(nil),   // 13: jobs.ContextMetaSingleQuery
-	(*Schedule)(nil),                 // 14: jobs.Schedule
-	(*Action)(nil),                   // 15: jobs.Action
-	(*Job)(nil),                      // 16: jobs.Job
-	(*JobParameter)(nil),             // 17: jobs.JobParameter
-	(*JobChangeEvent)(nil),           // 18: jobs.JobChangeEvent
-	(*TaskChangeEvent)(nil),          // 19: jobs.TaskChangeEvent
-	(*PutJobRequest)(nil),            // 20: jobs.PutJobRequest
-	(*PutJobResponse)(nil),           // 21: jobs.PutJobResponse
-	(*GetJobRequest)(nil),            // 22: jobs.GetJobRequest
-	(*GetJobResponse)(nil),           // 23: jobs.GetJobResponse
-	(*DeleteJobRequest)(nil),         // 24: jobs.DeleteJobRequest
-	(*DeleteJobResponse)(nil),        // 25: jobs.DeleteJobResponse
-	(*ListJobsRequest)(nil),          // 26: jobs.ListJobsRequest
-	(*ListJobsResponse)(nil),         // 27: jobs.ListJobsResponse
-	(*ListTasksRequest)(nil),         // 28: jobs.ListTasksRequest
-	(*ListTasksResponse)(nil),        // 29: jobs.ListTasksResponse
-	(*PutTaskRequest)(nil),           // 30: jobs.PutTaskRequest
-	(*PutTaskResponse)(nil),          // 31: jobs.PutTaskResponse
-	(*DeleteTasksRequest)(nil),       // 32: jobs.DeleteTasksRequest
-	(*DeleteTasksResponse)(nil),      // 33: jobs.DeleteTasksResponse
-	(*DetectStuckTasksRequest)(nil),  // 34: jobs.DetectStuckTasksRequest
-	(*DetectStuckTasksResponse)(nil), // 35: jobs.DetectStuckTasksResponse
-	(*Task)(nil),                     // 36: jobs.Task
-	(*CtrlCommand)(nil),              // 37: jobs.CtrlCommand
-	(*CtrlCommandResponse)(nil),      // 38: jobs.CtrlCommandResponse
-	(*ActionLog)(nil),                // 39: jobs.ActionLog
-	(*JobTriggerEvent)(nil),          // 40: jobs.JobTriggerEvent
-	(*ActionOutput)(nil),             // 41: jobs.ActionOutput
-	(*ActionOutputSingleQuery)(nil),  // 42: jobs.ActionOutputSingleQuery
-	(*ActionMessage)(nil),            // 43: jobs.ActionMessage
-	nil,                              // 44: jobs.Action.ParametersEntry
-	nil,                              // 45: jobs.CtrlCommand.RunParametersEntry
-	nil,                              // 46: jobs.JobTriggerEvent.RunParametersEntry
-	(*service.Query)(nil),            // 47: service.Query
-	(*idm.User)(nil),                 // 48: idm.User
-	(*idm.PolicyCondition)(nil),      // 49: idm.PolicyCondition
-	(*anypb.Any)(nil),                // 50: google.protobuf.Any
-	(*tree.Node)(nil),                // 51: tree.Node
-	(*idm.Role)(nil),                 // 52: idm.Role
-	(*idm.Workspace)(nil),            // 53: idm.Workspace
-	(*idm.ACL)(nil),                  // 54: idm.ACL
-	(*activity.Object)(nil),          // 55: activity.Object
-	(*object.DataSource)(nil),        // 56: object.DataSource
+	(IdmSelectorType)(0),               // 0: jobs.IdmSelectorType
+	(DataSourceSelectorType)(0),        // 1: jobs.DataSourceSelectorType
+	(ContextMetaFilterType)(0),         // 2: jobs.ContextMetaFilterType
+	(TaskStatus)(0),                    // 3: jobs.TaskStatus
+	(Command)(0),                       // 4: jobs.Command
+	(*NodesSelector)(nil),              // 5: jobs.NodesSelector
+	(*IdmSelector)(nil),                // 6: jobs.IdmSelector
+	(*UsersSelector)(nil),              // 7: jobs.UsersSelector
+	(*DataSourceSelector)(nil),         // 8: jobs.DataSourceSelector
+	(*TriggerFilterQuery)(nil),         // 9: jobs.TriggerFilterQuery
+	(*TriggerFilter)(nil),              // 10: jobs.TriggerFilter
+	(*ActionOutputFilter)(nil),         // 11: jobs.ActionOutputFilter
+	(*ContextMetaFilter)(nil),          // 12: jobs.ContextMetaFilter
+	(*ContextMetaSingleQuery)(nil),     // 13: jobs.ContextMetaSingleQuery
+	(*Schedule)(nil),                   // 14: jobs.Schedule
+	(*Action)(nil),                     // 15: jobs.Action
+	(*Job)(nil),                        // 16: jobs.Job
+	(*JobWebhook)(nil),                 // 17: jobs.JobWebhook
+	(*JobParameter)(nil),               // 18: jobs.JobParameter
+	(*JobChangeEvent)(nil),             // 19: jobs.JobChangeEvent
+	(*TaskChangeEvent)(nil),            // 20: jobs.TaskChangeEvent
+	(*PutJobRequest)(nil),              // 21: jobs.PutJobRequest
+	(*PutJobResponse)(nil),             // 22: jobs.PutJobResponse
+	(*GetJobRequest)(nil),              // 23: jobs.GetJobRequest
+	(*GetJobResponse)(nil),             // 24: jobs.GetJobResponse
+	(*DeleteJobRequest)(nil),           // 25: jobs.DeleteJobRequest
+	(*DeleteJobResponse)(nil),          // 26: jobs.DeleteJobResponse
+	(*ListJobsRequest)(nil),            // 27: jobs.ListJobsRequest
+	(*ListJobsResponse)(nil),           // 28: jobs.ListJobsResponse
+	(*ListTasksRequest)(nil),           // 29: jobs.ListTasksRequest
+	(*ListTasksResponse)(nil),          // 30: jobs.ListTasksResponse
+	(*PutTaskRequest)(nil),             // 31: jobs.PutTaskRequest
+	(*PutTaskResponse)(nil),            // 32: jobs.PutTaskResponse
+	(*DeleteTasksRequest)(nil),         // 33: jobs.DeleteTasksRequest
+	(*DeleteTasksResponse)(nil),        // 34: jobs.DeleteTasksResponse
+	(*DetectStuckTasksRequest)(nil),    // 35: jobs.DetectStuckTasksRequest
+	(*DetectStuckTasksResponse)(nil),   // 36: jobs.DetectStuckTasksResponse
+	(*JobRevision)(nil),                // 37: jobs.JobRevision
+	(*ListJobRevisionsRequest)(nil),    // 38: jobs.ListJobRevisionsRequest
+	(*ListJobRevisionsResponse)(nil),   // 39: jobs.ListJobRevisionsResponse
+	(*RestoreJobRevisionRequest)(nil),  // 40: jobs.RestoreJobRevisionRequest
+	(*RestoreJobRevisionResponse)(nil), // 41: jobs.RestoreJobRevisionResponse
+	(*Task)(nil),                       // 42: jobs.Task
+	(*TaskCheckpoint)(nil),             // 43: jobs.TaskCheckpoint
+	(*CtrlCommand)(nil),                // 44: jobs.CtrlCommand
+	(*CtrlCommandResponse)(nil),        // 45: jobs.CtrlCommandResponse
+	(*ActionLog)(nil),                  // 46: jobs.ActionLog
+	(*JobTriggerEvent)(nil),            // 47: jobs.JobTriggerEvent
+	(*ActionOutput)(nil),               // 48: jobs.ActionOutput
+	(*ActionOutputSingleQuery)(nil),    // 49: jobs.ActionOutputSingleQuery
+	(*ActionMessage)(nil),              // 50: jobs.ActionMessage
+	nil,                                // 51: jobs.Action.ParametersEntry
+	nil,                                // 52: jobs.Task.RunParametersEntry
+	nil,                                // 53: jobs.CtrlCommand.RunParametersEntry
+	nil,                                // 54: jobs.JobTriggerEvent.RunParametersEntry
+	(*service.Query)(nil),              // 55: service.Query
+	(*idm.User)(nil),                   // 56: idm.User
+	(*idm.PolicyCondition)(nil),        // 57: idm.PolicyCondition
+	(*anypb.Any)(nil),                  // 58: google.protobuf.Any
+	(*tree.Node)(nil),                  // 59: tree.Node
+	(*idm.Role)(nil),                   // 60: idm.Role
+	(*idm.Workspace)(nil),              // 61: idm.Workspace
+	(*idm.ACL)(nil),                    // 62: idm.ACL
+	(*activity.Object)(nil),            // 63: activity.Object
+	(*object.DataSource)(nil),          // 64: object.DataSource
 }
 var file_cells_jobs_proto_depIdxs = []int32{
-	47, // 0: jobs.NodesSelector.Query:type_name -> service.Query
+	55, // 0: jobs.NodesSelector.Query:type_name -> service.Query
 	0,  // 1: jobs.IdmSelector.Type:type_name -> jobs.IdmSelectorType
-	47, // 2: jobs.IdmSelector.Query:type_name -> service.Query
-	48, // 3: jobs.UsersSelector.Users:type_name -> idm.User
-	47, // 4: jobs.UsersSelector.Query:type_name -> service.Query
+	55, // 2: jobs.IdmSelector.Query:type_name -> service.Query
+	56, // 3: jobs.UsersSelector.Users:type_name -> idm.User
+	55, // 4: jobs.UsersSelector.Query:type_name -> service.Query
 	1,  // 5: jobs.DataSourceSelector.Type:type_name -> jobs.DataSourceSelectorType
-	47, // 6: jobs.DataSourceSelector.Query:type_name -> service.Query
-	47, // 7: jobs.TriggerFilter.Query:type_name -> service.Query
-	47, // 8: jobs.ActionOutputFilter.Query:type_name -> service.Query
+	55, // 6: jobs.DataSourceSelector.Query:type_name -> service.Query
+	55, // 7: jobs.TriggerFilter.Query:type_name -> service.Query
+	55, // 8: jobs.ActionOutputFilter.Query:type_name -> service.Query
 	2,  // 9: jobs.ContextMetaFilter.Type:type_name -> jobs.ContextMetaFilterType
-	47, // 10: jobs.ContextMetaFilter.Query:type_name -> service.Query
-	49, // 11: jobs.ContextMetaSingleQuery.Condition:type_name -> idm.PolicyCondition
+	55, // 10: jobs.ContextMetaFilter.Query:type_name -> service.Query
+	57, // 11: jobs.ContextMetaSingleQuery.Condition:type_name -> idm.PolicyCondition
 	5,  // 12: jobs.Action.NodesSelector:type_name -> jobs.NodesSelector
 	7,  // 13: jobs.Action.UsersSelector:type_name -> jobs.UsersSelector
 	5,  // 14: jobs.Action.NodesFilter:type_name -> jobs.NodesSelector
@@ -4041,76 +4714,86 @@ var file_cells_jobs_proto_depIdxs = []int32{
 	11, // 20: jobs.Action.ActionOutputFilter:type_name -> jobs.ActionOutputFilter
 	12, // 21: jobs.Action.ContextMetaFilter:type_name -> jobs.ContextMetaFilter
 	10, // 22: jobs.Action.TriggerFilter:type_name -> jobs.TriggerFilter
-	44, // 23: jobs.Action.Parameters:type_name -> jobs.Action.ParametersEntry
+	51, // 23: jobs.Action.Parameters:type_name -> jobs.Action.ParametersEntry
 	15, // 24: jobs.Action.ChainedActions:type_name -> jobs.Action
 	15, // 25: jobs.Action.FailedFilterActions:type_name -> jobs.Action
 	14, // 26: jobs.Job.Schedule:type_name -> jobs.Schedule
 	15, // 27: jobs.Job.Actions:type_name -> jobs.Action
-	36, // 28: jobs.Job.Tasks:type_name -> jobs.Task
+	42, // 28: jobs.Job.Tasks:type_name -> jobs.Task
 	5,  // 29: jobs.Job.NodeEventFilter:type_name -> jobs.NodesSelector
 	7,  // 30: jobs.Job.UserEventFilter:type_name -> jobs.UsersSelector
 	6,  // 31: jobs.Job.IdmFilter:type_name -> jobs.IdmSelector
 	12, // 32: jobs.Job.ContextMetaFilter:type_name -> jobs.ContextMetaFilter
 	8,  // 33: jobs.Job.DataSourceFilter:type_name -> jobs.DataSourceSelector
-	17, // 34: jobs.Job.Parameters:type_name -> jobs.JobParameter
-	50, // 35: jobs.Job.ResourcesDependencies:type_name -> google.protobuf.Any
-	16, // 36: jobs.JobChangeEvent.JobUpdated:type_name -> jobs.Job
-	36, // 37: jobs.TaskChangeEvent.TaskUpdated:type_name -> jobs.Task
-	16, // 38: jobs.TaskChangeEvent.Job:type_name -> jobs.Job
-	16, // 39: jobs.PutJobRequest.Job:type_name -> jobs.Job
-	16, // 40: jobs.PutJobResponse.Job:type_name -> jobs.Job
-	3,  // 41: jobs.GetJobRequest.LoadTasks:type_name -> jobs.TaskStatus
-	16, // 42: jobs.GetJobResponse.Job:type_name -> jobs.Job
-	3,  // 43: jobs.ListJobsRequest.LoadTasks:type_name -> jobs.TaskStatus
-	16, // 44: jobs.ListJobsResponse.Job:type_name -> jobs.Job
-	3,  // 45: jobs.ListTasksRequest.Status:type_name -> jobs.TaskStatus
-	36, // 46: jobs.ListTasksResponse.Task:type_name -> jobs.Task
-	36, // 47: jobs.PutTaskRequest.Task:type_name -> jobs.Task
-	36, // 48: jobs.PutTaskResponse.Task:type_name -> jobs.Task
-	3,  // 49: jobs.DeleteTasksRequest.Status:type_name -> jobs.TaskStatus
-	3,  // 50: jobs.Task.Status:type_name -> jobs.TaskStatus
-	39, // 51: jobs.Task.ActionsLogs:type_name -> jobs.ActionLog
-	4,  // 52: jobs.CtrlCommand.Cmd:type_name -> jobs.Command
-	45, // 53: jobs.CtrlCommand.RunParameters:type_name -> jobs.CtrlCommand.RunParametersEntry
-	15, // 54: jobs.ActionLog.Action:type_name -> jobs.Action
-	43, // 55: jobs.ActionLog.InputMessage:type_name -> jobs.ActionMessage
-	43, // 56: jobs.ActionLog.OutputMessage:type_name -> jobs.ActionMessage
-	14, // 57: jobs.JobTriggerEvent.Schedule:type_name -> jobs.Schedule
-	46, // 58: jobs.JobTriggerEvent.RunParameters:type_name -> jobs.JobTriggerEvent.RunParametersEntry
-	50, // 59: jobs.ActionMessage.Event:type_name -> google.protobuf.Any
-	51, // 60: jobs.ActionMessage.Nodes:type_name -> tree.Node
-	48, // 61: jobs.ActionMessage.Users:type_name -> idm.User
-	52, // 62: jobs.ActionMessage.Roles:type_name -> idm.Role
-	53, // 63: jobs.ActionMessage.Workspaces:type_name -> idm.Workspace
-	54, // 64: jobs.ActionMessage.Acls:type_name -> idm.ACL
-	55, // 65: jobs.ActionMessage.Activities:type_name -> activity.Object
-	56, // 66: jobs.ActionMessage.DataSources:type_name -> object.DataSource
-	41, // 67: jobs.ActionMessage.OutputChain:type_name -> jobs.ActionOutput
-	20, // 68: jobs.JobService.PutJob:input_type -> jobs.PutJobRequest
-	22, // 69: jobs.JobService.GetJob:input_type -> jobs.GetJobRequest
-	24, // 70: jobs.JobService.DeleteJob:input_type -> jobs.DeleteJobRequest
-	26, // 71: jobs.JobService.ListJobs:input_type -> jobs.ListJobsRequest
-	30, // 72: jobs.JobService.PutTask:input_type -> jobs.PutTaskRequest
-	30, // 73: jobs.JobService.PutTaskStream:input_type -> jobs.PutTaskRequest
-	28, // 74: jobs.JobService.ListTasks:input_type -> jobs.ListTasksRequest
-	32, // 75: jobs.JobService.DeleteTasks:input_type -> jobs.DeleteTasksRequest
-	34, // 76: jobs.JobService.DetectStuckTasks:input_type -> jobs.DetectStuckTasksRequest
-	37, // 77: jobs.TaskService.Control:input_type -> jobs.CtrlCommand
-	21, // 78: jobs.JobService.PutJob:output_type -> jobs.PutJobResponse
-	23, // 79: jobs.JobService.GetJob:output_type -> jobs.GetJobResponse
-	25, // 80: jobs.JobService.DeleteJob:output_type -> jobs.DeleteJobResponse
-	27, // 81: jobs.JobService.ListJobs:output_type -> jobs.ListJobsResponse
-	31, // 82: jobs.JobService.PutTask:output_type -> jobs.PutTaskResponse
-	31, // 83: jobs.JobService.PutTaskStream:output_type -> jobs.PutTaskResponse
-	29, // 84: jobs.JobService.ListTasks:output_type -> jobs.ListTasksResponse
-	33, // 85: jobs.JobService.DeleteTasks:output_type -> jobs.DeleteTasksResponse
-	35, // 86: jobs.JobService.DetectStuckTasks:output_type -> jobs.DetectStuckTasksResponse
-	38, // 87: jobs.TaskService.Control:output_type -> jobs.CtrlCommandResponse
-	78, // [78:88] is the sub-list for method output_type
-	68, // [68:78] is the sub-list for method input_type
-	68, // [68:68] is the sub-list for extension type_name
-	68, // [68:68] is the sub-list for extension extendee
-	0,  // [0:68] is the sub-list for field type_name
+	18, // 34: jobs.Job.Parameters:type_name -> jobs.JobParameter
+	58, // 35: jobs.Job.ResourcesDependencies:type_name -> google.protobuf.Any
+	17, // 36: jobs.Job.Webhook:type_name -> jobs.JobWebhook
+	16, // 37: jobs.JobChangeEvent.JobUpdated:type_name -> jobs.Job
+	42, // 38: jobs.TaskChangeEvent.TaskUpdated:type_name -> jobs.Task
+	16, // 39: jobs.TaskChangeEvent.Job:type_name -> jobs.Job
+	16, // 40: jobs.PutJobRequest.Job:type_name -> jobs.Job
+	16, // 41: jobs.PutJobResponse.Job:type_name -> jobs.Job
+	3,  // 42: jobs.GetJobRequest.LoadTasks:type_name -> jobs.TaskStatus
+	16, // 43: jobs.GetJobResponse.Job:type_name -> jobs.Job
+	3,  // 44: jobs.ListJobsRequest.LoadTasks:type_name -> jobs.TaskStatus
+	16, // 45: jobs.ListJobsResponse.Job:type_name -> jobs.Job
+	3,  // 46: jobs.ListTasksRequest.Status:type_name -> jobs.TaskStatus
+	42, // 47: jobs.ListTasksResponse.Task:type_name -> jobs.Task
+	42, // 48: jobs.PutTaskRequest.Task:type_name -> jobs.Task
+	42, // 49: jobs.PutTaskResponse.Task:type_name -> jobs.Task
+	3,  // 50: jobs.DeleteTasksRequest.Status:type_name -> jobs.TaskStatus
+	16, // 51: jobs.JobRevision.Job:type_name -> jobs.Job
+	37, // 52: jobs.ListJobRevisionsResponse.Revisions:type_name -> jobs.JobRevision
+	16, // 53: jobs.RestoreJobRevisionResponse.Job:type_name -> jobs.Job
+	3,  // 54: jobs.Task.Status:type_name -> jobs.TaskStatus
+	46, // 55: jobs.Task.ActionsLogs:type_name -> jobs.ActionLog
+	43, // 56: jobs.Task.Checkpoints:type_name -> jobs.TaskCheckpoint
+	52, // 57: jobs.Task.RunParameters:type_name -> jobs.Task.RunParametersEntry
+	4,  // 58: jobs.CtrlCommand.Cmd:type_name -> jobs.Command
+	53, // 59: jobs.CtrlCommand.RunParameters:type_name -> jobs.CtrlCommand.RunParametersEntry
+	15, // 60: jobs.ActionLog.Action:type_name -> jobs.Action
+	50, // 61: jobs.ActionLog.InputMessage:type_name -> jobs.ActionMessage
+	50, // 62: jobs.ActionLog.OutputMessage:type_name -> jobs.ActionMessage
+	14, // 63: jobs.JobTriggerEvent.Schedule:type_name -> jobs.Schedule
+	54, // 64: jobs.JobTriggerEvent.RunParameters:type_name -> jobs.JobTriggerEvent.RunParametersEntry
+	58, // 65: jobs.ActionMessage.Event:type_name -> google.protobuf.Any
+	59, // 66: jobs.ActionMessage.Nodes:type_name -> tree.Node
+	56, // 67: jobs.ActionMessage.Users:type_name -> idm.User
+	60, // 68: jobs.ActionMessage.Roles:type_name -> idm.Role
+	61, // 69: jobs.ActionMessage.Workspaces:type_name -> idm.Workspace
+	62, // 70: jobs.ActionMessage.Acls:type_name -> idm.ACL
+	63, // 71: jobs.ActionMessage.Activities:type_name -> activity.Object
+	64, // 72: jobs.ActionMessage.DataSources:type_name -> object.DataSource
+	48, // 73: jobs.ActionMessage.OutputChain:type_name -> jobs.ActionOutput
+	21, // 74: jobs.JobService.PutJob:input_type -> jobs.PutJobRequest
+	23, // 75: jobs.JobService.GetJob:input_type -> jobs.GetJobRequest
+	25, // 76: jobs.JobService.DeleteJob:input_type -> jobs.DeleteJobRequest
+	27, // 77: jobs.JobService.ListJobs:input_type -> jobs.ListJobsRequest
+	38, // 78: jobs.JobService.ListJobRevisions:input_type -> jobs.ListJobRevisionsRequest
+	40, // 79: jobs.JobService.RestoreJobRevision:input_type -> jobs.RestoreJobRevisionRequest
+	31, // 80: jobs.JobService.PutTask:input_type -> jobs.PutTaskRequest
+	31, // 81: jobs.JobService.PutTaskStream:input_type -> jobs.PutTaskRequest
+	29, // 82: jobs.JobService.ListTasks:input_type -> jobs.ListTasksRequest
+	33, // 83: jobs.JobService.DeleteTasks:input_type -> jobs.DeleteTasksRequest
+	35, // 84: jobs.JobService.DetectStuckTasks:input_type -> jobs.DetectStuckTasksRequest
+	44, // 85: jobs.TaskService.Control:input_type -> jobs.CtrlCommand
+	22, // 86: jobs.JobService.PutJob:output_type -> jobs.PutJobResponse
+	24, // 87: jobs.JobService.GetJob:output_type -> jobs.GetJobResponse
+	26, // 88: jobs.JobService.DeleteJob:output_type -> jobs.DeleteJobResponse
+	28, // 89: jobs.JobService.ListJobs:output_type -> jobs.ListJobsResponse
+	39, // 90: jobs.JobService.ListJobRevisions:output_type -> jobs.ListJobRevisionsResponse
+	41, // 91: jobs.JobService.RestoreJobRevision:output_type -> jobs.RestoreJobRevisionResponse
+	32, // 92: jobs.JobService.PutTask:output_type -> jobs.PutTaskResponse
+	32, // 93: jobs.JobService.PutTaskStream:output_type -> jobs.PutTaskResponse
+	30, // 94: jobs.JobService.ListTasks:output_type -> jobs.ListTasksResponse
+	34, // 95: jobs.JobService.DeleteTasks:output_type -> jobs.DeleteTasksResponse
+	36, // 96: jobs.JobService.DetectStuckTasks:output_type -> jobs.DetectStuckTasksResponse
+	45, // 97: jobs.TaskService.Control:output_type -> jobs.CtrlCommandResponse
+	86, // [86:98] is the sub-list for method output_type
+	74, // [74:86] is the sub-list for method input_type
+	74, // [74:74] is the sub-list for extension type_name
+	74, // [74:74] is the sub-list for extension extendee
+	0,  // [0:74] is the sub-list for field type_name
 }
 
 func init() { file_cells_jobs_proto_init() }
@@ -4264,7 +4947,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JobParameter); i {
+			switch v := v.(*JobWebhook); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4276,7 +4959,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JobChangeEvent); i {
+			switch v := v.(*JobParameter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4288,7 +4971,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskChangeEvent); i {
+			switch v := v.(*JobChangeEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4300,7 +4983,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutJobRequest); i {
+			switch v := v.(*TaskChangeEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4312,7 +4995,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutJobResponse); i {
+			switch v := v.(*PutJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4324,7 +5007,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetJobRequest); i {
+			switch v := v.(*PutJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4336,7 +5019,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetJobResponse); i {
+			switch v := v.(*GetJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4348,7 +5031,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteJobRequest); i {
+			switch v := v.(*GetJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4360,7 +5043,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteJobResponse); i {
+			switch v := v.(*DeleteJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4372,7 +5055,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListJobsRequest); i {
+			switch v := v.(*DeleteJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4384,7 +5067,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListJobsResponse); i {
+			switch v := v.(*ListJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4396,7 +5079,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTasksRequest); i {
+			switch v := v.(*ListJobsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4408,7 +5091,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTasksResponse); i {
+			switch v := v.(*ListTasksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4420,7 +5103,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutTaskRequest); i {
+			switch v := v.(*ListTasksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4432,7 +5115,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutTaskResponse); i {
+			switch v := v.(*PutTaskRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4444,7 +5127,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTasksRequest); i {
+			switch v := v.(*PutTaskResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4456,7 +5139,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTasksResponse); i {
+			switch v := v.(*DeleteTasksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4468,7 +5151,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DetectStuckTasksRequest); i {
+			switch v := v.(*DeleteTasksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4480,7 +5163,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DetectStuckTasksResponse); i {
+			switch v := v.(*DetectStuckTasksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4492,7 +5175,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Task); i {
+			switch v := v.(*DetectStuckTasksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4504,7 +5187,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CtrlCommand); i {
+			switch v := v.(*JobRevision); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4516,7 +5199,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CtrlCommandResponse); i {
+			switch v := v.(*ListJobRevisionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4528,7 +5211,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActionLog); i {
+			switch v := v.(*ListJobRevisionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4540,7 +5223,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JobTriggerEvent); i {
+			switch v := v.(*RestoreJobRevisionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4552,7 +5235,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActionOutput); i {
+			switch v := v.(*RestoreJobRevisionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4564,7 +5247,7 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActionOutputSingleQuery); i {
+			switch v := v.(*Task); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4576,6 +5259,90 @@ func file_cells_jobs_proto_init() {
 			}
 		}
 		file_cells_jobs_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskCheckpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cells_jobs_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CtrlCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cells_jobs_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CtrlCommandResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cells_jobs_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cells_jobs_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobTriggerEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cells_jobs_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cells_jobs_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionOutputSingleQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cells_jobs_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ActionMessage); i {
 			case 0:
 				return &v.state
@@ -4594,7 +5361,7 @@ func file_cells_jobs_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cells_jobs_proto_rawDesc,
 			NumEnums:      5,
-			NumMessages:   42,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -230,6 +230,14 @@ func (this *Job) Validate() error {
 			}
 		}
 	}
+	if this.Webhook != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Webhook); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Webhook", err)
+		}
+	}
+	return nil
+}
+func (this *JobWebhook) Validate() error {
 	return nil
 }
 func (this *JobParameter) Validate() error {
@@ -339,6 +347,38 @@ func (this *DetectStuckTasksRequest) Validate() error {
 func (this *DetectStuckTasksResponse) Validate() error {
 	return nil
 }
+func (this *JobRevision) Validate() error {
+	if this.Job != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Job); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Job", err)
+		}
+	}
+	return nil
+}
+func (this *ListJobRevisionsRequest) Validate() error {
+	return nil
+}
+func (this *ListJobRevisionsResponse) Validate() error {
+	for _, item := range this.Revisions {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Revisions", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *RestoreJobRevisionRequest) Validate() error {
+	return nil
+}
+func (this *RestoreJobRevisionResponse) Validate() error {
+	if this.Job != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Job); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Job", err)
+		}
+	}
+	return nil
+}
 func (this *Task) Validate() error {
 	for _, item := range this.ActionsLogs {
 		if item != nil {
@@ -347,6 +387,17 @@ func (this *Task) Validate() error {
 			}
 		}
 	}
+	for _, item := range this.Checkpoints {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Checkpoints", err)
+			}
+		}
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *TaskCheckpoint) Validate() error {
 	return nil
 }
 func (this *CtrlCommand) Validate() error {
@@ -92,6 +92,36 @@ func (m JobServiceEnhancedServer) ListJobs(r *ListJobsRequest, s JobService_List
 	return status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
 }
 
+func (m JobServiceEnhancedServer) ListJobRevisions(ctx context.Context, r *ListJobRevisionsRequest) (*ListJobRevisionsResponse, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get("targetname")) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "method ListJobRevisions should have a context")
+	}
+	enhancedJobServiceServersLock.RLock()
+	defer enhancedJobServiceServersLock.RUnlock()
+	for _, mm := range m {
+		if mm.Name() == md.Get("targetname")[0] {
+			return mm.ListJobRevisions(ctx, r)
+		}
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobRevisions not implemented")
+}
+
+func (m JobServiceEnhancedServer) RestoreJobRevision(ctx context.Context, r *RestoreJobRevisionRequest) (*RestoreJobRevisionResponse, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get("targetname")) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "method RestoreJobRevision should have a context")
+	}
+	enhancedJobServiceServersLock.RLock()
+	defer enhancedJobServiceServersLock.RUnlock()
+	for _, mm := range m {
+		if mm.Name() == md.Get("targetname")[0] {
+			return mm.RestoreJobRevision(ctx, r)
+		}
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreJobRevision not implemented")
+}
+
 func (m JobServiceEnhancedServer) PutTask(ctx context.Context, r *PutTaskRequest) (*PutTaskResponse, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok || len(md.Get("targetname")) == 0 {
@@ -22,6 +22,8 @@ type JobServiceClient interface {
 	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error)
 	DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*DeleteJobResponse, error)
 	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (JobService_ListJobsClient, error)
+	ListJobRevisions(ctx context.Context, in *ListJobRevisionsRequest, opts ...grpc.CallOption) (*ListJobRevisionsResponse, error)
+	RestoreJobRevision(ctx context.Context, in *RestoreJobRevisionRequest, opts ...grpc.CallOption) (*RestoreJobRevisionResponse, error)
 	PutTask(ctx context.Context, in *PutTaskRequest, opts ...grpc.CallOption) (*PutTaskResponse, error)
 	PutTaskStream(ctx context.Context, opts ...grpc.CallOption) (JobService_PutTaskStreamClient, error)
 	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (JobService_ListTasksClient, error)
@@ -96,6 +98,24 @@ func (x *jobServiceListJobsClient) Recv() (*ListJobsResponse, error) {
 	return m, nil
 }
 
+func (c *jobServiceClient) ListJobRevisions(ctx context.Context, in *ListJobRevisionsRequest, opts ...grpc.CallOption) (*ListJobRevisionsResponse, error) {
+	out := new(ListJobRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/jobs.JobService/ListJobRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) RestoreJobRevision(ctx context.Context, in *RestoreJobRevisionRequest, opts ...grpc.CallOption) (*RestoreJobRevisionResponse, error) {
+	out := new(RestoreJobRevisionResponse)
+	err := c.cc.Invoke(ctx, "/jobs.JobService/RestoreJobRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jobServiceClient) PutTask(ctx context.Context, in *PutTaskRequest, opts ...grpc.CallOption) (*PutTaskResponse, error) {
 	out := new(PutTaskResponse)
 	err := c.cc.Invoke(ctx, "/jobs.JobService/PutTask", in, out, opts...)
@@ -194,6 +214,8 @@ type JobServiceServer interface {
 	GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error)
 	DeleteJob(context.Context, *DeleteJobRequest) (*DeleteJobResponse, error)
 	ListJobs(*ListJobsRequest, JobService_ListJobsServer) error
+	ListJobRevisions(context.Context, *ListJobRevisionsRequest) (*ListJobRevisionsResponse, error)
+	RestoreJobRevision(context.Context, *RestoreJobRevisionRequest) (*RestoreJobRevisionResponse, error)
 	PutTask(context.Context, *PutTaskRequest) (*PutTaskResponse, error)
 	PutTaskStream(JobService_PutTaskStreamServer) error
 	ListTasks(*ListTasksRequest, JobService_ListTasksServer) error
@@ -218,6 +240,12 @@ func (UnimplementedJobServiceServer) DeleteJob(context.Context, *DeleteJobReques
 func (UnimplementedJobServiceServer) ListJobs(*ListJobsRequest, JobService_ListJobsServer) error {
 	return status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
 }
+func (UnimplementedJobServiceServer) ListJobRevisions(context.Context, *ListJobRevisionsRequest) (*ListJobRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobRevisions not implemented")
+}
+func (UnimplementedJobServiceServer) RestoreJobRevision(context.Context, *RestoreJobRevisionRequest) (*RestoreJobRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreJobRevision not implemented")
+}
 func (UnimplementedJobServiceServer) PutTask(context.Context, *PutTaskRequest) (*PutTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PutTask not implemented")
 }
@@ -321,6 +349,42 @@ func (x *jobServiceListJobsServer) Send(m *ListJobsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _JobService_ListJobRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListJobRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jobs.JobService/ListJobRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListJobRevisions(ctx, req.(*ListJobRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_RestoreJobRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreJobRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).RestoreJobRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jobs.JobService/RestoreJobRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).RestoreJobRevision(ctx, req.(*RestoreJobRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JobService_PutTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PutTaskRequest)
 	if err := dec(in); err != nil {
@@ -441,6 +505,14 @@ var JobService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteJob",
 			Handler:    _JobService_DeleteJob_Handler,
 		},
+		{
+			MethodName: "ListJobRevisions",
+			Handler:    _JobService_ListJobRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreJobRevision",
+			Handler:    _JobService_RestoreJobRevision_Handler,
+		},
 		{
 			MethodName: "PutTask",
 			Handler:    _JobService_PutTask_Handler,
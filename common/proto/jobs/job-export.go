@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package jobs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pydio/cells/v4/common/config"
+	json "github.com/pydio/cells/v4/common/utils/jsonx"
+)
+
+const jobsExportVersion = 1
+
+// JobsExport is the document used to transfer jobs definitions between instances
+type JobsExport struct {
+	Version int    `json:"Version"`
+	Date    int64  `json:"Date"`
+	Jobs    []*Job `json:"Jobs"`
+}
+
+// NewJobsExport prepares jobs definitions for export. Tasks are removed. Webhooks secrets are read
+// from the configuration vault if withSecrets is true, and removed otherwise.
+func NewJobsExport(jj []*Job, withSecrets bool) *JobsExport {
+	e := &JobsExport{
+		Version: jobsExportVersion,
+		Date:    time.Now().Unix(),
+	}
+	for _, j := range jj {
+		c := proto.Clone(j).(*Job)
+		c.Tasks = nil
+		if c.Webhook != nil {
+			if withSecrets {
+				c.Webhook.HmacSecret = config.GetSecret(c.WebhookSecretKey()).String()
+			} else {
+				c.Webhook.HmacSecret = ""
+			}
+		}
+		e.Jobs = append(e.Jobs, c)
+	}
+	return e
+}
+
+// Marshal encodes the export in "json" or "yaml" format.
+func (e *JobsExport) Marshal(format string) ([]byte, error) {
+	data, er := json.MarshalIndent(e, "", "  ")
+	if er != nil {
+		return nil, er
+	}
+	switch format {
+	case "json":
+		return data, nil
+	case "yaml", "yml":
+		return yaml.JSONToYAML(data)
+	default:
+		return nil, fmt.Errorf("unsupported export format %s", format)
+	}
+}
+
+// UnmarshalJobsExport decodes an export in JSON or YAML format.
+func UnmarshalJobsExport(data []byte) (*JobsExport, error) {
+	// JSON is valid YAML
+	js, er := yaml.YAMLToJSON(data)
+	if er != nil {
+		return nil, er
+	}
+	e := &JobsExport{}
+	if er := json.Unmarshal(js, e); er != nil {
+		return nil, er
+	}
+	if e.Version > jobsExportVersion {
+		return nil, fmt.Errorf("unsupported export version %d", e.Version)
+	}
+	for i, j := range e.Jobs {
+		if j == nil || j.ID == "" {
+			return nil, fmt.Errorf("job #%d has no ID", i)
+		}
+	}
+	return e, nil
+}
+
+// RemapIDs replaces jobs IDs according to mapping, as well as references to previous IDs found in
+// actions parameters. Jobs that are not in mapping keep their ID.
+func (e *JobsExport) RemapIDs(mapping map[string]string) {
+	var pairs []string
+	for from, to := range mapping {
+		if from != "" && from != to {
+			pairs = append(pairs, from, to)
+		}
+	}
+	if len(pairs) == 0 {
+		return
+	}
+	replacer := strings.NewReplacer(pairs...)
+	for _, j := range e.Jobs {
+		if to, ok := mapping[j.ID]; ok && to != "" {
+			j.ID = to
+		}
+		remapActionsParameters(j.Actions, replacer)
+	}
+}
+
+func remapActionsParameters(aa []*Action, replacer *strings.Replacer) {
+	for _, a := range aa {
+		for k, v := range a.Parameters {
+			a.Parameters[k] = replacer.Replace(v)
+		}
+		remapActionsParameters(a.ChainedActions, replacer)
+		remapActionsParameters(a.FailedFilterActions, replacer)
+	}
+}
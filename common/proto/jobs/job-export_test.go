@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package jobs
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/v4/common/config"
+	"github.com/pydio/cells/v4/common/config/mock"
+	"github.com/pydio/cells/v4/common/utils/configx"
+)
+
+func TestJobsExport(t *testing.T) {
+
+	Convey("Test export and import of jobs", t, func() {
+		config.RegisterVault(&mock.MockStore{Values: configx.New(configx.WithJSON())})
+		config.SetSecret("jobs-webhook-job-1", "secret")
+
+		source := []*Job{
+			{
+				ID:      "job-1",
+				Label:   "First Job",
+				Owner:   "admin",
+				Custom:  true,
+				Webhook: &JobWebhook{Enabled: true},
+				Tasks:   []*Task{{ID: "task"}},
+				Actions: []*Action{{
+					ID:         "actions.cmd.rpc",
+					Parameters: map[string]string{"request": `{"JobID":"job-2"}`},
+					ChainedActions: []*Action{{
+						ID:         "actions.test.fake",
+						Parameters: map[string]string{"job": "job-1"},
+					}},
+				}},
+			},
+			{ID: "job-2", Label: "Second Job", Owner: "admin", Custom: true},
+		}
+
+		export := NewJobsExport(source, false)
+		So(export.Jobs, ShouldHaveLength, 2)
+		So(export.Jobs[0].Tasks, ShouldBeEmpty)
+		So(export.Jobs[0].Webhook.HmacSecret, ShouldBeEmpty)
+		So(NewJobsExport(source, true).Jobs[0].Webhook.HmacSecret, ShouldEqual, "secret")
+		So(source[0].Webhook.HmacSecret, ShouldBeEmpty)
+
+		for _, format := range []string{"json", "yaml"} {
+			data, e := export.Marshal(format)
+			So(e, ShouldBeNil)
+			imported, e := UnmarshalJobsExport(data)
+			So(e, ShouldBeNil)
+			So(imported.Jobs, ShouldHaveLength, 2)
+			So(imported.Jobs[0].Label, ShouldEqual, "First Job")
+			So(imported.Jobs[0].Actions[0].ChainedActions, ShouldHaveLength, 1)
+		}
+		_, e := export.Marshal("xml")
+		So(e, ShouldNotBeNil)
+
+		_, e = UnmarshalJobsExport([]byte(`{"Version":1,"Jobs":[{"Label":"No ID"}]}`))
+		So(e, ShouldNotBeNil)
+
+		export.RemapIDs(map[string]string{"job-1": "new-1", "job-2": "new-2"})
+		So(export.Jobs[0].ID, ShouldEqual, "new-1")
+		So(export.Jobs[1].ID, ShouldEqual, "new-2")
+		So(export.Jobs[0].Actions[0].Parameters["request"], ShouldEqual, `{"JobID":"new-2"}`)
+		So(export.Jobs[0].Actions[0].ChainedActions[0].Parameters["job"], ShouldEqual, "new-1")
+
+	})
+}
+
+func TestJobSecrets(t *testing.T) {
+
+	Convey("Secrets are redacted and restored from the current job", t, func() {
+		job := &Job{
+			ID:         "job-1",
+			Parameters: []*JobParameter{{Name: "pass", Type: "password", Value: "p1"}, {Name: "label", Type: "string", Value: "l"}},
+			Actions: []*Action{{
+				ID:         "actions.archive.compress",
+				Parameters: map[string]string{"password": "p2", "format": "zip"},
+				ChainedActions: []*Action{{
+					ID:         "actions.archive.extract",
+					Parameters: map[string]string{"password": "p3"},
+				}},
+			}},
+		}
+		redacted := job.WithoutSecrets()
+		So(redacted.Parameters[0].Value, ShouldEqual, RedactedSecret)
+		So(redacted.Parameters[1].Value, ShouldEqual, "l")
+		So(redacted.Actions[0].Parameters["password"], ShouldEqual, RedactedSecret)
+		So(redacted.Actions[0].Parameters["format"], ShouldEqual, "zip")
+		So(redacted.Actions[0].ChainedActions[0].Parameters["password"], ShouldEqual, RedactedSecret)
+		So(job.Actions[0].Parameters["password"], ShouldEqual, "p2")
+
+		current := job.WithoutSecrets()
+		current.Parameters[0].Value = "new"
+		current.Actions[0].Parameters["password"] = "new2"
+		current.Actions[0].ChainedActions[0].ID = "actions.other"
+		redacted.RestoreSecrets(current)
+		So(redacted.Parameters[0].Value, ShouldEqual, "new")
+		So(redacted.Actions[0].Parameters["password"], ShouldEqual, "new2")
+		So(redacted.Actions[0].ChainedActions[0].Parameters["password"], ShouldBeEmpty)
+	})
+}
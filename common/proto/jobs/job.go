@@ -21,6 +21,7 @@
 package jobs
 
 import (
+	"fmt"
 	"time"
 
 	"go.uber.org/zap"
@@ -138,3 +139,67 @@ func MustMarshalAnyMultiple(pbs ...proto.Message) (out []*anypb.Any) {
 	}
 	return
 }
+
+// WebhookSecretKey returns the identifier of the webhook secret in the configuration vault.
+func (job *Job) WebhookSecretKey() string {
+	return "jobs-webhook-" + job.GetID()
+}
+
+// RedactedSecret replaces secret values in jobs revisions.
+const RedactedSecret = "__REDACTED__"
+
+// WithoutSecrets returns a copy of the job where job parameters of type password and "password"
+// actions parameters are replaced by RedactedSecret.
+func (job *Job) WithoutSecrets() *Job {
+	c := proto.Clone(job).(*Job)
+	for _, p := range c.Parameters {
+		if p.Type == "password" && p.Value != "" {
+			p.Value = RedactedSecret
+		}
+	}
+	walkActions(c.Actions, "", func(_ string, a *Action) {
+		if v, ok := a.Parameters["password"]; ok && v != "" {
+			a.Parameters["password"] = RedactedSecret
+		}
+	})
+	return c
+}
+
+// RestoreSecrets replaces redacted values with the values found in current, for the same job parameter
+// or the same action at the same position. Values that cannot be found are emptied.
+func (job *Job) RestoreSecrets(current *Job) {
+	for _, p := range job.Parameters {
+		if p.Value != RedactedSecret {
+			continue
+		}
+		p.Value = ""
+		for _, cp := range current.GetParameters() {
+			if cp.Name == p.Name {
+				p.Value = cp.Value
+			}
+		}
+	}
+	currentActions := make(map[string]*Action)
+	walkActions(current.GetActions(), "", func(p string, a *Action) {
+		currentActions[p] = a
+	})
+	walkActions(job.Actions, "", func(p string, a *Action) {
+		if a.Parameters["password"] != RedactedSecret {
+			return
+		}
+		a.Parameters["password"] = ""
+		if ca, ok := currentActions[p]; ok && ca.ID == a.ID {
+			a.Parameters["password"] = ca.Parameters["password"]
+		}
+	})
+}
+
+// walkActions calls f on each action of the tree with a path made of actions positions.
+func walkActions(aa []*Action, parent string, f func(p string, a *Action)) {
+	for i, a := range aa {
+		p := fmt.Sprintf("%s/%d", parent, i)
+		f(p, a)
+		walkActions(a.ChainedActions, p+"/c", f)
+		walkActions(a.FailedFilterActions, p+"/f", f)
+	}
+}
@@ -44,6 +44,16 @@ func (this *Mail) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Sender", err)
 		}
 	}
+	for _, item := range this.InlineAttachments {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("InlineAttachments", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *Attachment) Validate() error {
 	return nil
 }
 func (this *SendMailRequest) Validate() error {
@@ -400,7 +400,7 @@ type ShareLink struct {
 	UserLogin string `protobuf:"bytes,7,opt,name=UserLogin,proto3" json:"UserLogin,omitempty"`
 	// Whether a password is required or not to access the link
 	PasswordRequired bool `protobuf:"varint,8,opt,name=PasswordRequired,proto3" json:"PasswordRequired,omitempty"`
-	// Timestamp of start date for enabling the share (not implemented yet)
+	// Timestamp of start date for enabling the share
 	AccessStart int64 `protobuf:"varint,9,opt,name=AccessStart,proto3" json:"AccessStart,omitempty"`
 	// Timestamp after which the share is disabled
 	AccessEnd int64 `protobuf:"varint,10,opt,name=AccessEnd,proto3" json:"AccessEnd,omitempty"`
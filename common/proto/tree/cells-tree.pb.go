@@ -954,6 +954,8 @@ type DeleteNodeResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Success bool `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+	// Node concerned by the request, only sent back by streams to report per-item errors
+	Node *Node `protobuf:"bytes,2,opt,name=Node,proto3" json:"Node,omitempty"`
 }
 
 func (x *DeleteNodeResponse) Reset() {
@@ -995,6 +997,13 @@ func (x *DeleteNodeResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *DeleteNodeResponse) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
 type IndexationSession struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3816,9 +3825,11 @@ var file_cells_tree_proto_rawDesc = []byte{
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x69, 0x6c, 0x65,
 	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x53, 0x69, 0x6c, 0x65, 0x6e, 0x74,
-	0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x22, 0x4e, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
 	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x1e, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x74, 0x72, 0x65, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x4e, 0x6f, 0x64, 0x65,
 	0x22, 0xf9, 0x01, 0x0a, 0x11, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
 	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x75, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65,
@@ -4397,101 +4408,102 @@ var file_cells_tree_proto_depIdxs = []int32{
 	40, // 10: tree.UpdateNodeRequest.To:type_name -> tree.Node
 	40, // 11: tree.UpdateNodeResponse.Node:type_name -> tree.Node
 	40, // 12: tree.DeleteNodeRequest.Node:type_name -> tree.Node
-	40, // 13: tree.IndexationSession.RootNode:type_name -> tree.Node
-	15, // 14: tree.OpenSessionRequest.Session:type_name -> tree.IndexationSession
-	15, // 15: tree.OpenSessionResponse.Session:type_name -> tree.IndexationSession
-	15, // 16: tree.FlushSessionRequest.Session:type_name -> tree.IndexationSession
-	15, // 17: tree.FlushSessionResponse.Session:type_name -> tree.IndexationSession
-	15, // 18: tree.CloseSessionRequest.Session:type_name -> tree.IndexationSession
-	15, // 19: tree.CloseSessionResponse.Session:type_name -> tree.IndexationSession
-	40, // 20: tree.WatchNodeRequest.Node:type_name -> tree.Node
-	40, // 21: tree.WatchNodeResponse.Node:type_name -> tree.Node
-	43, // 22: tree.SearchRequest.Query:type_name -> tree.Query
-	40, // 23: tree.SearchResponse.Node:type_name -> tree.Node
-	26, // 24: tree.SearchResponse.Facet:type_name -> tree.SearchFacet
-	40, // 25: tree.CreateVersionRequest.Node:type_name -> tree.Node
-	47, // 26: tree.CreateVersionRequest.TriggerEvent:type_name -> tree.NodeChangeEvent
-	42, // 27: tree.CreateVersionResponse.Version:type_name -> tree.ChangeLog
-	40, // 28: tree.ListVersionsRequest.Node:type_name -> tree.Node
-	42, // 29: tree.ListVersionsResponse.Version:type_name -> tree.ChangeLog
-	40, // 30: tree.HeadVersionRequest.Node:type_name -> tree.Node
-	42, // 31: tree.HeadVersionResponse.Version:type_name -> tree.ChangeLog
-	40, // 32: tree.StoreVersionRequest.Node:type_name -> tree.Node
-	42, // 33: tree.StoreVersionRequest.Version:type_name -> tree.ChangeLog
-	42, // 34: tree.StoreVersionResponse.PruneVersions:type_name -> tree.ChangeLog
-	40, // 35: tree.PruneVersionsRequest.UniqueNode:type_name -> tree.Node
-	42, // 36: tree.PruneVersionsResponse.DeletedVersions:type_name -> tree.ChangeLog
-	39, // 37: tree.VersioningPolicy.KeepPeriods:type_name -> tree.VersioningKeepPeriod
-	0,  // 38: tree.VersioningPolicy.NodeDeletedStrategy:type_name -> tree.VersioningNodeDeletedStrategy
-	1,  // 39: tree.Node.Type:type_name -> tree.NodeType
-	42, // 40: tree.Node.Commits:type_name -> tree.ChangeLog
-	55, // 41: tree.Node.MetaStore:type_name -> tree.Node.MetaStoreEntry
-	41, // 42: tree.Node.AppearsIn:type_name -> tree.WorkspaceRelativePath
-	47, // 43: tree.ChangeLog.Event:type_name -> tree.NodeChangeEvent
-	40, // 44: tree.ChangeLog.Location:type_name -> tree.Node
-	1,  // 45: tree.Query.Type:type_name -> tree.NodeType
-	44, // 46: tree.Query.GeoQuery:type_name -> tree.GeoQuery
-	45, // 47: tree.GeoQuery.Center:type_name -> tree.GeoPoint
-	45, // 48: tree.GeoQuery.TopLeft:type_name -> tree.GeoPoint
-	45, // 49: tree.GeoQuery.BottomRight:type_name -> tree.GeoPoint
-	2,  // 50: tree.NodeChangeEvent.Type:type_name -> tree.NodeChangeEvent.EventType
-	40, // 51: tree.NodeChangeEvent.Source:type_name -> tree.Node
-	40, // 52: tree.NodeChangeEvent.Target:type_name -> tree.Node
-	56, // 53: tree.NodeChangeEvent.Metadata:type_name -> tree.NodeChangeEvent.MetadataEntry
-	40, // 54: tree.GetEncryptionKeyRequest.Node:type_name -> tree.Node
-	3,  // 55: tree.SyncChange.type:type_name -> tree.SyncChange.Type
-	52, // 56: tree.SyncChange.node:type_name -> tree.SyncChangeNode
-	4,  // 57: tree.NodeProvider.ReadNode:input_type -> tree.ReadNodeRequest
-	6,  // 58: tree.NodeProvider.ListNodes:input_type -> tree.ListNodesRequest
-	4,  // 59: tree.NodeProviderStreamer.ReadNodeStream:input_type -> tree.ReadNodeRequest
-	46, // 60: tree.NodeChangesStreamer.StreamChanges:input_type -> tree.StreamChangesRequest
-	9,  // 61: tree.NodeReceiver.CreateNode:input_type -> tree.CreateNodeRequest
-	11, // 62: tree.NodeReceiver.UpdateNode:input_type -> tree.UpdateNodeRequest
-	13, // 63: tree.NodeReceiver.DeleteNode:input_type -> tree.DeleteNodeRequest
-	9,  // 64: tree.NodeReceiverStream.CreateNodeStream:input_type -> tree.CreateNodeRequest
-	11, // 65: tree.NodeReceiverStream.UpdateNodeStream:input_type -> tree.UpdateNodeRequest
-	13, // 66: tree.NodeReceiverStream.DeleteNodeStream:input_type -> tree.DeleteNodeRequest
-	17, // 67: tree.SessionIndexer.OpenSession:input_type -> tree.OpenSessionRequest
-	19, // 68: tree.SessionIndexer.FlushSession:input_type -> tree.FlushSessionRequest
-	21, // 69: tree.SessionIndexer.CloseSession:input_type -> tree.CloseSessionRequest
-	23, // 70: tree.NodeEventsProvider.WatchNode:input_type -> tree.WatchNodeRequest
-	25, // 71: tree.Searcher.Search:input_type -> tree.SearchRequest
-	28, // 72: tree.NodeVersioner.CreateVersion:input_type -> tree.CreateVersionRequest
-	34, // 73: tree.NodeVersioner.StoreVersion:input_type -> tree.StoreVersionRequest
-	30, // 74: tree.NodeVersioner.ListVersions:input_type -> tree.ListVersionsRequest
-	32, // 75: tree.NodeVersioner.HeadVersion:input_type -> tree.HeadVersionRequest
-	36, // 76: tree.NodeVersioner.PruneVersions:input_type -> tree.PruneVersionsRequest
-	49, // 77: tree.FileKeyManager.GetEncryptionKey:input_type -> tree.GetEncryptionKeyRequest
-	51, // 78: tree.SyncChanges.Put:input_type -> tree.SyncChange
-	54, // 79: tree.SyncChanges.Search:input_type -> tree.SearchSyncChangeRequest
-	5,  // 80: tree.NodeProvider.ReadNode:output_type -> tree.ReadNodeResponse
-	7,  // 81: tree.NodeProvider.ListNodes:output_type -> tree.ListNodesResponse
-	5,  // 82: tree.NodeProviderStreamer.ReadNodeStream:output_type -> tree.ReadNodeResponse
-	47, // 83: tree.NodeChangesStreamer.StreamChanges:output_type -> tree.NodeChangeEvent
-	10, // 84: tree.NodeReceiver.CreateNode:output_type -> tree.CreateNodeResponse
-	12, // 85: tree.NodeReceiver.UpdateNode:output_type -> tree.UpdateNodeResponse
-	14, // 86: tree.NodeReceiver.DeleteNode:output_type -> tree.DeleteNodeResponse
-	10, // 87: tree.NodeReceiverStream.CreateNodeStream:output_type -> tree.CreateNodeResponse
-	12, // 88: tree.NodeReceiverStream.UpdateNodeStream:output_type -> tree.UpdateNodeResponse
-	14, // 89: tree.NodeReceiverStream.DeleteNodeStream:output_type -> tree.DeleteNodeResponse
-	18, // 90: tree.SessionIndexer.OpenSession:output_type -> tree.OpenSessionResponse
-	20, // 91: tree.SessionIndexer.FlushSession:output_type -> tree.FlushSessionResponse
-	22, // 92: tree.SessionIndexer.CloseSession:output_type -> tree.CloseSessionResponse
-	24, // 93: tree.NodeEventsProvider.WatchNode:output_type -> tree.WatchNodeResponse
-	27, // 94: tree.Searcher.Search:output_type -> tree.SearchResponse
-	29, // 95: tree.NodeVersioner.CreateVersion:output_type -> tree.CreateVersionResponse
-	35, // 96: tree.NodeVersioner.StoreVersion:output_type -> tree.StoreVersionResponse
-	31, // 97: tree.NodeVersioner.ListVersions:output_type -> tree.ListVersionsResponse
-	33, // 98: tree.NodeVersioner.HeadVersion:output_type -> tree.HeadVersionResponse
-	37, // 99: tree.NodeVersioner.PruneVersions:output_type -> tree.PruneVersionsResponse
-	50, // 100: tree.FileKeyManager.GetEncryptionKey:output_type -> tree.GetEncryptionKeyResponse
-	53, // 101: tree.SyncChanges.Put:output_type -> tree.PutSyncChangeResponse
-	51, // 102: tree.SyncChanges.Search:output_type -> tree.SyncChange
-	80, // [80:103] is the sub-list for method output_type
-	57, // [57:80] is the sub-list for method input_type
-	57, // [57:57] is the sub-list for extension type_name
-	57, // [57:57] is the sub-list for extension extendee
-	0,  // [0:57] is the sub-list for field type_name
+	40, // 13: tree.DeleteNodeResponse.Node:type_name -> tree.Node
+	40, // 14: tree.IndexationSession.RootNode:type_name -> tree.Node
+	15, // 15: tree.OpenSessionRequest.Session:type_name -> tree.IndexationSession
+	15, // 16: tree.OpenSessionResponse.Session:type_name -> tree.IndexationSession
+	15, // 17: tree.FlushSessionRequest.Session:type_name -> tree.IndexationSession
+	15, // 18: tree.FlushSessionResponse.Session:type_name -> tree.IndexationSession
+	15, // 19: tree.CloseSessionRequest.Session:type_name -> tree.IndexationSession
+	15, // 20: tree.CloseSessionResponse.Session:type_name -> tree.IndexationSession
+	40, // 21: tree.WatchNodeRequest.Node:type_name -> tree.Node
+	40, // 22: tree.WatchNodeResponse.Node:type_name -> tree.Node
+	43, // 23: tree.SearchRequest.Query:type_name -> tree.Query
+	40, // 24: tree.SearchResponse.Node:type_name -> tree.Node
+	26, // 25: tree.SearchResponse.Facet:type_name -> tree.SearchFacet
+	40, // 26: tree.CreateVersionRequest.Node:type_name -> tree.Node
+	47, // 27: tree.CreateVersionRequest.TriggerEvent:type_name -> tree.NodeChangeEvent
+	42, // 28: tree.CreateVersionResponse.Version:type_name -> tree.ChangeLog
+	40, // 29: tree.ListVersionsRequest.Node:type_name -> tree.Node
+	42, // 30: tree.ListVersionsResponse.Version:type_name -> tree.ChangeLog
+	40, // 31: tree.HeadVersionRequest.Node:type_name -> tree.Node
+	42, // 32: tree.HeadVersionResponse.Version:type_name -> tree.ChangeLog
+	40, // 33: tree.StoreVersionRequest.Node:type_name -> tree.Node
+	42, // 34: tree.StoreVersionRequest.Version:type_name -> tree.ChangeLog
+	42, // 35: tree.StoreVersionResponse.PruneVersions:type_name -> tree.ChangeLog
+	40, // 36: tree.PruneVersionsRequest.UniqueNode:type_name -> tree.Node
+	42, // 37: tree.PruneVersionsResponse.DeletedVersions:type_name -> tree.ChangeLog
+	39, // 38: tree.VersioningPolicy.KeepPeriods:type_name -> tree.VersioningKeepPeriod
+	0,  // 39: tree.VersioningPolicy.NodeDeletedStrategy:type_name -> tree.VersioningNodeDeletedStrategy
+	1,  // 40: tree.Node.Type:type_name -> tree.NodeType
+	42, // 41: tree.Node.Commits:type_name -> tree.ChangeLog
+	55, // 42: tree.Node.MetaStore:type_name -> tree.Node.MetaStoreEntry
+	41, // 43: tree.Node.AppearsIn:type_name -> tree.WorkspaceRelativePath
+	47, // 44: tree.ChangeLog.Event:type_name -> tree.NodeChangeEvent
+	40, // 45: tree.ChangeLog.Location:type_name -> tree.Node
+	1,  // 46: tree.Query.Type:type_name -> tree.NodeType
+	44, // 47: tree.Query.GeoQuery:type_name -> tree.GeoQuery
+	45, // 48: tree.GeoQuery.Center:type_name -> tree.GeoPoint
+	45, // 49: tree.GeoQuery.TopLeft:type_name -> tree.GeoPoint
+	45, // 50: tree.GeoQuery.BottomRight:type_name -> tree.GeoPoint
+	2,  // 51: tree.NodeChangeEvent.Type:type_name -> tree.NodeChangeEvent.EventType
+	40, // 52: tree.NodeChangeEvent.Source:type_name -> tree.Node
+	40, // 53: tree.NodeChangeEvent.Target:type_name -> tree.Node
+	56, // 54: tree.NodeChangeEvent.Metadata:type_name -> tree.NodeChangeEvent.MetadataEntry
+	40, // 55: tree.GetEncryptionKeyRequest.Node:type_name -> tree.Node
+	3,  // 56: tree.SyncChange.type:type_name -> tree.SyncChange.Type
+	52, // 57: tree.SyncChange.node:type_name -> tree.SyncChangeNode
+	4,  // 58: tree.NodeProvider.ReadNode:input_type -> tree.ReadNodeRequest
+	6,  // 59: tree.NodeProvider.ListNodes:input_type -> tree.ListNodesRequest
+	4,  // 60: tree.NodeProviderStreamer.ReadNodeStream:input_type -> tree.ReadNodeRequest
+	46, // 61: tree.NodeChangesStreamer.StreamChanges:input_type -> tree.StreamChangesRequest
+	9,  // 62: tree.NodeReceiver.CreateNode:input_type -> tree.CreateNodeRequest
+	11, // 63: tree.NodeReceiver.UpdateNode:input_type -> tree.UpdateNodeRequest
+	13, // 64: tree.NodeReceiver.DeleteNode:input_type -> tree.DeleteNodeRequest
+	9,  // 65: tree.NodeReceiverStream.CreateNodeStream:input_type -> tree.CreateNodeRequest
+	11, // 66: tree.NodeReceiverStream.UpdateNodeStream:input_type -> tree.UpdateNodeRequest
+	13, // 67: tree.NodeReceiverStream.DeleteNodeStream:input_type -> tree.DeleteNodeRequest
+	17, // 68: tree.SessionIndexer.OpenSession:input_type -> tree.OpenSessionRequest
+	19, // 69: tree.SessionIndexer.FlushSession:input_type -> tree.FlushSessionRequest
+	21, // 70: tree.SessionIndexer.CloseSession:input_type -> tree.CloseSessionRequest
+	23, // 71: tree.NodeEventsProvider.WatchNode:input_type -> tree.WatchNodeRequest
+	25, // 72: tree.Searcher.Search:input_type -> tree.SearchRequest
+	28, // 73: tree.NodeVersioner.CreateVersion:input_type -> tree.CreateVersionRequest
+	34, // 74: tree.NodeVersioner.StoreVersion:input_type -> tree.StoreVersionRequest
+	30, // 75: tree.NodeVersioner.ListVersions:input_type -> tree.ListVersionsRequest
+	32, // 76: tree.NodeVersioner.HeadVersion:input_type -> tree.HeadVersionRequest
+	36, // 77: tree.NodeVersioner.PruneVersions:input_type -> tree.PruneVersionsRequest
+	49, // 78: tree.FileKeyManager.GetEncryptionKey:input_type -> tree.GetEncryptionKeyRequest
+	51, // 79: tree.SyncChanges.Put:input_type -> tree.SyncChange
+	54, // 80: tree.SyncChanges.Search:input_type -> tree.SearchSyncChangeRequest
+	5,  // 81: tree.NodeProvider.ReadNode:output_type -> tree.ReadNodeResponse
+	7,  // 82: tree.NodeProvider.ListNodes:output_type -> tree.ListNodesResponse
+	5,  // 83: tree.NodeProviderStreamer.ReadNodeStream:output_type -> tree.ReadNodeResponse
+	47, // 84: tree.NodeChangesStreamer.StreamChanges:output_type -> tree.NodeChangeEvent
+	10, // 85: tree.NodeReceiver.CreateNode:output_type -> tree.CreateNodeResponse
+	12, // 86: tree.NodeReceiver.UpdateNode:output_type -> tree.UpdateNodeResponse
+	14, // 87: tree.NodeReceiver.DeleteNode:output_type -> tree.DeleteNodeResponse
+	10, // 88: tree.NodeReceiverStream.CreateNodeStream:output_type -> tree.CreateNodeResponse
+	12, // 89: tree.NodeReceiverStream.UpdateNodeStream:output_type -> tree.UpdateNodeResponse
+	14, // 90: tree.NodeReceiverStream.DeleteNodeStream:output_type -> tree.DeleteNodeResponse
+	18, // 91: tree.SessionIndexer.OpenSession:output_type -> tree.OpenSessionResponse
+	20, // 92: tree.SessionIndexer.FlushSession:output_type -> tree.FlushSessionResponse
+	22, // 93: tree.SessionIndexer.CloseSession:output_type -> tree.CloseSessionResponse
+	24, // 94: tree.NodeEventsProvider.WatchNode:output_type -> tree.WatchNodeResponse
+	27, // 95: tree.Searcher.Search:output_type -> tree.SearchResponse
+	29, // 96: tree.NodeVersioner.CreateVersion:output_type -> tree.CreateVersionResponse
+	35, // 97: tree.NodeVersioner.StoreVersion:output_type -> tree.StoreVersionResponse
+	31, // 98: tree.NodeVersioner.ListVersions:output_type -> tree.ListVersionsResponse
+	33, // 99: tree.NodeVersioner.HeadVersion:output_type -> tree.HeadVersionResponse
+	37, // 100: tree.NodeVersioner.PruneVersions:output_type -> tree.PruneVersionsResponse
+	50, // 101: tree.FileKeyManager.GetEncryptionKey:output_type -> tree.GetEncryptionKeyResponse
+	53, // 102: tree.SyncChanges.Put:output_type -> tree.PutSyncChangeResponse
+	51, // 103: tree.SyncChanges.Search:output_type -> tree.SyncChange
+	81, // [81:104] is the sub-list for method output_type
+	58, // [58:81] is the sub-list for method input_type
+	58, // [58:58] is the sub-list for extension type_name
+	58, // [58:58] is the sub-list for extension extendee
+	0,  // [0:58] is the sub-list for field type_name
 }
 
 func init() { file_cells_tree_proto_init() }
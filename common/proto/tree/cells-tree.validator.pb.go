@@ -110,6 +110,11 @@ func (this *DeleteNodeRequest) Validate() error {
 	return nil
 }
 func (this *DeleteNodeResponse) Validate() error {
+	if this.Node != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Node); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Node", err)
+		}
+	}
 	return nil
 }
 func (this *IndexationSession) Validate() error {
@@ -174,7 +174,7 @@ func (h *Handler) Prepare(key string, query interface{}) error {
 }
 
 func (h *Handler) addStmt(query string) (Stmt, error) {
-	stmt, err := h.DB().Prepare(query)
+	stmt, err := h.DB().Prepare(h.helper.Rebind(query))
 	if err != nil {
 		return nil, err
 	}
@@ -26,6 +26,7 @@ type Helper interface {
 	Concat(...string) string
 	Hash(...string) string
 	HashParent(string, ...string) string
+	Rebind(string) string
 }
 
 func newHelper(d string) (Helper, error) {
@@ -34,6 +35,8 @@ func newHelper(d string) (Helper, error) {
 		return new(mysql), nil
 	case "sqlite3":
 		return new(sqlite), nil
+	case "postgres":
+		return new(postgres), nil
 	default:
 		return nil, fmt.Errorf("wrong driver")
 	}
@@ -36,6 +36,7 @@ import (
 
 	"github.com/pydio/cells/v4/common/dao"
 	"github.com/pydio/cells/v4/common/dao/sqlite"
+	"github.com/pydio/cells/v4/common/dao/test"
 	"github.com/pydio/cells/v4/common/proto/tree"
 	servicecontext "github.com/pydio/cells/v4/common/service/context"
 	"github.com/pydio/cells/v4/common/utils/mtree"
@@ -51,7 +52,8 @@ func TestMain(m *testing.M) {
 		return NewDAO(d, "ROOT")
 	}
 	var e error
-	if baseCacheDAO, e = dao.InitDAO(sqlite.Driver, sqlite.SharedMemDSN, "test", wrapper, options); e != nil {
+	driver, dsn := test.SQLDriver(sqlite.Driver, sqlite.SharedMemDSN)
+	if baseCacheDAO, e = dao.InitDAO(driver, dsn, "test", wrapper, options); e != nil {
 		panic(e)
 	}
 	m.Run()
@@ -34,6 +34,7 @@ import (
 
 	"github.com/pydio/cells/v4/common/dao"
 	"github.com/pydio/cells/v4/common/dao/sqlite"
+	"github.com/pydio/cells/v4/common/dao/test"
 	"github.com/pydio/cells/v4/common/proto/tree"
 	servicecontext "github.com/pydio/cells/v4/common/service/context"
 	"github.com/pydio/cells/v4/common/utils/mtree"
@@ -50,7 +51,8 @@ func init() {
 		return NewDAO(d, "ROOT")
 	}
 
-	if d, er := dao.InitDAO(sqlite.Driver, "file::memnocache:?mode=memory&cache=shared", "test", wrapper, options); er != nil {
+	driver, dsn := test.SQLDriver(sqlite.Driver, "file::memnocache:?mode=memory&cache=shared")
+	if d, er := dao.InitDAO(driver, dsn, "test", wrapper, options); er != nil {
 		panic(er)
 	} else {
 		ctxNoCache = servicecontext.WithDAO(context.Background(), d)
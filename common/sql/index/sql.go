@@ -126,6 +126,30 @@ func init() {
 			)
 		`
 	}
+	queries["updateTree_postgres"] = func(dao sql.DAO, mpathes ...string) string {
+
+		columns := []string{"level", "name", "leaf", "mtime", "etag", "size", "mode", "mpath1", "mpath2", "mpath3", "mpath4", "uuid"}
+		values := []string{"?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?"}
+
+		var updates []string
+		for _, c := range columns[:len(columns)-1] {
+			updates = append(updates, c+"=excluded."+c)
+		}
+
+		return `
+			insert into %%PREFIX%%_idx_tree (` +
+			strings.Join(columns, ",") + `
+			) values (` +
+			strings.Join(values, ",") + `
+			) on conflict (uuid) do update set ` +
+			strings.Join(updates, ", ")
+	}
+	// Postgres upserts on the uuid only: nodes left on the target mpath must be removed first, as replace does
+	queries["clearTree_postgres"] = func(dao sql.DAO, mpathes ...string) string {
+		return `
+			delete from %%PREFIX%%_idx_tree
+			where mpath1 = ? and mpath2 = ? and mpath3 = ? and mpath4 = ? and uuid <> ?`
+	}
 	queries["updateReplace"] = func(dao sql.DAO, args ...string) (string, []interface{}) {
 		whereSub, whereArgs := getMPathLike([]byte(args[0]))
 
@@ -155,7 +179,7 @@ func init() {
 
 		// rows before
 		for i := 0; i < quoMPathTo; i++ {
-			mpathSub = append(mpathSub, fmt.Sprintf(`mpath%d="%s"`, i+1, mpathTo[i]))
+			mpathSub = append(mpathSub, fmt.Sprintf(`mpath%d='%s'`, i+1, mpathTo[i]))
 		}
 
 		// for the final rows, we do some clever concatenations based on the length of the origin and the target
@@ -173,7 +197,7 @@ func init() {
 			incr := indexLen
 			if cnt == quoMPathTo {
 				incr = indexLen - modMPathTo
-				concat = append(concat, `"`+mpathTo[cnt]+`"`)
+				concat = append(concat, `'`+mpathTo[cnt]+`'`)
 			}
 			tarIndexFrom := curIndexFrom + incr
 			if tarIndexFrom > maxLen {
@@ -518,7 +542,18 @@ func (dao *IndexSQL) SetNode(node *mtree.TreeNode) error {
 
 	mpath1, mpath2, mpath3, mpath4 := prepareMPathParts(node)
 
-	updateTree, er := dao.GetStmt("updateTree")
+	stmtName := "updateTree"
+	if dao.Driver() == "postgres" {
+		stmtName = "updateTree_postgres"
+		clearTree, er := dao.GetStmt("clearTree_postgres")
+		if er != nil {
+			return er
+		}
+		if _, er := clearTree.Exec(mpath1, mpath2, mpath3, mpath4, node.Uuid); er != nil {
+			return er
+		}
+	}
+	updateTree, er := dao.GetStmt(stmtName)
 	if er != nil {
 		return er
 	}
@@ -1143,7 +1178,19 @@ func (dao *IndexSQL) MoveNodeTree(nodeFrom *mtree.TreeNode, nodeTo *mtree.TreeNo
 	}()
 
 	// Start by updating the original node
-	updateTree, er := dao.GetStmt("updateTree")
+	stmtName := "updateTree"
+	if dao.Driver() == "postgres" {
+		stmtName = "updateTree_postgres"
+		clearTree, er := dao.GetStmt("clearTree_postgres")
+		if er != nil {
+			errTx = er
+			return er
+		}
+		if _, errTx = tx.Stmt(clearTree.GetSQLStmt()).Exec(mpath1To, mpath2To, mpath3To, mpath4To, nodeFrom.Uuid); errTx != nil {
+			return errTx
+		}
+	}
+	updateTree, er := dao.GetStmt(stmtName)
 	if er != nil {
 		errTx = er
 		return er
@@ -1444,7 +1491,7 @@ func getMPathEquals(mpath []byte) (string, []interface{}) {
 	for {
 		cnt := (len(mpath) - 1) / indexLen
 		res = append(res, fmt.Sprintf(`mpath%d LIKE ?`, cnt+1))
-		args = append(args, mpath[(cnt*indexLen):])
+		args = append(args, string(mpath[(cnt*indexLen):]))
 
 		if idx := cnt * indexLen; idx == 0 {
 			break
@@ -36,8 +36,8 @@ func TestSQL(t *testing.T) {
 		str, args := getMPathEquals([]byte(mpath.String()))
 		So(str, ShouldEqual, `mpath2 LIKE ? and mpath1 LIKE ?`)
 		So(len(args), ShouldEqual, 2)
-		So(string(args[0].([]byte)), ShouldEqual, "89.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000")
-		So(string(args[1].([]byte)), ShouldEqual, "100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.10000.1234567")
+		So(args[0], ShouldEqual, "89.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000")
+		So(args[1], ShouldEqual, "100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.10000.1234567")
 	})
 
 	Convey("Test getting a mpath like", t, func() {
@@ -49,8 +49,8 @@ func TestSQL(t *testing.T) {
 
 		So(len(args), ShouldEqual, 2)
 
-		So(string(args[0].([]byte)), ShouldEqual, "100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.%")
-		So(string(args[1].([]byte)), ShouldEqual, "100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.")
+		So(args[0], ShouldEqual, "100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.%")
+		So(args[1], ShouldEqual, "100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.")
 	})
 
 	Convey("Test getting mpathes in ", t, func() {
@@ -60,10 +60,10 @@ func TestSQL(t *testing.T) {
 
 		So(len(args), ShouldEqual, 5)
 
-		So(string(args[0].([]byte)), ShouldEqual, "1")
-		So(string(args[1].([]byte)), ShouldEqual, "1.1")
-		So(string(args[2].([]byte)), ShouldEqual, "1.1.2")
-		So(string(args[3].([]byte)), ShouldEqual, "100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000")
-		So(string(args[4].([]byte)), ShouldEqual, "100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.")
+		So(args[0], ShouldEqual, "1")
+		So(args[1], ShouldEqual, "1.1")
+		So(args[2], ShouldEqual, "1.1.2")
+		So(args[3], ShouldEqual, "100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000")
+		So(args[4], ShouldEqual, "100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.100000000000.")
 	})
 }
@@ -139,9 +139,9 @@ var Migrations = map[string]Statements{
 				{
 					Id: "4",
 					Up: []string{
-						"alter table ladon_policy_permission add id int auto_increment primary key",
-						"alter table ladon_policy_resource add id int auto_increment primary key",
-						"alter table ladon_policy_subject add id int auto_increment primary key",
+						"alter table ladon_policy_permission add column id serial primary key",
+						"alter table ladon_policy_resource add column id serial primary key",
+						"alter table ladon_policy_subject add column id serial primary key",
 					},
 					Down: []string{
 						"alter table ladon_policy_permission drop column id",
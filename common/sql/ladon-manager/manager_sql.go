@@ -61,6 +61,10 @@ func NewSQLManager(db *sqlx.DB, schema []string) *SQLManager {
 // MigrateMigrationTable checks if migration table exists. If not, we are upgrading
 // from v3 and we need mimick the new one
 func (s *SQLManager) MigrateMigrationTable(tableName string) error {
+	if s.database == "postgres" {
+		// Postgres was not supported in v3, there is no legacy table to migrate
+		return nil
+	}
 	if rows, er := s.db.Query("SELECT * FROM " + tableName); er == nil && rows.Next() {
 		// Table exists, nothing to do
 		return nil
@@ -42,3 +42,7 @@ func (m *mysql) HashParent(name string, s ...string) string {
 	pmpath := `SUBSTRING_INDEX(` + m.Concat(s...) + `, '.', level-1)`
 	return m.Hash(name, "'__###PARENT_HASH###__'", pmpath)
 }
+
+func (m *mysql) Rebind(query string) string {
+	return query
+}
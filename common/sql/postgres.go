@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package sql
+
+import (
+	"strconv"
+	"strings"
+)
+
+type postgres struct{}
+
+func (*postgres) Concat(s ...string) string {
+	return strings.Join(s, " || ")
+}
+
+func (*postgres) Hash(s ...string) string {
+	return ""
+}
+
+func (*postgres) HashParent(name string, s ...string) string {
+	return ""
+}
+
+// Rebind replaces the '?' bindvars used across the DAOs with the positional
+// $1, $2... parameters expected by PostgreSQL. Quoted literals are left untouched.
+func (*postgres) Rebind(query string) string {
+	if !strings.Contains(query, "?") {
+		return query
+	}
+	var (
+		sb    strings.Builder
+		n     int
+		quote rune
+	)
+	sb.Grow(len(query) + 10)
+	for _, r := range query {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+		case r == '?':
+			n++
+			sb.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package sql
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPostgres_Rebind(t *testing.T) {
+
+	Convey("Test Rebind bindvars", t, func() {
+		h := new(postgres)
+		So(h.Rebind("select * from t"), ShouldEqual, "select * from t")
+		So(h.Rebind("select * from t where a=? and b=?"), ShouldEqual, "select * from t where a=$1 and b=$2")
+		So(h.Rebind("insert into t (a, b, c) values (?, '?', ?)"), ShouldEqual, "insert into t (a, b, c) values ($1, '?', $2)")
+		So(h.Rebind(`select "a?" from t where b like 'x?%' and c=?`), ShouldEqual, `select "a?" from t where b like 'x?%' and c=$1`)
+	})
+
+}
@@ -23,10 +23,12 @@ package resources
 import (
 	"testing"
 
+	"github.com/doug-martin/goqu/v9"
 	. "github.com/smartystreets/goconvey/convey"
 
 	"github.com/pydio/cells/v4/common/dao"
 	"github.com/pydio/cells/v4/common/dao/sqlite"
+	"github.com/pydio/cells/v4/common/dao/test"
 	"github.com/pydio/cells/v4/common/proto/service"
 )
 
@@ -35,7 +37,8 @@ func TestQueryResourceForAction(t *testing.T) {
 	wrapper := func(d dao.DAO) dao.DAO {
 		return NewDAO(d, "left.uuid")
 	}
-	d, e := dao.InitDAO(sqlite.Driver, sqlite.SharedMemDSN, "", wrapper)
+	driver, dsn := test.SQLDriver(sqlite.Driver, sqlite.SharedMemDSN)
+	d, e := dao.InitDAO(driver, dsn, "", wrapper)
 	if e != nil {
 		panic(e)
 	}
@@ -64,4 +67,14 @@ func TestQueryResourceForAction(t *testing.T) {
 
 	})
 
+	Convey("Test condition inside a prepared query", t, func() {
+
+		expr, e := resDAO.BuildPolicyConditionForAction(&service.ResourcePolicyQuery{Subjects: []string{"subject-1"}}, service.ResourcePolicyAction_READ)
+		So(e, ShouldBeNil)
+		_, args, e := goqu.Dialect(resDAO.Driver()).From("left").Prepared(true).Where(goqu.C("namespace").Eq("ns"), expr).ToSQL()
+		So(e, ShouldBeNil)
+		So(args, ShouldResemble, []interface{}{"ns", "subject-1", "READ"})
+
+	})
+
 }
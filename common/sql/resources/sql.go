@@ -263,18 +263,13 @@ func (s *ResourcesSQL) BuildPolicyConditionForAction(q *service.ResourcePolicyQu
 	if q.Empty {
 		join := grt.Col("resource").Eq(gli)
 		actionQ := grt.Col("action").Eq(action.String())
-		str, args, e := goqu.New(s.Driver(), s.DB()).
+		// Sub-query is passed as an expression so that its placeholders are numbered along with the main query ones
+		sub := goqu.Dialect(s.Driver()).
 			From(resourcesTableName).
-			Prepared(true).
 			Select(goqu.L("1")).
-			Where(goqu.And(join, actionQ)).
-			ToSQL()
+			Where(goqu.And(join, actionQ))
 
-		if e != nil {
-			return nil, e
-		}
-
-		return goqu.L("NOT EXISTS ("+str+")", args...), nil
+		return goqu.L("NOT EXISTS ?", sub), nil
 
 	} else {
 
@@ -290,17 +285,12 @@ func (s *ResourcesSQL) BuildPolicyConditionForAction(q *service.ResourcePolicyQu
 
 		ands = append(ands, grt.Col("resource").Eq(gli)) // Join
 		ands = append(ands, grt.Col("action").Eq(action.String()))
-		str, args, e := goqu.New(s.Driver(), s.DB()).
+		sub := goqu.Dialect(s.Driver()).
 			From(resourcesTableName).
-			Prepared(true).
 			Select(goqu.L("1")).
-			Where(goqu.And(ands...)).
-			ToSQL()
+			Where(goqu.And(ands...))
 
-		if e != nil {
-			return nil, e
-		}
-		return goqu.L("EXISTS ("+str+")", args...), nil
+		return goqu.L("EXISTS ?", sub), nil
 
 	}
 }
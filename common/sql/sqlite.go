@@ -37,3 +37,7 @@ func (*sqlite) Hash(s ...string) string {
 func (*sqlite) HashParent(name string, s ...string) string {
 	return ""
 }
+
+func (*sqlite) Rebind(query string) string {
+	return query
+}
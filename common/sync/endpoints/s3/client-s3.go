@@ -69,6 +69,8 @@ type Client struct {
 
 	checksumMapper       ChecksumMapper
 	purgeMapperAfterWalk bool
+
+	nativeWatcher model.PathSyncSource
 }
 
 func NewClient(ctx context.Context, host string, key string, secret string, bucket string, rootPath string, secure bool, options model.EndpointOptions) (*Client, error) {
@@ -728,6 +730,14 @@ func (c *Client) Watch(recursivePath string) (*model.WatchObject, error) {
 	// Start listening on all bucket events.
 	eventsCh := c.Mc.ListenBucketNotification(ctx, c.Bucket, c.getFullPath(recursivePath), "", events)
 
+	// Optionally merge events detected directly on the underlying storage
+	nativeEvents, nativeDone, er := c.watchNative(recursivePath)
+	if er != nil {
+		cancel()
+		return nil, er
+	}
+	echoes := newEchoCache(nativeEchoWindow)
+
 	wo := &model.WatchObject{
 		EventInfoChan:  eventChan,
 		ErrorChan:      errorChan,
@@ -739,6 +749,9 @@ func (c *Client) Watch(recursivePath string) (*model.WatchObject, error) {
 	go func() {
 		defer func() {
 			cancel()
+			if nativeDone != nil {
+				close(nativeDone)
+			}
 			close(eventChan)
 			close(errorChan)
 			close(wConn)
@@ -748,6 +761,17 @@ func (c *Client) Watch(recursivePath string) (*model.WatchObject, error) {
 			select {
 			case <-doneChan:
 				return
+			case nativeEvent, ok := <-nativeEvents:
+				if !ok {
+					nativeEvents = nil
+					continue
+				}
+				if c.isIgnoredNativeEvent(nativeEvent, echoes) {
+					continue
+				}
+				log.Logger(c.globalContext).Debug("Native Event", zap.Any("type", nativeEvent.Type), zap.String("path", nativeEvent.Path))
+				nativeEvent.Source = c
+				eventChan <- nativeEvent
 			case notificationInfo := <-eventsCh:
 				if notificationInfo.Err != nil {
 					if nErr, ok := notificationInfo.Err.(minio.ErrorResponse); ok && nErr.Code == "APINotSupported" {
@@ -773,6 +797,8 @@ func (c *Client) Watch(recursivePath string) (*model.WatchObject, error) {
 						objectPath = path.Dir(key)
 						folder = true
 					}
+					// Remember this path so that the same change seen by the native watcher is not replayed
+					echoes.touch(c.getLocalPath(objectPath))
 					if c.isIgnoredFile(objectPath, record) {
 						continue
 					}
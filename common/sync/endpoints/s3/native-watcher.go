@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+package s3
+
+import (
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	servicescommon "github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/log"
+	"github.com/pydio/cells/v4/common/sync/model"
+)
+
+const (
+	// nativeEchoWindow is the delay during which a native event is considered as an echo of a bucket notification
+	nativeEchoWindow = 10 * time.Second
+	echoCacheMaxSize = 2000
+)
+
+// SetNativeWatcher registers an additional source whose Watch events are merged with the bucket notifications.
+// It is used to detect changes applied directly on the storage backing the S3 server (e.g. a local folder
+// served by minio), without going through the S3 API. Paths emitted by this source must be relative to the
+// client RootPath.
+func (c *Client) SetNativeWatcher(source model.PathSyncSource) {
+	c.nativeWatcher = source
+}
+
+// watchNative starts the native watcher if one is registered. Returned channels are nil otherwise.
+func (c *Client) watchNative(recursivePath string) (chan model.EventInfo, chan bool, error) {
+	if c.nativeWatcher == nil {
+		return nil, nil, nil
+	}
+	wo, er := c.nativeWatcher.Watch(recursivePath)
+	if er != nil {
+		return nil, nil, er
+	}
+	go func() {
+		for e := range wo.Errors() {
+			if e != nil {
+				log.Logger(c.globalContext).Warn("Received error from native watcher", zap.Error(e))
+			}
+		}
+	}()
+	log.Logger(c.globalContext).Info("Watching storage for out-of-band changes", zap.String("uri", c.nativeWatcher.GetEndpointInfo().URI))
+	return wo.Events(), wo.DoneChan, nil
+}
+
+// isIgnoredNativeEvent filters out native events that are either irrelevant or already
+// notified through the S3 API.
+func (c *Client) isIgnoredNativeEvent(event model.EventInfo, echoes *echoCache) bool {
+	if event.Path == "" || path.Base(event.Path) == servicescommon.PydioSyncHiddenFile {
+		return true
+	}
+	switch event.Type {
+	case model.EventCreate, model.EventRename, model.EventRemove:
+	default:
+		return true
+	}
+	return echoes.seen(event.Path)
+}
+
+// echoCache keeps track of paths recently notified by the S3 server
+type echoCache struct {
+	sync.Mutex
+	window time.Duration
+	paths  map[string]time.Time
+}
+
+func newEchoCache(window time.Duration) *echoCache {
+	return &echoCache{
+		window: window,
+		paths:  make(map[string]time.Time),
+	}
+}
+
+func (e *echoCache) key(p string) string {
+	return strings.Trim(p, "/")
+}
+
+// touch registers a path as just modified
+func (e *echoCache) touch(p string) {
+	e.Lock()
+	defer e.Unlock()
+	now := time.Now()
+	if len(e.paths) > echoCacheMaxSize {
+		for k, t := range e.paths {
+			if now.Sub(t) > e.window {
+				delete(e.paths, k)
+			}
+		}
+	}
+	e.paths[e.key(p)] = now
+}
+
+// seen checks if a path has been modified within the window
+func (e *echoCache) seen(p string) bool {
+	e.Lock()
+	defer e.Unlock()
+	t, ok := e.paths[e.key(p)]
+	return ok && time.Since(t) < e.window
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+package s3
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/sync/model"
+)
+
+func TestClient_isIgnoredNativeEvent(t *testing.T) {
+	Convey("Test native events filtering", t, func() {
+		c := NewS3Mock()
+		echoes := newEchoCache(100 * time.Millisecond)
+
+		So(c.isIgnoredNativeEvent(model.EventInfo{Path: "/folder/file", Type: model.EventCreate}, echoes), ShouldBeFalse)
+		So(c.isIgnoredNativeEvent(model.EventInfo{Path: "/folder/" + common.PydioSyncHiddenFile, Type: model.EventCreate}, echoes), ShouldBeTrue)
+		So(c.isIgnoredNativeEvent(model.EventInfo{Path: "/folder/file", Type: model.EventAccessedRead}, echoes), ShouldBeTrue)
+		So(c.isIgnoredNativeEvent(model.EventInfo{Type: model.EventRemove}, echoes), ShouldBeTrue)
+
+		echoes.touch("folder/file")
+		So(c.isIgnoredNativeEvent(model.EventInfo{Path: "/folder/file", Type: model.EventRemove}, echoes), ShouldBeTrue)
+		So(c.isIgnoredNativeEvent(model.EventInfo{Path: "/folder/other", Type: model.EventRemove}, echoes), ShouldBeFalse)
+
+		<-time.After(150 * time.Millisecond)
+		So(c.isIgnoredNativeEvent(model.EventInfo{Path: "/folder/file", Type: model.EventRemove}, echoes), ShouldBeFalse)
+	})
+}
@@ -0,0 +1,268 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	cronDescriptors = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+	cronMonths = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+	cronDays = map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}
+)
+
+// cronExpression is a parsed 5-fields cron expression. Each field is stored as a bitset.
+type cronExpression struct {
+	minute, hour, dom, month, dow uint64
+	// domAny and dowAny are true when the field is "*" or "?"
+	domAny, dowAny bool
+	// lastDom is set by "L" in day-of-month
+	lastDom bool
+	// nthDow stores "d#n" items as a bitset of n (1 to 5) for each week day
+	nthDow [7]uint8
+	// lastDow stores "dL" items (last given week day of the month)
+	lastDow [7]bool
+}
+
+// parseCron parses a standard cron expression "minute hour day-of-month month day-of-week".
+// Predefined descriptors (@daily, @weekly...), names for months and days, "L" in day-of-month,
+// and "d#n" / "dL" in day-of-week are supported.
+func parseCron(expr string) (*cronExpression, error) {
+	expr = strings.TrimSpace(expr)
+	if d, ok := cronDescriptors[strings.ToLower(expr)]; ok {
+		expr = d
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q: expected 5 fields, found %d", expr, len(fields))
+	}
+	c := &cronExpression{}
+	var err error
+	if c.minute, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("invalid minute field: %v", err)
+	}
+	if c.hour, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("invalid hour field: %v", err)
+	}
+	if err = c.parseDom(fields[2]); err != nil {
+		return nil, fmt.Errorf("invalid day-of-month field: %v", err)
+	}
+	if c.month, err = parseCronField(fields[3], 1, 12, cronMonths); err != nil {
+		return nil, fmt.Errorf("invalid month field: %v", err)
+	}
+	if err = c.parseDow(fields[4]); err != nil {
+		return nil, fmt.Errorf("invalid day-of-week field: %v", err)
+	}
+	return c, nil
+}
+
+func (c *cronExpression) parseDom(field string) error {
+	if field == "*" || field == "?" {
+		c.domAny = true
+	}
+	var items []string
+	for _, item := range strings.Split(field, ",") {
+		if strings.ToUpper(item) == "L" {
+			c.lastDom = true
+		} else {
+			items = append(items, item)
+		}
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	var err error
+	c.dom, err = parseCronField(strings.Join(items, ","), 1, 31, nil)
+	return err
+}
+
+func (c *cronExpression) parseDow(field string) error {
+	if field == "*" || field == "?" {
+		c.dowAny = true
+	}
+	var items []string
+	for _, item := range strings.Split(strings.ToUpper(field), ",") {
+		if parts := strings.Split(item, "#"); len(parts) == 2 {
+			d, e := parseCronValue(parts[0], 0, 7, cronDays)
+			if e != nil {
+				return e
+			}
+			n, e := strconv.Atoi(parts[1])
+			if e != nil || n < 1 || n > 5 {
+				return fmt.Errorf("invalid week number in %q", item)
+			}
+			c.nthDow[d%7] |= 1 << uint(n)
+		} else if len(item) > 1 && strings.HasSuffix(item, "L") {
+			d, e := parseCronValue(strings.TrimSuffix(item, "L"), 0, 7, cronDays)
+			if e != nil {
+				return e
+			}
+			c.lastDow[d%7] = true
+		} else {
+			items = append(items, item)
+		}
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	bits, err := parseCronField(strings.Join(items, ","), 0, 7, cronDays)
+	if err != nil {
+		return err
+	}
+	// 7 is an alias for Sunday
+	if bits&(1<<7) != 0 {
+		bits = bits&^(1<<7) | 1
+	}
+	c.dow = bits
+	return nil
+}
+
+// parseCronField parses a comma-separated list of values, ranges and steps into a bitset.
+func parseCronField(field string, min, max int, names map[string]int) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		step := 1
+		if parts := strings.Split(item, "/"); len(parts) == 2 {
+			s, e := strconv.Atoi(parts[1])
+			if e != nil || s <= 0 {
+				return 0, fmt.Errorf("invalid step in %q", item)
+			}
+			item, step = parts[0], s
+		} else if len(parts) > 2 {
+			return 0, fmt.Errorf("invalid item %q", item)
+		}
+		start, end := min, max
+		if item != "*" && item != "?" {
+			bounds := strings.Split(item, "-")
+			if len(bounds) > 2 {
+				return 0, fmt.Errorf("invalid range %q", item)
+			}
+			var e error
+			if start, e = parseCronValue(bounds[0], min, max, names); e != nil {
+				return 0, e
+			}
+			if len(bounds) == 2 {
+				if end, e = parseCronValue(bounds[1], min, max, names); e != nil {
+					return 0, e
+				}
+			} else if step == 1 {
+				end = start
+			}
+			if end < start {
+				return 0, fmt.Errorf("invalid range %q", item)
+			}
+		}
+		for i := start; i <= end; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, nil
+}
+
+func parseCronValue(value string, min, max int, names map[string]int) (int, error) {
+	if names != nil {
+		if v, ok := names[strings.ToUpper(value)]; ok {
+			return v, nil
+		}
+	}
+	v, e := strconv.Atoi(value)
+	if e != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	if v < min || v > max {
+		return 0, fmt.Errorf("value %d out of range [%d-%d]", v, min, max)
+	}
+	return v, nil
+}
+
+func (c *cronExpression) matchDay(t time.Time) bool {
+	day := t.Day()
+	lastDay := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+	wd := int(t.Weekday())
+
+	domMatch := c.dom&(1<<uint(day)) != 0 || (c.lastDom && day == lastDay)
+	dowMatch := c.dow&(1<<uint(wd)) != 0 ||
+		c.nthDow[wd]&(1<<uint((day-1)/7+1)) != 0 ||
+		(c.lastDow[wd] && day+7 > lastDay)
+
+	// As in standard cron, if both fields are restricted, the day matches if any of them matches
+	switch {
+	case c.domAny && c.dowAny:
+		return true
+	case c.domAny:
+		return dowMatch
+	case c.dowAny:
+		return domMatch
+	default:
+		return domMatch || dowMatch
+	}
+}
+
+// next returns the first time strictly after t matching the expression, evaluated in the location
+// of t. It returns a zero time if no match can be found within five years.
+func (c *cronExpression) next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Year() + 5
+
+	for t.Year() <= limit {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !c.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			n := time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			if !n.After(t) {
+				// DST transition, move forward anyway
+				n = t.Add(time.Duration(60-t.Minute()) * time.Minute)
+			}
+			t = n
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCronParse(t *testing.T) {
+
+	Convey("Parse valid expressions", t, func() {
+		for _, expr := range []string{"* * * * *", "30 2 * * MON-FRI", "0 0 1 */2 *", "0 3 * * SUN#1", "0 18 * * FRIL", "0 0 L * *", "@daily", "5,10,15-20/2 * * JAN-MAR 7"} {
+			_, err := parseCron(expr)
+			So(err, ShouldBeNil)
+		}
+	})
+
+	Convey("Parse invalid expressions", t, func() {
+		for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "* * * * MON#6", "5-1 * * * *", "*/0 * * * *", "@never"} {
+			_, err := parseCron(expr)
+			So(err, ShouldNotBeNil)
+		}
+	})
+
+}
+
+func TestCronNext(t *testing.T) {
+
+	paris, _ := time.LoadLocation("Europe/Paris")
+
+	Convey("Weekdays at 02:30", t, func() {
+		s, err := NewTickerScheduleFromCron("30 2 * * 1-5", "UTC")
+		So(err, ShouldBeNil)
+		// Friday 2021-10-01 03:00 UTC => Monday 2021-10-04 02:30
+		next := s.Next(time.Date(2021, 10, 1, 3, 0, 0, 0, time.UTC))
+		So(next, ShouldEqual, time.Date(2021, 10, 4, 2, 30, 0, 0, time.UTC))
+		// Strictly after
+		So(s.Next(next), ShouldEqual, time.Date(2021, 10, 5, 2, 30, 0, 0, time.UTC))
+	})
+
+	Convey("First Sunday of the month", t, func() {
+		s, err := NewTickerScheduleFromCron("0 4 * * SUN#1", "UTC")
+		So(err, ShouldBeNil)
+		next := s.Next(time.Date(2021, 10, 4, 0, 0, 0, 0, time.UTC))
+		So(next, ShouldEqual, time.Date(2021, 11, 7, 4, 0, 0, 0, time.UTC))
+	})
+
+	Convey("Last Friday and last day of the month", t, func() {
+		s, err := NewTickerScheduleFromCron("0 18 * * 5L", "UTC")
+		So(err, ShouldBeNil)
+		So(s.Next(time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)), ShouldEqual, time.Date(2021, 10, 29, 18, 0, 0, 0, time.UTC))
+		s, err = NewTickerScheduleFromCron("0 0 L * *", "UTC")
+		So(err, ShouldBeNil)
+		So(s.Next(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)), ShouldEqual, time.Date(2021, 2, 28, 0, 0, 0, 0, time.UTC))
+	})
+
+	Convey("Day-of-month and day-of-week are combined with OR", t, func() {
+		s, err := NewTickerScheduleFromCron("0 0 15 * MON", "UTC")
+		So(err, ShouldBeNil)
+		// Wednesday 2021-09-01 => Monday 2021-09-06, then 2021-09-13, then 2021-09-15
+		from := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+		So(s.Next(from), ShouldEqual, time.Date(2021, 9, 6, 0, 0, 0, 0, time.UTC))
+		So(s.Next(time.Date(2021, 9, 13, 0, 0, 0, 0, time.UTC)), ShouldEqual, time.Date(2021, 9, 15, 0, 0, 0, 0, time.UTC))
+	})
+
+	Convey("Time zone is respected, including DST", t, func() {
+		s, err := NewTickerScheduleFromCron("30 2 * * *", "Europe/Paris")
+		So(err, ShouldBeNil)
+		next := s.Next(time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC))
+		So(next.Equal(time.Date(2021, 6, 2, 2, 30, 0, 0, paris)), ShouldBeTrue)
+		// 02:30 does not exist on 2021-03-28 in Paris: occurrence is skipped
+		next = s.Next(time.Date(2021, 3, 27, 12, 0, 0, 0, time.UTC))
+		So(next.Equal(time.Date(2021, 3, 29, 2, 30, 0, 0, paris)), ShouldBeTrue)
+	})
+
+	Convey("Invalid time zone and impossible dates", t, func() {
+		_, err := NewTickerScheduleFromCron("* * * * *", "Mars/Olympus")
+		So(err, ShouldNotBeNil)
+		s, err := NewTickerScheduleFromCron("0 0 30 2 *", "")
+		So(err, ShouldBeNil)
+		So(s.Next(time.Now()).IsZero(), ShouldBeTrue)
+		w := NewTicker(s, func() error { return nil })
+		_, stop := w.computeNextWait()
+		So(stop, ShouldBeTrue)
+	})
+
+	Convey("Compute Next Wait with cron", t, func() {
+		s, err := NewTickerScheduleFromCron("* * * * *", "")
+		So(err, ShouldBeNil)
+		w := NewTicker(s, func() error { return nil })
+		wait, stop := w.computeNextWait()
+		So(stop, ShouldBeFalse)
+		So(wait, ShouldBeGreaterThan, 0)
+		So(wait, ShouldBeLessThanOrEqualTo, time.Minute)
+	})
+
+}
@@ -19,7 +19,7 @@
  */
 
 // Package schedule provides a fixed ticker based on a start time
-// iso8601 interval periods are supported
+// iso8601 interval periods and cron expressions are supported
 package schedule
 
 import (
@@ -45,6 +45,10 @@ type TickerSchedule struct {
 	startTime time.Time
 	// Interval between ticks
 	interval time.Duration
+	// Cron expression, if set it replaces the interval-based computation
+	cron *cronExpression
+	// Location used to evaluate the cron expression
+	location *time.Location
 }
 
 // ParseIsoSchedule parses the given Iso 8601 string and stores corresponding values.
@@ -109,6 +113,47 @@ func NewTickerScheduleFromISO(is8601 string) (*TickerSchedule, error) {
 	return s, nil
 }
 
+// NewTickerScheduleFromCron creates a schedule from a cron expression, evaluated in the given
+// IANA time zone (server local time if empty). It returns an error if the expression or the
+// time zone cannot be parsed.
+func NewTickerScheduleFromCron(expr string, timezone string) (*TickerSchedule, error) {
+	c, err := parseCron(expr)
+	if err != nil {
+		return nil, err
+	}
+	loc := time.Local
+	if timezone != "" {
+		if loc, err = time.LoadLocation(timezone); err != nil {
+			return nil, err
+		}
+	}
+	return &TickerSchedule{cron: c, location: loc}, nil
+}
+
+// Next returns the next occurrence of this schedule strictly after the given time,
+// or a zero time if there is none.
+func (s *TickerSchedule) Next(from time.Time) time.Time {
+	if s.cron != nil {
+		n := s.cron.next(from.In(s.location))
+		if n.IsZero() {
+			return n
+		}
+		return n.In(from.Location())
+	}
+	var next time.Time
+	if from.Before(s.startTime) {
+		next = s.startTime
+	} else if s.interval > 0 {
+		next = from.Add(s.interval - from.Sub(s.startTime)%s.interval)
+	} else {
+		return time.Time{}
+	}
+	if !s.endTime.IsZero() && next.After(s.endTime) {
+		return time.Time{}
+	}
+	return next
+}
+
 // NewTickerSchedule creates a schedule from parameters
 func NewTickerSchedule(interval time.Duration, startTime time.Time, repeat int64) *TickerSchedule {
 	s := &TickerSchedule{
@@ -186,6 +231,13 @@ func (w *Ticker) computeNextWait() (time.Duration, bool) {
 
 	now := time.Now()
 	var wait time.Duration
+	if w.cron != nil {
+		next := w.Next(now)
+		if next.IsZero() {
+			return 0, true
+		}
+		return next.Sub(now), false
+	}
 	// First let's wait until start time
 	wait = w.startTime.Sub(now)
 	if wait < 0 {
@@ -0,0 +1,38 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS enc_nodes (
+    node_id VARCHAR(255) NOT NULL PRIMARY KEY,
+    legacy INT DEFAULT 1
+);
+
+CREATE TABLE IF NOT EXISTS enc_legacy_nodes (
+    node_id    VARCHAR(255) NOT NULL PRIMARY KEY,
+    nonce      BYTEA,
+    block_size INT
+);
+
+CREATE TABLE IF NOT EXISTS enc_node_keys (
+    node_id VARCHAR(255) NOT NULL,
+    owner_id VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    key_data BYTEA,
+    id SERIAL PRIMARY KEY,
+    FOREIGN KEY (node_id) REFERENCES enc_nodes(node_id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS enc_node_blocks (
+    node_id           VARCHAR(255) NOT NULL,
+    part_id           INT,
+    seq_start         INT,
+    seq_end           INT,
+    block_data_size   INT,
+    block_header_size INT,
+    owner             VARCHAR(255),
+    id                SERIAL PRIMARY KEY,
+    FOREIGN KEY (node_id) REFERENCES enc_nodes(node_id) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE enc_node_blocks;
+DROP TABLE enc_node_keys;
+DROP TABLE enc_legacy_nodes;
+DROP TABLE enc_nodes;
@@ -26,6 +26,7 @@ import (
 	"embed"
 
 	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
 	migrate "github.com/rubenv/sql-migrate"
 	"go.uber.org/zap"
 
@@ -245,6 +246,9 @@ func (h *sqlimpl) SaveNode(node *encryption.Node) error {
 			return nil
 		}
 	}
+	if pe, o := err.(*pq.Error); o && pe.Code == "23505" {
+		return nil
+	}
 	return err
 }
 
@@ -26,6 +26,7 @@ import (
 
 	"github.com/pydio/cells/v4/common/dao"
 	"github.com/pydio/cells/v4/common/dao/sqlite"
+	"github.com/pydio/cells/v4/common/dao/test"
 	"github.com/pydio/cells/v4/common/utils/configx"
 )
 
@@ -35,7 +36,8 @@ var (
 
 func TestMain(m *testing.M) {
 	options := configx.New()
-	if d, e := dao.InitDAO(sqlite.Driver, sqlite.SharedMemDSN, "test", NewDAO, options); e != nil {
+	driver, dsn := test.SQLDriver(sqlite.Driver, sqlite.SharedMemDSN)
+	if d, e := dao.InitDAO(driver, dsn, "test", NewDAO, options); e != nil {
 		panic(e)
 	} else {
 		mockDAO = d.(DAO)
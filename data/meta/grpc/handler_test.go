@@ -29,6 +29,7 @@ import (
 
 	"github.com/pydio/cells/v4/common/dao"
 	"github.com/pydio/cells/v4/common/dao/sqlite"
+	"github.com/pydio/cells/v4/common/dao/test"
 	common "github.com/pydio/cells/v4/common/proto/tree"
 	"github.com/pydio/cells/v4/common/service/errors"
 	"github.com/pydio/cells/v4/common/utils/cache"
@@ -43,7 +44,8 @@ var (
 func TestMain(m *testing.M) {
 	options := configx.New()
 
-	if d, e := dao.InitDAO(sqlite.Driver, sqlite.SharedMemDSN, "test", meta.NewDAO, options); e != nil {
+	driver, dsn := test.SQLDriver(sqlite.Driver, sqlite.SharedMemDSN)
+	if d, e := dao.InitDAO(driver, dsn, "test", meta.NewDAO, options); e != nil {
 		panic(e)
 	} else {
 		mockDAO = d.(meta.DAO)
@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS data_meta (
+    node_id varchar(255) not null,
+    namespace varchar(255) not null,
+    author varchar(255),
+    timestamp int,
+    data text,
+    format varchar(255),
+    constraint data_meta_pk primary key (node_id, namespace)
+);
+
+CREATE INDEX data_meta_timestamp_idx ON data_meta(timestamp);
+CREATE INDEX data_meta_author_idx ON data_meta(author);
+
+-- +migrate Down
+DROP TABLE data_meta;
@@ -42,7 +42,7 @@ var (
 		"deleteNS":      `DELETE FROM data_meta WHERE namespace=?`,
 		"deleteUuid":    `DELETE FROM data_meta WHERE node_id=?`,
 		"select":        `SELECT * FROM data_meta WHERE node_id=?`,
-		"selectAll":     `SELECT * FROM data_meta LIMIT 0, 500`,
+		"selectAll":     `SELECT * FROM data_meta LIMIT 500`,
 	}
 )
 
@@ -116,7 +116,8 @@ func (h *sqlImpl) SetMetadata(nodeId string, author string, metadata map[string]
 
 				var stmt sql.Stmt
 				var er error
-				if h.Driver() == "sqlite3" {
+				if h.Driver() == "sqlite3" || h.Driver() == "postgres" {
+					// Postgres shares the ON CONFLICT syntax
 					stmt, er = h.GetStmt("upsert-sqlite")
 				} else {
 					stmt, er = h.GetStmt("upsert")
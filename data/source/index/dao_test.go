@@ -33,6 +33,7 @@ import (
 
 	"github.com/pydio/cells/v4/common/dao"
 	"github.com/pydio/cells/v4/common/dao/sqlite"
+	"github.com/pydio/cells/v4/common/dao/test"
 	"github.com/pydio/cells/v4/common/proto/tree"
 	servicecontext "github.com/pydio/cells/v4/common/service/context"
 	"github.com/pydio/cells/v4/common/sql"
@@ -99,7 +100,8 @@ func getDAO(ctx context.Context) DAO {
 
 func TestMain(m *testing.M) {
 
-	if d, e := dao.InitDAO(sqlite.Driver, sqlite.SharedMemDSN, "test", NewDAO, options); e != nil {
+	driver, dsn := test.SQLDriver(sqlite.Driver, sqlite.SharedMemDSN)
+	if d, e := dao.InitDAO(driver, dsn, "test", NewDAO, options); e != nil {
 		panic(e)
 	} else {
 		ctx = servicecontext.WithDAO(context.Background(), d)
@@ -33,6 +33,7 @@ import (
 	// SQLite Driver
 	"github.com/pydio/cells/v4/common/dao"
 	"github.com/pydio/cells/v4/common/dao/sqlite"
+	"github.com/pydio/cells/v4/common/dao/test"
 	"github.com/pydio/cells/v4/common/proto/object"
 	"github.com/pydio/cells/v4/common/proto/tree"
 	servicecontext "github.com/pydio/cells/v4/common/service/context"
@@ -112,7 +113,8 @@ func (l *List) Close() error {
 func TestMain(m *testing.M) {
 
 	options := configx.New()
-	if d, e := dao.InitDAO(sqlite.Driver, sqlite.SharedMemDSN, "test", index.NewDAO, options); e != nil {
+	driver, dsn := test.SQLDriver(sqlite.Driver, sqlite.SharedMemDSN)
+	if d, e := dao.InitDAO(driver, dsn, "test", index.NewDAO, options); e != nil {
 		panic(e)
 	} else {
 		indexDAO = d.(index.DAO)
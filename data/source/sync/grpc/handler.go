@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"github.com/pydio/cells/v4/common/utils/std"
 	"math"
+	"path/filepath"
 	"strconv"
 	"strings"
 	sync2 "sync"
@@ -48,6 +49,7 @@ import (
 	"github.com/pydio/cells/v4/common/proto/tree"
 	servicecontext "github.com/pydio/cells/v4/common/service/context"
 	"github.com/pydio/cells/v4/common/service/context/metadata"
+	"github.com/pydio/cells/v4/common/sync/endpoints/filesystem"
 	"github.com/pydio/cells/v4/common/sync/endpoints/index"
 	"github.com/pydio/cells/v4/common/sync/endpoints/s3"
 	"github.com/pydio/cells/v4/common/sync/merger"
@@ -264,8 +266,13 @@ func (s *Handler) initSync(syncConfig *object.DataSource) error {
 	}
 
 	var source model.PathSyncTarget
+	var nativeWatcher model.PathSyncSource
 	if syncConfig.Watch {
-		return fmt.Errorf("datasource watch is not implemented yet")
+		w, er := s.initNativeWatcher(syncConfig)
+		if er != nil {
+			return er
+		}
+		nativeWatcher = w
 	}
 	normalizeS3, _ := strconv.ParseBool(syncConfig.StorageConfiguration[object.StorageKeyNormalize])
 	var computer func(string) (int64, error)
@@ -366,6 +373,9 @@ func (s *Handler) initSync(syncConfig *object.DataSource) error {
 				s3client.SetChecksumMapper(csm, true)
 			}
 		}
+		if nativeWatcher != nil {
+			s3client.SetNativeWatcher(nativeWatcher)
+		}
 
 		source = s3client
 	}
@@ -394,6 +404,28 @@ func (s *Handler) initSync(syncConfig *object.DataSource) error {
 
 }
 
+// initNativeWatcher prepares a filesystem endpoint on the datasource storage folder, used to detect changes
+// applied directly on disk. Watch is only supported for structured datasources stored on a local folder.
+func (s *Handler) initNativeWatcher(syncConfig *object.DataSource) (model.PathSyncSource, error) {
+	if syncConfig.StorageType != object.StorageType_LOCAL || syncConfig.FlatStorage {
+		return nil, fmt.Errorf("datasource watch is only supported for structured datasources on local storage")
+	}
+	if syncConfig.ObjectsBucket == "" {
+		return nil, fmt.Errorf("datasource watch is not supported on multi-buckets datasources")
+	}
+	folder, ok := syncConfig.StorageConfiguration[object.StorageKeyFolder]
+	if !ok || folder == "" {
+		return nil, fmt.Errorf("cannot find storage folder for datasource %s", syncConfig.Name)
+	}
+	root := filepath.Join(folder, filepath.FromSlash(syncConfig.ObjectsBaseFolder))
+	fsClient, er := filesystem.NewFSClient(root, model.EndpointOptions{BrowseOnly: true})
+	if er != nil {
+		return nil, fmt.Errorf("cannot watch storage folder for datasource %s: %v", syncConfig.Name, er)
+	}
+	log.Logger(s.globalCtx).Info("Datasource " + syncConfig.Name + " will watch changes on " + root)
+	return fsClient, nil
+}
+
 func (s *Handler) watchDisconnection() {
 	//defer close(watchOnce)
 	watchOnce := make(chan interface{})
@@ -480,7 +512,7 @@ func (s *Handler) watchConfigs() {
 
 			if err := event.Scan(&cfg); err == nil && cfg.Name == s.dsName {
 				log.Logger(s.globalCtx).Info("Config changed on "+serviceName+", comparing", zap.Any("old", s.SyncConfig), zap.Any("new", &cfg))
-				if s.SyncConfig.ObjectsBaseFolder != cfg.ObjectsBaseFolder || s.SyncConfig.ObjectsBucket != cfg.ObjectsBucket {
+				if s.SyncConfig.ObjectsBaseFolder != cfg.ObjectsBaseFolder || s.SyncConfig.ObjectsBucket != cfg.ObjectsBucket || s.SyncConfig.Watch != cfg.Watch {
 					// @TODO - Object service must be restarted before restarting sync
 					log.Logger(s.globalCtx).Info("Path changed on " + serviceName + ", should reload sync task entirely - Please restart service")
 				} else if s.SyncConfig.VersioningPolicyName != cfg.VersioningPolicyName || s.SyncConfig.EncryptionMode != cfg.EncryptionMode {
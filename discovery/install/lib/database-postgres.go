@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package lib
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/pydio/cells/v4/common/proto/install"
+)
+
+// dbDriverFromInstallConfig returns the SQL driver matching the connection type of the install config.
+func dbDriverFromInstallConfig(c *install.InstallConfig) string {
+	switch c.GetDbConnectionType() {
+	case "postgres":
+		return "postgres"
+	case "manual":
+		if strings.HasPrefix(c.GetDbManualDSN(), "postgres://") || strings.HasPrefix(c.GetDbManualDSN(), "postgresql://") {
+			return "postgres"
+		}
+	}
+	return "mysql"
+}
+
+func addDatabasePostgresConnection(c *install.InstallConfig) string {
+	u := &url.URL{
+		Scheme: "postgres",
+		Host:   net.JoinHostPort(c.GetDbTCPHostname(), c.GetDbTCPPort()),
+		Path:   "/" + c.GetDbTCPName(),
+	}
+	if c.GetDbTCPPassword() != "" {
+		u.User = url.UserPassword(c.GetDbTCPUser(), c.GetDbTCPPassword())
+	} else {
+		u.User = url.User(c.GetDbTCPUser())
+	}
+	u.RawQuery = "sslmode=disable"
+	return u.String()
+}
+
+func checkPostgresConnection(dsn string) error {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	c, cf := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cf()
+	err = db.PingContext(c)
+	if pe, ok := err.(*pq.Error); !ok || pe.Code != "3D000" {
+		// Either connected or failed for another reason than a missing database
+		return err
+	}
+
+	// Database does not exist yet, connect to the maintenance database and create it
+	u, er := url.Parse(dsn)
+	if er != nil {
+		return er
+	}
+	dbName := strings.TrimPrefix(u.Path, "/")
+	u.Path = "/postgres"
+	rootDB, er := sql.Open("postgres", u.String())
+	if er != nil {
+		return er
+	}
+	defer rootDB.Close()
+	if _, er := rootDB.Exec(fmt.Sprintf("create database %s", pq.QuoteIdentifier(dbName))); er != nil {
+		return er
+	}
+	return nil
+}
+
+func checkCellsInstallExistsPostgres(dsn string) (install bool, admin bool, e error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return
+	}
+	defer db.Close()
+	var count int
+	q := "SELECT count(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name IN ('idm_user_idx_tree', 'idm_user_attributes')"
+	if er := db.QueryRow(q).Scan(&count); er != nil || count < 2 {
+		return
+	}
+	install = true
+	q = "SELECT count(t.name) FROM idm_user_idx_tree as t , idm_user_attributes as a WHERE (a.name = 'profile' AND a.value = 'admin') AND (t.uuid = a.uuid) LIMIT 1"
+	if er := db.QueryRow(q).Scan(&count); er == nil && count > 0 {
+		admin = true
+	}
+	return
+}
@@ -51,6 +51,13 @@ func dsnFromInstallConfig(c *install.InstallConfig) (string, error) {
 
 	var conf *mysql.Config
 
+	if dbDriverFromInstallConfig(c) == "postgres" {
+		if c.GetDbConnectionType() == "manual" {
+			return c.GetDbManualDSN(), nil
+		}
+		return addDatabasePostgresConnection(c), nil
+	}
+
 	switch c.GetDbConnectionType() {
 	case "tcp":
 		conf, err = addDatabaseTCPConnection(c)
@@ -133,7 +140,12 @@ func actionDatabaseAdd(c *install.InstallConfig) error {
 		return err
 	}
 
-	if e := checkConnection(dsn); e != nil {
+	driver := dbDriverFromInstallConfig(c)
+	if driver == "postgres" {
+		if e := checkPostgresConnection(dsn); e != nil {
+			return e
+		}
+	} else if e := checkConnection(dsn); e != nil {
 		return e
 	}
 
@@ -141,7 +153,7 @@ func actionDatabaseAdd(c *install.InstallConfig) error {
 	io.WriteString(h, dsn)
 	id := fmt.Sprintf("%x", h.Sum(nil))
 
-	config.SetDatabase(id, "mysql", dsn)
+	config.SetDatabase(id, driver, dsn)
 
 	// Only set the default if the default is not set
 	if config.Get("defaults", "database").String() == "" {
@@ -111,12 +111,16 @@ func PerformCheck(ctx context.Context, name string, c *install.InstallConfig) (*
 			wrapError(e)
 			break
 		}
-		if e := checkConnection(dsn); e != nil {
+		checkConn, checkInstall := checkConnection, checkCellsInstallExists
+		if dbDriverFromInstallConfig(c) == "postgres" {
+			checkConn, checkInstall = checkPostgresConnection, checkCellsInstallExistsPostgres
+		}
+		if e := checkConn(dsn); e != nil {
 			wrapError(e)
 			break
 		}
 		jData := map[string]interface{}{"message": "successfully connected to database"}
-		if installExists, adminExists, err := checkCellsInstallExists(dsn); err == nil {
+		if installExists, adminExists, err := checkInstall(dsn); err == nil {
 			if installExists {
 				jData["tablesFound"] = true
 			}
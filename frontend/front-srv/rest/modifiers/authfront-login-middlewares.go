@@ -59,6 +59,10 @@ func LoginSuccessWrapper(middleware frontend.AuthMiddleware) frontend.AuthMiddle
 		if err != nil {
 			return err
 		}
+		// A second authentication step is pending
+		if out.Trigger != "" {
+			return nil
+		}
 
 		// AFTER MIDDLEWARE
 
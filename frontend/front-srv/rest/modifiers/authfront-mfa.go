@@ -0,0 +1,241 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package modifiers
+
+import (
+	"context"
+	"strings"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorilla/sessions"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/auth"
+	"github.com/pydio/cells/v4/common/auth/totp"
+	"github.com/pydio/cells/v4/common/config"
+	"github.com/pydio/cells/v4/common/log"
+	"github.com/pydio/cells/v4/common/proto/idm"
+	"github.com/pydio/cells/v4/common/proto/rest"
+	"github.com/pydio/cells/v4/common/service"
+	"github.com/pydio/cells/v4/common/service/errors"
+	"github.com/pydio/cells/v4/common/service/frontend"
+	"github.com/pydio/cells/v4/common/utils/permissions"
+)
+
+const (
+	// MfaTriggerVerify is sent back after a valid password when the user must provide a TOTP code
+	MfaTriggerVerify = "mfa_totp"
+	// MfaTriggerEnroll is sent back after a valid password when MFA is required but not enrolled yet.
+	// TriggerInfo contains the secret and the otpauth URL to be displayed to the user.
+	MfaTriggerEnroll = "mfa_totp_enroll"
+	// MfaEnrollType is the EnrollType handled by the FrontEnrollAuth endpoint
+	MfaEnrollType = "totp"
+)
+
+// LoginMfaWrapper passes the one-time code sent along with credentials ("mfa_code" key) to the password grant,
+// which refuses to issue any code or token to users having MFA enabled without a valid one. When the code is
+// missing, the credentials are sent back with a trigger asking for it. Nothing is kept in the session: the
+// client sends the credentials again with the code, and a device enrolled during login is kept pending in the
+// user attributes until it is validated.
+func LoginMfaWrapper(middleware frontend.AuthMiddleware) frontend.AuthMiddleware {
+	return func(req *restful.Request, rsp *restful.Response, in *frontend.FrontSessionWithRuntimeCtx, out *rest.FrontSessionResponse, session *sessions.Session) error {
+		if a, ok := in.AuthInfo["type"]; !ok || a != "credentials" { // Ignore this middleware
+			return middleware(req, rsp, in, out, session)
+		}
+		ctx := req.Request.Context()
+		code := in.AuthInfo["mfa_code"]
+		var enrolling bool
+		if u, e := permissions.SearchUniqueUser(ctx, in.AuthInfo["login"], ""); e == nil && code != "" {
+			enrolling = !auth.MfaEnrolled(u) && u.GetAttributes()[idm.UserAttrMfaTotpPending] != ""
+		}
+		req.Request = req.Request.WithContext(auth.WithMfaCode(ctx, code))
+
+		err := middleware(req, rsp, in, out, session)
+		if err == nil {
+			if enrolling {
+				return mfaLoginRecoveryCodes(ctx, in.AuthInfo["login"], out)
+			}
+			return nil
+		}
+		switch errors.FromError(err).Id {
+		case auth.MfaErrorCodeRequired:
+			out.Trigger = MfaTriggerVerify
+			return nil
+		case auth.MfaErrorEnrollRequired:
+			return mfaLoginEnroll(ctx, in.AuthInfo["login"], out)
+		default:
+			return err
+		}
+	}
+}
+
+// mfaLoginEnroll stores a new pending secret for a user who passed the password check, and sends it back.
+func mfaLoginEnroll(ctx context.Context, login string, out *rest.FrontSessionResponse) error {
+	user, err := mfaLoadUser(ctx, login)
+	if err != nil {
+		return err
+	}
+	secret, sealed, err := auth.NewMfaSecret()
+	if err != nil {
+		return err
+	}
+	user.Attributes[idm.UserAttrMfaTotpPending] = sealed
+	if err := auth.MfaSaveUser(ctx, user); err != nil {
+		return err
+	}
+	out.Trigger = MfaTriggerEnroll
+	out.TriggerInfo = map[string]string{
+		"secret": secret,
+		"url":    totp.URL(mfaIssuer(), user.Login, secret),
+	}
+	return nil
+}
+
+// mfaLoginRecoveryCodes generates recovery codes once a device was enrolled during login.
+func mfaLoginRecoveryCodes(ctx context.Context, login string, out *rest.FrontSessionResponse) error {
+	user, err := mfaLoadUser(ctx, login)
+	if err != nil {
+		return err
+	}
+	codes, err := auth.MfaNewRecoveryCodes(user)
+	if err != nil {
+		return err
+	}
+	if err := auth.MfaSaveUser(ctx, user); err != nil {
+		return err
+	}
+	out.TriggerInfo = map[string]string{"recoveryCodes": strings.Join(codes, ",")}
+	return nil
+}
+
+// MfaEnrollMiddleware handles the "totp" EnrollType of the FrontEnrollAuth endpoint for the current user.
+// Steps are "setup" (returns a new secret), "verify" (activates it and returns recovery codes), "recovery"
+// (renews recovery codes) and "disable". Replacing an enrolled device requires a "currentCode" at setup.
+// Admins can also "reset" the device of another user.
+func MfaEnrollMiddleware(req *restful.Request, rsp *restful.Response, in *rest.FrontEnrollAuthRequest) bool {
+	if in.GetEnrollType() != MfaEnrollType {
+		return false
+	}
+	ctx := req.Request.Context()
+	info := in.GetEnrollInfo()
+	login, claims := permissions.FindUserNameInContext(ctx)
+	if login == "" || login == common.PydioS3AnonUsername {
+		service.RestError401(req, rsp, errors.Unauthorized("mfa.enroll", "You must be logged in to enroll a device"))
+		return true
+	}
+	step := info["step"]
+	if step == "reset" {
+		if claims.Profile != common.PydioProfileAdmin {
+			service.RestError403(req, rsp, errors.Forbidden("mfa.enroll", "Only administrators can reset a device"))
+			return true
+		}
+		login = info["login"]
+	}
+
+	user, err := mfaLoadUser(ctx, login)
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return true
+	}
+
+	response := &rest.FrontEnrollAuthResponse{Info: map[string]string{}}
+	switch step {
+	case "setup":
+		if auth.MfaEnrolled(user) {
+			if e := auth.MfaCheckCode(user, info["currentCode"]); e != nil {
+				service.RestErrorDetect(req, rsp, e)
+				return true
+			}
+		}
+		secret, sealed, e := auth.NewMfaSecret()
+		if e != nil {
+			service.RestError500(req, rsp, e)
+			return true
+		}
+		user.Attributes[idm.UserAttrMfaTotpPending] = sealed
+		response.Info["secret"] = secret
+		response.Info["url"] = totp.URL(mfaIssuer(), user.Login, secret)
+	case "verify":
+		if e := auth.MfaActivate(user, user.Attributes[idm.UserAttrMfaTotpPending], info["code"]); e != nil {
+			service.RestErrorDetect(req, rsp, e)
+			return true
+		}
+		delete(user.Attributes, idm.UserAttrMfaTotpPending)
+		codes, e := auth.MfaNewRecoveryCodes(user)
+		if e != nil {
+			service.RestError500(req, rsp, e)
+			return true
+		}
+		response.Info["recoveryCodes"] = strings.Join(codes, ",")
+	case "recovery":
+		if e := auth.MfaCheckCode(user, info["code"]); e != nil {
+			service.RestErrorDetect(req, rsp, e)
+			return true
+		}
+		codes, e := auth.MfaNewRecoveryCodes(user)
+		if e != nil {
+			service.RestError500(req, rsp, e)
+			return true
+		}
+		response.Info["recoveryCodes"] = strings.Join(codes, ",")
+	case "disable":
+		if auth.MfaRequired(ctx, user) {
+			service.RestError403(req, rsp, errors.Forbidden("mfa.required", "Multi-factor authentication is required for your account"))
+			return true
+		}
+		if e := auth.MfaCheckCode(user, info["code"]); e != nil {
+			service.RestErrorDetect(req, rsp, e)
+			return true
+		}
+		auth.MfaClear(user)
+	case "reset":
+		auth.MfaClear(user)
+		log.Auditer(ctx).Info("MFA device was reset for user ["+user.Login+"]", user.ZapLogin())
+	default:
+		service.RestErrorDetect(req, rsp, errors.BadRequest("mfa.enroll", "Unsupported step %s", step))
+		return true
+	}
+
+	if e := auth.MfaSaveUser(ctx, user); e != nil {
+		service.RestErrorDetect(req, rsp, e)
+		return true
+	}
+	rsp.WriteEntity(response)
+	return true
+}
+
+// mfaLoadUser returns a copy of the user that can be modified and saved.
+func mfaLoadUser(ctx context.Context, login string) (*idm.User, error) {
+	u, err := permissions.SearchUniqueUser(ctx, login, "")
+	if err != nil {
+		return nil, err
+	}
+	user := proto.Clone(u).(*idm.User)
+	if user.Attributes == nil {
+		user.Attributes = map[string]string{}
+	}
+	return user, nil
+}
+
+func mfaIssuer() string {
+	return config.Get("frontend", "plugin", "core.pydio", "APPLICATION_TITLE").Default("Pydio Cells").String()
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package modifiers
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/auth"
+	"github.com/pydio/cells/v4/common/auth/claim"
+	"github.com/pydio/cells/v4/common/auth/totp"
+	"github.com/pydio/cells/v4/common/client/grpc"
+	"github.com/pydio/cells/v4/common/config"
+	"github.com/pydio/cells/v4/common/config/mock"
+	"github.com/pydio/cells/v4/common/proto/idm"
+	"github.com/pydio/cells/v4/common/proto/rest"
+	"github.com/pydio/cells/v4/common/utils/configx"
+	"github.com/pydio/cells/v4/common/utils/permissions"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// usersMock stores users in memory, indexed by login
+type usersMock struct {
+	idm.UnimplementedUserServiceServer
+	users map[string]*idm.User
+}
+
+func (u *usersMock) CreateUser(ctx context.Context, req *idm.CreateUserRequest) (*idm.CreateUserResponse, error) {
+	u.users[req.GetUser().Login] = req.GetUser()
+	return &idm.CreateUserResponse{User: req.GetUser()}, nil
+}
+
+func (u *usersMock) SearchUser(req *idm.SearchUserRequest, stream idm.UserService_SearchUserServer) error {
+	for _, sub := range req.GetQuery().GetSubQueries() {
+		q := &idm.UserSingleQuery{}
+		if e := sub.UnmarshalTo(q); e != nil {
+			return e
+		}
+		if user, ok := u.users[q.Login]; ok {
+			if e := stream.Send(&idm.SearchUserResponse{User: user}); e != nil {
+				return e
+			}
+		}
+	}
+	return nil
+}
+
+func enrollRequest(login string) (*restful.Request, *restful.Response, *httptest.ResponseRecorder) {
+	ctx := context.WithValue(context.Background(), claim.ContextKey, claim.Claims{Name: login})
+	recorder := httptest.NewRecorder()
+	req := restful.NewRequest(httptest.NewRequest(http.MethodPost, "/frontend/enroll", nil).WithContext(ctx))
+	rsp := restful.NewResponse(recorder)
+	rsp.SetRequestAccepts(restful.MIME_JSON)
+	return req, rsp, recorder
+}
+
+func TestMfaEnrollMiddleware(t *testing.T) {
+
+	store := &mock.MockStore{Values: configx.New(configx.WithJSON())}
+	config.Register(store)
+	config.RegisterLocal(store)
+	if e := config.Get("defaults", "mfa", "secureKey").Set(base64.StdEncoding.EncodeToString(make([]byte, 32))); e != nil {
+		t.Fatal(e)
+	}
+
+	users := &usersMock{users: map[string]*idm.User{}}
+	grpc.RegisterMock(common.ServiceUser, &idm.UserServiceStub{UserServiceServer: users})
+
+	secret, sealed, err := auth.NewMfaSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	reset := func() {
+		users.users["enrolled"] = &idm.User{Uuid: "enrolled-uuid", Login: "enrolled", Attributes: map[string]string{
+			idm.UserAttrMfaTotp: sealed,
+		}}
+		users.users["plain"] = &idm.User{Uuid: "plain-uuid", Login: "plain"}
+		permissions.ForceClearUserCache("enrolled")
+		permissions.ForceClearUserCache("plain")
+	}
+
+	Convey("Users without device can setup a new one", t, func() {
+		reset()
+		in := &rest.FrontEnrollAuthRequest{EnrollType: MfaEnrollType, EnrollInfo: map[string]string{"step": "setup"}}
+		req, rsp, _ := enrollRequest("plain")
+		So(MfaEnrollMiddleware(req, rsp, in), ShouldBeTrue)
+		So(users.users["plain"].Attributes[idm.UserAttrMfaTotpPending], ShouldNotBeEmpty)
+	})
+
+	Convey("Enrolled users cannot replace their device without a current code", t, func() {
+		reset()
+		in := &rest.FrontEnrollAuthRequest{EnrollType: MfaEnrollType, EnrollInfo: map[string]string{"step": "setup"}}
+		req, rsp, recorder := enrollRequest("enrolled")
+		So(MfaEnrollMiddleware(req, rsp, in), ShouldBeTrue)
+		So(recorder.Code, ShouldEqual, http.StatusUnauthorized)
+		So(users.users["enrolled"].Attributes, ShouldNotContainKey, idm.UserAttrMfaTotpPending)
+
+		in.EnrollInfo["currentCode"] = "000000x"
+		req, rsp, recorder = enrollRequest("enrolled")
+		So(MfaEnrollMiddleware(req, rsp, in), ShouldBeTrue)
+		So(recorder.Code, ShouldEqual, http.StatusUnauthorized)
+		So(users.users["enrolled"].Attributes, ShouldNotContainKey, idm.UserAttrMfaTotpPending)
+
+		in.EnrollInfo["currentCode"], _ = totp.Code(secret, time.Now())
+		req, rsp, _ = enrollRequest("enrolled")
+		So(MfaEnrollMiddleware(req, rsp, in), ShouldBeTrue)
+		So(users.users["enrolled"].Attributes[idm.UserAttrMfaTotpPending], ShouldNotBeEmpty)
+		So(users.users["enrolled"].Attributes[idm.UserAttrMfaTotp], ShouldEqual, sealed)
+	})
+}
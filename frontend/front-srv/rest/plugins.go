@@ -101,10 +101,13 @@ func init() {
 		frontend.WrapAuthMiddleware(modifiers.LoginPasswordAuth)
 		frontend.WrapAuthMiddleware(modifiers.LoginExternalAuth)
 		frontend.WrapAuthMiddleware(modifiers.AuthorizationCodeAuth)
+		frontend.WrapAuthMiddleware(modifiers.LoginMfaWrapper)
 
 		frontend.WrapAuthMiddleware(modifiers.LoginSuccessWrapper)
 		frontend.WrapAuthMiddleware(modifiers.LoginFailedWrapper)
 
+		frontend.RegisterEnrollMiddleware("FrontEnrollAuth", modifiers.MfaEnrollMiddleware)
+
 		service.NewService(
 			service.Name(common.ServiceRestNamespace_+common.ServiceFrontend),
 			service.Context(ctx),
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"fmt"
+
+	restful "github.com/emicklei/go-restful/v3"
+
+	"github.com/pydio/cells/v4/common/auth"
+	"github.com/pydio/cells/v4/common/service"
+)
+
+// samlMetadataSwagger declares the SAML metadata route, that is not generated from the protobuf definitions
+// as it does not serve JSON.
+const samlMetadataSwagger = `{
+  "swagger": "2.0",
+  "info": {"title": "SAML Service Provider Metadata", "version": "2.0"},
+  "paths": {
+    "/frontend/auth/saml/{ConnectorID}/metadata": {
+      "get": {
+        "operationId": "FrontSAMLMetadata",
+        "produces": ["application/samlmetadata+xml"],
+        "parameters": [
+          {"in": "path", "name": "ConnectorID", "required": true, "type": "string"}
+        ],
+        "responses": {
+          "200": {"description": "SAML 2.0 EntityDescriptor of the service provider"},
+          "404": {"description": "Unknown SAML connector"}
+        },
+        "tags": ["FrontendService"]
+      }
+    }
+  }
+}`
+
+func init() {
+	service.RegisterSwaggerJSON(samlMetadataSwagger)
+}
+
+// FrontSAMLMetadata serves the service provider metadata of a SAML connector, to be imported in the IdP.
+func (a *FrontendHandler) FrontSAMLMetadata(req *restful.Request, rsp *restful.Response) {
+	id := req.PathParameter("ConnectorID")
+	for _, c := range auth.GetConnectors() {
+		if c.ID() != id {
+			continue
+		}
+		mc, ok := c.Conn().(auth.SAMLMetadataConnector)
+		if !ok {
+			break
+		}
+		data, e := mc.Metadata()
+		if e != nil {
+			service.RestError500(req, rsp, e)
+			return
+		}
+		rsp.Header().Set("Content-Type", "application/samlmetadata+xml")
+		_, _ = rsp.Write(data)
+		return
+	}
+	service.RestError404(req, rsp, fmt.Errorf("cannot find SAML connector %s", id))
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"path"
 	"strings"
 	"time"
 
@@ -30,6 +31,8 @@ import (
 
 	"github.com/minio/cli"
 	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/client/grpc"
+	"github.com/pydio/cells/v4/common/config"
 	"github.com/pydio/cells/v4/common/log"
 	"github.com/pydio/cells/v4/common/nodes"
 	"github.com/pydio/cells/v4/common/nodes/compose"
@@ -40,6 +43,8 @@ import (
 
 const (
 	pydioBackend = "pydio"
+	// defaultBucketsWorkspace is the personal workspace created at first run
+	defaultBucketsWorkspace = "personal-files"
 )
 
 var (
@@ -67,19 +72,137 @@ type Pydio struct {
 // s3Objects implements gateway for Minio and S3 compatible object storage servers.
 type pydioObjects struct {
 	minio.GatewayUnsupported
-	Router nodes.Client
+	Router     nodes.Client
+	RuntimeCtx context.Context
+	// BucketsWorkspace is the slug of the workspace where buckets are created as root folders
+	BucketsWorkspace string
+}
+
+// isVirtualBucket checks if bucket is one of the two buckets exposed by the gateway
+func isVirtualBucket(bucket string) bool {
+	return bucket == "io" || bucket == "data"
 }
 
-func (l *pydioObjects) MakeBucketWithLocation(_ context.Context, _ string, _ minio.BucketOptions) error {
-	return minio.NotImplemented{}
+// bucketFolder returns the path of the folder backing a bucket, at the root of the buckets workspace.
+func (l *pydioObjects) bucketFolder(bucket string) (string, error) {
+	if l.BucketsWorkspace == "" || bucket == "" || strings.ContainsAny(bucket, "/\\") || bucket == "." || bucket == ".." {
+		return "", minio.BucketNameInvalid{Bucket: bucket}
+	}
+	return path.Join(l.BucketsWorkspace, bucket), nil
 }
 
-func (l *pydioObjects) DeleteBucket(_ context.Context, _ string, _ minio.DeleteBucketOptions) error {
-	return minio.NotImplemented{}
+// objectPath resolves an object key to a router path. Keys of the virtual buckets are full paths, keys of other
+// buckets are relative to the bucket folder.
+func (l *pydioObjects) objectPath(bucket, object string) (string, error) {
+	object = strings.TrimLeft(object, "/")
+	if isVirtualBucket(bucket) {
+		return object, nil
+	}
+	folder, er := l.bucketFolder(bucket)
+	if er != nil {
+		return "", er
+	}
+	if object == "" {
+		return folder, nil
+	}
+	return folder + "/" + object, nil
 }
 
-func (l *pydioObjects) DeleteObjects(_ context.Context, _ string, _ []minio.ObjectToDelete, _ minio.ObjectOptions) ([]minio.DeletedObject, []error) {
-	return nil, []error{minio.NotImplemented{}}
+// objectName is the reverse of objectPath: it turns a router path into an object key of the bucket.
+func (l *pydioObjects) objectName(bucket, nodePath string) string {
+	if isVirtualBucket(bucket) {
+		return nodePath
+	}
+	folder, er := l.bucketFolder(bucket)
+	if er != nil {
+		return nodePath
+	}
+	return strings.TrimPrefix(nodePath, folder+"/")
+}
+
+// MakeBucketWithLocation creates a folder at the root of the buckets workspace. It is filtered by the router ACLs,
+// and will be refused unless policies allow folder creation at this level.
+func (l *pydioObjects) MakeBucketWithLocation(ctx context.Context, bucket string, _ minio.BucketOptions) error {
+	if isVirtualBucket(bucket) {
+		return minio.BucketAlreadyOwnedByYou{Bucket: bucket}
+	}
+	folder, er := l.bucketFolder(bucket)
+	if er != nil {
+		return er
+	}
+	if _, er := l.Router.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Path: l.BucketsWorkspace}}); er != nil {
+		return pydioToMinioError(er, bucket, "")
+	}
+	if _, er := l.Router.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Path: folder}}); er == nil {
+		return minio.BucketAlreadyOwnedByYou{Bucket: bucket}
+	}
+	_, er = l.Router.CreateNode(ctx, &tree.CreateNodeRequest{Node: &tree.Node{
+		Path:  folder,
+		Type:  tree.NodeType_COLLECTION,
+		MTime: time.Now().Unix(),
+	}})
+	if er != nil {
+		return pydioToMinioError(er, bucket, "")
+	}
+	return nil
+}
+
+// DeleteBucket removes a folder at the root of the buckets workspace. It must be empty, unless opts.Force is set.
+func (l *pydioObjects) DeleteBucket(ctx context.Context, bucket string, opts minio.DeleteBucketOptions) error {
+	if isVirtualBucket(bucket) {
+		return minio.PrefixAccessDenied{Bucket: bucket}
+	}
+	folder, er := l.bucketFolder(bucket)
+	if er != nil {
+		return er
+	}
+	resp, er := l.Router.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Path: folder}})
+	if er != nil || resp.GetNode().IsLeaf() {
+		return minio.BucketNotFound{Bucket: bucket}
+	}
+	if !opts.Force {
+		objects, prefixes, er := l.ListPydioObjects(ctx, bucket, "", "/", 0, false)
+		if er != nil {
+			return er
+		}
+		for _, o := range objects {
+			if path.Base(o.Name) != common.PydioSyncHiddenFile {
+				return minio.BucketNotEmpty{Bucket: bucket}
+			}
+		}
+		if len(prefixes) > 0 {
+			return minio.BucketNotEmpty{Bucket: bucket}
+		}
+	}
+	if _, er := l.Router.DeleteNode(ctx, &tree.DeleteNodeRequest{Node: resp.GetNode()}); er != nil {
+		return pydioToMinioError(er, bucket, "")
+	}
+	return nil
+}
+
+// DeleteObjects deletes objects one by one and reports an error for each object that could not be deleted.
+func (l *pydioObjects) DeleteObjects(ctx context.Context, bucket string, objects []minio.ObjectToDelete, opts minio.ObjectOptions) ([]minio.DeletedObject, []error) {
+	deleted := make([]minio.DeletedObject, len(objects))
+	errs := make([]error, len(objects))
+	for i, o := range objects {
+		if o.VersionID != "" {
+			// Versions cannot be deleted individually through the router
+			errs[i] = minio.NotImplemented{}
+			continue
+		}
+		if _, er := l.DeleteObject(ctx, bucket, o.ObjectName, opts); er != nil {
+			if _, notFound := er.(minio.ObjectNotFound); notFound {
+				// S3 reports missing keys as deleted
+				deleted[i] = minio.DeletedObject{ObjectName: o.ObjectName}
+				continue
+			}
+			log.Logger(ctx).Warn("Cannot delete object " + o.ObjectName + ": " + er.Error())
+			errs[i] = er
+			continue
+		}
+		deleted[i] = minio.DeletedObject{ObjectName: o.ObjectName}
+	}
+	return deleted, errs
 }
 
 // Name returns the unique name of the gateway.
@@ -90,7 +213,9 @@ func (p *Pydio) Name() string {
 // NewGatewayLayer returns a new  ObjectLayer.
 func (p *Pydio) NewGatewayLayer(_ madmin.Credentials) (minio.ObjectLayer, error) {
 	o := &pydioObjects{
-		Router: compose.PathClient(p.RuntimeCtx, nodes.WithReadEventsLogging(), nodes.WithAuditEventsLogging()),
+		Router:           compose.PathClient(p.RuntimeCtx, nodes.WithReadEventsLogging(), nodes.WithAuditEventsLogging()),
+		RuntimeCtx:       p.RuntimeCtx,
+		BucketsWorkspace: config.Get("services", common.ServiceGatewayData, "bucketsWorkspace").Default(defaultBucketsWorkspace).String(),
 	}
 	return o, nil
 }
@@ -169,7 +294,10 @@ func (l *pydioObjects) ListPydioObjects(ctx context.Context, bucket string, pref
 
 	// log.Printf("ListPydioObjects With Version? %v", versions)
 
-	treePath := strings.TrimLeft(prefix, "/")
+	treePath, err := l.objectPath(bucket, prefix)
+	if err != nil {
+		return nil, nil, err
+	}
 	recursive := false
 	if delimiter == "" {
 		recursive = true
@@ -207,6 +335,9 @@ func (l *pydioObjects) ListPydioObjects(ctx context.Context, bucket string, pref
 		}
 		// log.Println(clientResponse.Node.Path)
 		objectInfo := fromPydioNodeObjectInfo(bucket, clientResponse.Node)
+		if objectInfo.Name = l.objectName(bucket, objectInfo.Name); objectInfo.Name == "" {
+			continue
+		}
 		if clientResponse.Node.IsLeaf() {
 			objects = append(objects, objectInfo)
 		} else {
@@ -234,25 +365,65 @@ func (l *pydioObjects) StorageInfo(_ context.Context) (minio.StorageInfo, []erro
 }
 
 // GetBucketInfo gets bucket metadata..
-func (l *pydioObjects) GetBucketInfo(_ context.Context, bucket string) (bi minio.BucketInfo, e error) {
+func (l *pydioObjects) GetBucketInfo(ctx context.Context, bucket string) (bi minio.BucketInfo, e error) {
 
-	if bucket != "io" && bucket != "data" {
+	if isVirtualBucket(bucket) {
+		return minio.BucketInfo{
+			Name:    bucket,
+			Created: time.Now(),
+		}, nil
+	}
+	folder, er := l.bucketFolder(bucket)
+	if er != nil {
+		return bi, minio.BucketNotFound{Bucket: bucket}
+	}
+	resp, er := l.Router.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Path: folder}})
+	if er != nil || resp.GetNode().IsLeaf() {
 		return bi, minio.BucketNotFound{Bucket: bucket}
 	}
 	return minio.BucketInfo{
 		Name:    bucket,
-		Created: time.Now(),
+		Created: time.Unix(resp.GetNode().GetMTime(), 0),
 	}, nil
 
 }
 
-// ListBuckets lists all S3 buckets
-func (l *pydioObjects) ListBuckets(_ context.Context) ([]minio.BucketInfo, error) {
+// ListBuckets lists the virtual buckets and the folders at the root of the buckets workspace
+func (l *pydioObjects) ListBuckets(ctx context.Context) ([]minio.BucketInfo, error) {
 
-	return []minio.BucketInfo{
+	buckets := []minio.BucketInfo{
 		{Name: "io", Created: time.Now()},
 		{Name: "data", Created: time.Now()},
-	}, nil
+	}
+	if l.BucketsWorkspace == "" {
+		return buckets, nil
+	}
+	streamer, er := l.Router.ListNodes(ctx, &tree.ListNodesRequest{
+		Node:       &tree.Node{Path: l.BucketsWorkspace},
+		FilterType: tree.NodeType_COLLECTION,
+	})
+	if er != nil {
+		// Workspace may not be accessible to the current user
+		log.Logger(ctx).Debug("Cannot list buckets workspace: " + er.Error())
+		return buckets, nil
+	}
+	defer streamer.CloseSend()
+	for {
+		resp, er := streamer.Recv()
+		if er != nil {
+			break
+		}
+		node := resp.GetNode()
+		if node == nil || node.IsLeaf() || path.Dir(node.GetPath()) != l.BucketsWorkspace {
+			continue
+		}
+		name := path.Base(node.GetPath())
+		if isVirtualBucket(name) || strings.HasPrefix(name, ".") {
+			continue
+		}
+		buckets = append(buckets, minio.BucketInfo{Name: name, Created: time.Unix(node.GetMTime(), 0)})
+	}
+	return buckets, nil
 
 }
 
@@ -302,9 +473,12 @@ func (l *pydioObjects) GetObjectInfo(ctx context.Context, bucket string, object
 
 	//fmt.Println("[Gateway:GetObjectInfo]" + object)
 
-	path := strings.TrimLeft(object, "/")
+	nodePath, err := l.objectPath(bucket, object)
+	if err != nil {
+		return minio.ObjectInfo{}, err
+	}
 	node := &tree.Node{
-		Path: path,
+		Path: nodePath,
 	}
 	if opts.VersionID != "" {
 		node.MustSetMeta(common.MetaNamespaceVersionId, opts.VersionID)
@@ -319,7 +493,9 @@ func (l *pydioObjects) GetObjectInfo(ctx context.Context, bucket string, object
 		return minio.ObjectInfo{}, e
 	}
 
-	return fromPydioNodeObjectInfo(bucket, readNodeResponse.Node), nil
+	objInfo = fromPydioNodeObjectInfo(bucket, readNodeResponse.Node)
+	objInfo.Name = l.objectName(bucket, objInfo.Name)
+	return objInfo, nil
 
 }
 
@@ -362,9 +538,12 @@ func (l *pydioObjects) GetObject(ctx context.Context, bucket string, key string,
 
 	// log.Println("[GetObject] From Router", bucket, key, startOffset, length)
 
-	path := strings.TrimLeft(key, "/")
+	nodePath, err := l.objectPath(bucket, key)
+	if err != nil {
+		return err
+	}
 	objectReader, err := l.Router.GetObject(ctx, &tree.Node{
-		Path: path,
+		Path: nodePath,
 	}, &models.GetRequestData{
 		StartOffset: startOffset,
 		Length:      length,
@@ -391,14 +570,25 @@ func (l *pydioObjects) PutObject(ctx context.Context, bucket, object string, dat
 		}
 	}
 
-	written, err := l.Router.PutObject(ctx, &tree.Node{
-		Path: strings.TrimLeft(object, "/"),
-	}, data, &models.PutRequestData{
+	return l.putObject(ctx, bucket, object, data, &models.PutRequestData{
 		Size:      data.Size(),
 		Sha256Sum: data.SHA256(),
 		Md5Sum:    data.MD5(),
 		Metadata:  opts.UserDefined,
 	})
+
+}
+
+// putObject writes the object at its router path.
+func (l *pydioObjects) putObject(ctx context.Context, bucket, object string, data io.Reader, requestData *models.PutRequestData) (objInfo minio.ObjectInfo, err error) {
+
+	nodePath, err := l.objectPath(bucket, object)
+	if err != nil {
+		return objInfo, err
+	}
+	written, err := l.Router.PutObject(ctx, &tree.Node{
+		Path: nodePath,
+	}, data, requestData)
 	if err != nil {
 		log.Logger(ctx).Error("Error while putting object:" + err.Error())
 		return objInfo, pydioToMinioError(err, bucket, object)
@@ -417,15 +607,22 @@ func (l *pydioObjects) PutObject(ctx context.Context, bucket, object string, dat
 func (l *pydioObjects) CopyObject(ctx context.Context, srcBucket string, srcObject string, destBucket string, destObject string,
 	srcInfo minio.ObjectInfo, srcOpts, dstOpts minio.ObjectOptions) (objInfo minio.ObjectInfo, e error) {
 
-	if srcObject == destObject && srcOpts.VersionID == "" {
-		// log.Printf("Coping %v to %v, this is a REPLACE meta directive \n", srcObject, destObject)
-		// log.Println(requestMetadata)
-		return objInfo, (&minio.NotImplemented{})
+	srcPath, err := l.objectPath(srcBucket, srcObject)
+	if err != nil {
+		return objInfo, err
+	}
+	destPath, err := l.objectPath(destBucket, destObject)
+	if err != nil {
+		return objInfo, err
+	}
+	if srcPath == destPath && srcOpts.VersionID == "" {
+		// Copying an object onto itself is a REPLACE meta directive
+		return l.replaceObjectMetadata(ctx, destBucket, destObject, dstOpts.UserDefined)
 	}
 	written, err := l.Router.CopyObject(ctx, &tree.Node{
-		Path: strings.TrimLeft(srcObject, "/"),
+		Path: srcPath,
 	}, &tree.Node{
-		Path: strings.TrimLeft(destObject, "/"),
+		Path: destPath,
 	}, &models.CopyRequestData{
 		SrcVersionId: srcOpts.VersionID,
 	})
@@ -441,13 +638,57 @@ func (l *pydioObjects) CopyObject(ctx context.Context, srcBucket string, srcObje
 
 }
 
+// replaceObjectMetadata replaces the user-defined metadata of the node with the ones of an in-place copy.
+func (l *pydioObjects) replaceObjectMetadata(ctx context.Context, bucket, object string, userDefined map[string]string) (objInfo minio.ObjectInfo, e error) {
+	nodePath, er := l.objectPath(bucket, object)
+	if er != nil {
+		return objInfo, er
+	}
+	resp, er := l.Router.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Path: nodePath}})
+	if er != nil {
+		return objInfo, pydioToMinioError(er, bucket, object)
+	}
+	node := resp.GetNode().Clone()
+	if !node.IsLeaf() {
+		return objInfo, minio.ObjectNotFound{Bucket: bucket, Object: object}
+	}
+	meta := replacedMetadata(node, userDefined)
+	if len(meta) > 0 {
+		// Only send modified namespaces to the meta service
+		node.MetaStore = make(map[string]string, len(meta))
+		for k, v := range meta {
+			node.MustSetMeta(k, v)
+		}
+		e = l.Router.WrapCallback(func(inputFilter nodes.FilterFunc, outputFilter nodes.FilterFunc) error {
+			var er error
+			var metaCtx context.Context
+			metaCtx, node, er = inputFilter(ctx, node, "in")
+			if er != nil {
+				return er
+			}
+			cli := tree.NewNodeReceiverClient(grpc.GetClientConnFromCtx(l.RuntimeCtx, common.ServiceMeta))
+			_, er = cli.UpdateNode(metaCtx, &tree.UpdateNodeRequest{From: node, To: node})
+			return er
+		})
+		if e != nil {
+			log.Logger(ctx).Error("Error while replacing object metadata: " + e.Error())
+			return objInfo, pydioToMinioError(e, bucket, object)
+		}
+	}
+	return l.GetObjectInfo(ctx, bucket, object, minio.ObjectOptions{})
+}
+
 // DeleteObject deletes a blob in bucket
 func (l *pydioObjects) DeleteObject(ctx context.Context, bucket string, object string, opts minio.ObjectOptions) (minio.ObjectInfo, error) {
 
 	// log.Println("[DeleteObject]", object)
-	_, err := l.Router.DeleteNode(ctx, &tree.DeleteNodeRequest{
+	nodePath, err := l.objectPath(bucket, object)
+	if err != nil {
+		return minio.ObjectInfo{}, err
+	}
+	_, err = l.Router.DeleteNode(ctx, &tree.DeleteNodeRequest{
 		Node: &tree.Node{
-			Path: strings.TrimLeft(object, "/"),
+			Path: nodePath,
 		},
 	})
 	if err != nil {
@@ -460,7 +701,11 @@ func (l *pydioObjects) DeleteObject(ctx context.Context, bucket string, object s
 // ListMultipartUploads lists all multipart uploads.
 func (l *pydioObjects) ListMultipartUploads(ctx context.Context, bucket string, prefix string, keyMarker string, uploadIDMarker string, delimiter string, maxUploads int) (lmi minio.ListMultipartsInfo, e error) {
 
-	result, err := l.Router.MultipartList(ctx, prefix, &models.MultipartRequestData{
+	listPrefix, err := l.objectPath(bucket, prefix)
+	if err != nil {
+		return lmi, err
+	}
+	result, err := l.Router.MultipartList(ctx, listPrefix, &models.MultipartRequestData{
 		ListKeyMarker:      keyMarker,
 		ListUploadIDMarker: uploadIDMarker,
 		ListDelimiter:      delimiter,
@@ -483,7 +728,7 @@ func (l *pydioObjects) ListMultipartUploads(ctx context.Context, bucket string,
 		for i, u := range result.Uploads {
 			res.Uploads[i] = minio.MultipartInfo{
 				Bucket:    bucket,
-				Object:    u.Key,
+				Object:    l.objectName(bucket, u.Key),
 				UploadID:  u.UploadID,
 				Initiated: u.Initiated,
 				//StorageClass: u.StorageClass,
@@ -502,8 +747,12 @@ func (l *pydioObjects) ListMultipartUploads(ctx context.Context, bucket string,
 // NewMultipartUpload upload object in multiple parts
 func (l *pydioObjects) NewMultipartUpload(ctx context.Context, bucket string, object string, o minio.ObjectOptions) (uploadID string, err error) {
 
+	nodePath, err := l.objectPath(bucket, object)
+	if err != nil {
+		return "", err
+	}
 	uploadID, err = l.Router.MultipartCreate(ctx, &tree.Node{
-		Path: object,
+		Path: nodePath,
 	}, &models.MultipartRequestData{
 		Metadata: minio.ToMinioClientMetadata(o.UserDefined),
 	})
@@ -527,7 +776,11 @@ func (l *pydioObjects) PutObjectPart(ctx context.Context, bucket, object, upload
 
 	//sha256Sum, err := hex.DecodeString(data.sha256Sum)
 	//md5Sum, err := hex.DecodeString(data.md5Sum)
-	objectPart, err := l.Router.MultipartPutObjectPart(ctx, &tree.Node{Path: object}, uploadID, partID, data, &models.PutRequestData{
+	nodePath, err := l.objectPath(bucket, object)
+	if err != nil {
+		return info, err
+	}
+	objectPart, err := l.Router.MultipartPutObjectPart(ctx, &tree.Node{Path: nodePath}, uploadID, partID, data, &models.PutRequestData{
 		Size:              data.Size(),
 		Md5Sum:            data.MD5(),    // md5Sum,
 		Sha256Sum:         data.SHA256(), //sha256Sum,
@@ -550,7 +803,11 @@ func (l *pydioObjects) PutObjectPart(ctx context.Context, bucket, object, upload
 // ListObjectParts returns all object parts for specified object in specified bucket
 func (l *pydioObjects) ListObjectParts(ctx context.Context, bucket, object, uploadID string, partNumberMarker int, maxParts int, opts minio.ObjectOptions) (lpi minio.ListPartsInfo, e error) {
 
-	result, err := l.Router.MultipartListObjectParts(ctx, &tree.Node{Path: object}, uploadID, partNumberMarker, maxParts)
+	nodePath, err := l.objectPath(bucket, object)
+	if err != nil {
+		return lpi, err
+	}
+	result, err := l.Router.MultipartListObjectParts(ctx, &tree.Node{Path: nodePath}, uploadID, partNumberMarker, maxParts)
 	if err != nil {
 		return lpi, err
 	}
@@ -574,7 +831,7 @@ func (l *pydioObjects) ListObjectParts(ctx context.Context, bucket, object, uplo
 	return minio.ListPartsInfo{
 		UploadID:             result.UploadID,
 		Bucket:               result.Bucket,
-		Object:               result.Key,
+		Object:               l.objectName(bucket, result.Key),
 		StorageClass:         result.StorageClass,
 		PartNumberMarker:     result.PartNumberMarker,
 		NextPartNumberMarker: result.NextPartNumberMarker,
@@ -588,7 +845,11 @@ func (l *pydioObjects) ListObjectParts(ctx context.Context, bucket, object, uplo
 // AbortMultipartUpload aborts a ongoing multipart upload
 func (l *pydioObjects) AbortMultipartUpload(ctx context.Context, bucket string, object string, uploadID string, opts minio.ObjectOptions) error {
 
-	return l.Router.MultipartAbort(ctx, &tree.Node{Path: object}, uploadID, &models.MultipartRequestData{Metadata: opts.UserDefined})
+	nodePath, err := l.objectPath(bucket, object)
+	if err != nil {
+		return err
+	}
+	return l.Router.MultipartAbort(ctx, &tree.Node{Path: nodePath}, uploadID, &models.MultipartRequestData{Metadata: opts.UserDefined})
 
 }
 
@@ -601,7 +862,11 @@ func (l *pydioObjects) CompleteMultipartUpload(ctx context.Context, bucket strin
 			ETag:       part.ETag,
 		}
 	}
-	oi, err := l.Router.MultipartComplete(ctx, &tree.Node{Path: object}, uploadID, mParts)
+	nodePath, err := l.objectPath(bucket, object)
+	if err != nil {
+		return moi, err
+	}
+	oi, err := l.Router.MultipartComplete(ctx, &tree.Node{Path: nodePath}, uploadID, mParts)
 	if err != nil {
 		return moi, err
 	}
@@ -612,7 +877,7 @@ func (l *pydioObjects) CompleteMultipartUpload(ctx context.Context, bucket strin
 	canonicalETag = strings.TrimSuffix(canonicalETag, "\"")
 	moi = minio.ObjectInfo{
 		Bucket:          bucket,
-		Name:            oi.Key,
+		Name:            l.objectName(bucket, oi.Key),
 		ModTime:         oi.LastModified,
 		Size:            oi.Size,
 		ETag:            canonicalETag,
@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package pydio
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	minio "github.com/minio/minio/cmd"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/v4/common/nodes"
+	"github.com/pydio/cells/v4/common/nodes/models"
+	"github.com/pydio/cells/v4/common/proto/tree"
+)
+
+// clientMock adds the nodes.Client methods to the HandlerMock
+type clientMock struct {
+	*nodes.HandlerMock
+}
+
+func (c *clientMock) WrapCallback(provider nodes.CallbackFunc) error {
+	return nil
+}
+
+func (c *clientMock) BranchInfoForNode(ctx context.Context, node *tree.Node) (branch nodes.BranchInfo, err error) {
+	return
+}
+
+func (c *clientMock) CanApply(ctx context.Context, operation *tree.NodeChangeEvent) (*tree.NodeChangeEvent, error) {
+	return operation, nil
+}
+
+func (c *clientMock) GetClientsPool() nodes.SourcesPool {
+	return nil
+}
+
+func TestBuckets(t *testing.T) {
+	nodes.IsUnitTestEnv = true
+	ctx := context.Background()
+
+	Convey("Buckets are created in the buckets workspace", t, func() {
+		mock := nodes.NewHandlerMock()
+		l := &pydioObjects{Router: &clientMock{HandlerMock: mock}, BucketsWorkspace: "personal-files"}
+
+		So(l.MakeBucketWithLocation(ctx, "io", minio.BucketOptions{}), ShouldHaveSameTypeAs, minio.BucketAlreadyOwnedByYou{})
+		So(l.MakeBucketWithLocation(ctx, "a/b", minio.BucketOptions{}), ShouldHaveSameTypeAs, minio.BucketNameInvalid{})
+		So(l.MakeBucketWithLocation(ctx, "..", minio.BucketOptions{}), ShouldHaveSameTypeAs, minio.BucketNameInvalid{})
+		// Workspace is not readable
+		So(l.MakeBucketWithLocation(ctx, "bucket", minio.BucketOptions{}), ShouldNotBeNil)
+
+		mock.Nodes["personal-files"] = &tree.Node{Path: "personal-files", Type: tree.NodeType_COLLECTION}
+		So(l.MakeBucketWithLocation(ctx, "bucket", minio.BucketOptions{}), ShouldBeNil)
+		So(mock.Nodes["in"].Path, ShouldEqual, "personal-files/bucket")
+		So(mock.Nodes["in"].Type, ShouldEqual, tree.NodeType_COLLECTION)
+
+		mock.Nodes["personal-files/bucket"] = &tree.Node{Path: "personal-files/bucket", Type: tree.NodeType_COLLECTION}
+		So(l.MakeBucketWithLocation(ctx, "bucket", minio.BucketOptions{}), ShouldHaveSameTypeAs, minio.BucketAlreadyOwnedByYou{})
+	})
+
+	Convey("Buckets are deleted from the buckets workspace", t, func() {
+		mock := nodes.NewHandlerMock()
+		l := &pydioObjects{Router: &clientMock{HandlerMock: mock}, BucketsWorkspace: "personal-files"}
+
+		So(l.DeleteBucket(ctx, "data", minio.DeleteBucketOptions{}), ShouldHaveSameTypeAs, minio.PrefixAccessDenied{})
+		So(l.DeleteBucket(ctx, "bucket", minio.DeleteBucketOptions{Force: true}), ShouldHaveSameTypeAs, minio.BucketNotFound{})
+
+		mock.Nodes["bucket"] = &tree.Node{Path: "bucket", Type: tree.NodeType_COLLECTION}
+		So(l.DeleteBucket(ctx, "bucket", minio.DeleteBucketOptions{Force: true}), ShouldHaveSameTypeAs, minio.BucketNotFound{})
+
+		mock.Nodes["personal-files/bucket"] = &tree.Node{Path: "personal-files/bucket", Type: tree.NodeType_COLLECTION}
+		So(l.DeleteBucket(ctx, "bucket", minio.DeleteBucketOptions{Force: true}), ShouldBeNil)
+		So(mock.Nodes["in"].Path, ShouldEqual, "personal-files/bucket")
+	})
+
+	Convey("Objects of created buckets are stored in the bucket folder", t, func() {
+		mock := nodes.NewHandlerMock()
+		l := &pydioObjects{Router: &clientMock{HandlerMock: mock}, BucketsWorkspace: "personal-files"}
+
+		_, e := l.GetBucketInfo(ctx, "bucket")
+		So(e, ShouldHaveSameTypeAs, minio.BucketNotFound{})
+
+		mock.Nodes["personal-files"] = &tree.Node{Path: "personal-files", Type: tree.NodeType_COLLECTION}
+		So(l.MakeBucketWithLocation(ctx, "bucket", minio.BucketOptions{}), ShouldBeNil)
+		mock.Nodes["personal-files/bucket"] = mock.Nodes["in"]
+
+		info, e := l.GetBucketInfo(ctx, "bucket")
+		So(e, ShouldBeNil)
+		So(info.Name, ShouldEqual, "bucket")
+		buckets, e := l.ListBuckets(ctx)
+		So(e, ShouldBeNil)
+		So(buckets, ShouldHaveLength, 3)
+		So(buckets[2].Name, ShouldEqual, "bucket")
+
+		_, e = l.putObject(ctx, "bucket", "folder/file.txt", strings.NewReader("content"), &models.PutRequestData{Size: 7})
+		So(e, ShouldBeNil)
+		So(mock.Nodes["in"].Path, ShouldEqual, "personal-files/bucket/folder/file.txt")
+		mock.Nodes["personal-files/bucket/folder/file.txt"] = &tree.Node{Path: "personal-files/bucket/folder/file.txt", Type: tree.NodeType_LEAF, Size: 7}
+
+		list, e := l.ListObjects(ctx, "bucket", "", "", "", 0)
+		So(e, ShouldBeNil)
+		So(list.Objects, ShouldHaveLength, 1)
+		So(list.Objects[0].Name, ShouldEqual, "folder/file.txt")
+		So(list.Objects[0].Bucket, ShouldEqual, "bucket")
+
+		obj, e := l.GetObjectInfo(ctx, "bucket", "folder/file.txt", minio.ObjectOptions{})
+		So(e, ShouldBeNil)
+		So(obj.Name, ShouldEqual, "folder/file.txt")
+
+		_, e = l.CopyObject(ctx, "bucket", "folder/file.txt", "io", "personal-files/copy.txt", minio.ObjectInfo{}, minio.ObjectOptions{}, minio.ObjectOptions{})
+		So(e, ShouldBeNil)
+		So(mock.Nodes["from"].Path, ShouldEqual, "personal-files/bucket/folder/file.txt")
+		So(mock.Nodes["to"].Path, ShouldEqual, "personal-files/copy.txt")
+
+		_, e = l.DeleteObject(ctx, "bucket", "folder/file.txt", minio.ObjectOptions{})
+		So(e, ShouldBeNil)
+		So(mock.Nodes["in"].Path, ShouldEqual, "personal-files/bucket/folder/file.txt")
+	})
+}
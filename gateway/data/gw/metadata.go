@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package pydio
+
+import (
+	"strings"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/proto/tree"
+)
+
+var (
+	// reservedMetaPrefixes are prefixes of metadata namespaces managed internally
+	reservedMetaPrefixes = []string{"pydio", "ws_", "ds_", "datasource_", "repository_", "image_", "x-amz-", "content-"}
+	// reservedMetaNamespaces are metadata namespaces managed internally by the various services
+	reservedMetaNamespaces = map[string]bool{
+		common.MetaNamespaceNodeName:          true,
+		common.MetaNamespaceMime:              true,
+		common.MetaNamespaceVersionId:         true,
+		common.MetaNamespaceVersionDesc:       true,
+		common.MetaNamespaceGeoLocation:       true,
+		common.MetaNamespaceContents:          true,
+		common.RecycleBinName:                 true,
+		common.MetaFlagReadonly:               true,
+		common.MetaFlagLevelReadonly:          true,
+		common.MetaFlagVirtualRoot:            true,
+		common.NodeFlagEtagTemporary:          true,
+		common.MetaFlagCellNode:               true,
+		common.MetaFlagChildrenCount:          true,
+		common.MetaFlagChildrenFolders:        true,
+		common.MetaFlagChildrenFiles:          true,
+		common.MetaFlagContentLock:            true,
+		common.MetaFlagWorkspacesShares:       true,
+		common.MetaFlagUserSubscriptions:      true,
+		common.MetaFlagDocumentContentHit:     true,
+		common.MetaFlagWorkspaceEventId:       true,
+		common.MetaFlagStreamError:            true,
+		common.PydioThumbstoreNamespace:       true,
+		common.PydioDocstoreBinariesNamespace: true,
+		common.PydioVersionsNamespace:         true,
+		// Metadata computed by scheduler actions
+		"ImageThumbnails":    true,
+		"ImageDimensions":    true,
+		"ImageExif":          true,
+		"VideoMetadata":      true,
+		"ContentRef":         true,
+		"is_image":           true,
+		"readable_dimension": true,
+		"remote_push":        true,
+	}
+)
+
+// isReservedMeta checks if a metadata namespace is managed internally and cannot be set or removed by S3 clients.
+func isReservedMeta(ns string) bool {
+	if ns == "" || reservedMetaNamespaces[ns] {
+		return true
+	}
+	lns := strings.ToLower(ns)
+	for k := range reservedMetaNamespaces {
+		if strings.ToLower(k) == lns {
+			return true
+		}
+	}
+	for _, p := range reservedMetaPrefixes {
+		if strings.HasPrefix(lns, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// replacedMetadata computes the metadata to store on a node for a REPLACE metadata directive. Content-Type is
+// stored as mime, x-amz-meta-* headers are stored under their suffix as namespace, and user metadata currently
+// set on the node but missing from the request are removed.
+func replacedMetadata(node *tree.Node, userDefined map[string]string) map[string]string {
+	meta := make(map[string]string)
+	for k, v := range userDefined {
+		lk := strings.ToLower(k)
+		if lk == "content-type" {
+			meta[common.MetaNamespaceMime] = v
+		} else if strings.HasPrefix(lk, "x-amz-meta-") {
+			if ns := strings.TrimPrefix(lk, "x-amz-meta-"); !isReservedMeta(ns) {
+				meta[ns] = v
+			}
+		}
+	}
+	for k := range node.GetMetaStore() {
+		if _, ok := meta[strings.ToLower(k)]; ok || isReservedMeta(k) {
+			continue
+		}
+		// Removed metadata are stored with an empty value, as does the meta REST API
+		meta[k] = ""
+	}
+	return meta
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package pydio
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/proto/tree"
+)
+
+func TestReservedMeta(t *testing.T) {
+	Convey("Internal namespaces are reserved", t, func() {
+		for _, ns := range []string{"", "pydio:meta-data-source-name", "pydio-thumbstore", "name", "mime", "Mime", "versionId",
+			"ws_uuid", "ds_bucket", "datasource_encrypted", "ImageThumbnails", "imagethumbnails", "image_width", "content_lock", "content-md5", "GeoLocation"} {
+			So(isReservedMeta(ns), ShouldBeTrue)
+		}
+	})
+	Convey("User namespaces are not reserved", t, func() {
+		for _, ns := range []string{"usermeta-tags", "author", "mtime", "project"} {
+			So(isReservedMeta(ns), ShouldBeFalse)
+		}
+	})
+}
+
+func TestReplacedMetadata(t *testing.T) {
+	Convey("Replace metadata of a node", t, func() {
+		node := &tree.Node{Path: "ws/file.txt", Type: tree.NodeType_LEAF}
+		node.MustSetMeta("author", "john")
+		node.MustSetMeta("project", "alpha")
+		node.MustSetMeta(common.MetaNamespaceMime, "text/plain")
+		node.MustSetMeta("ImageThumbnails", "{}")
+		node.MustSetMeta(common.MetaFlagWorkspaceUuid, "ws-uuid")
+
+		meta := replacedMetadata(node, map[string]string{
+			"Content-Type":               "text/markdown",
+			"X-Amz-Meta-Author":          "jane",
+			"X-Amz-Meta-Mtime":           "1650000000",
+			"X-Amz-Meta-Pydio-Node-Uuid": "fake-uuid",
+			"X-Amz-Meta-Name":            "other.txt",
+		})
+		So(meta, ShouldResemble, map[string]string{
+			common.MetaNamespaceMime: "text/markdown",
+			"author":                 "jane",
+			"mtime":                  "1650000000",
+			"project":                "",
+		})
+	})
+
+	Convey("Replace with no user metadata removes all of them", t, func() {
+		node := &tree.Node{Path: "ws/file.txt", Type: tree.NodeType_LEAF}
+		node.MustSetMeta("author", "john")
+		node.MustSetMeta(common.MetaNamespaceNodeName, "file.txt")
+		meta := replacedMetadata(node, map[string]string{})
+		So(meta, ShouldResemble, map[string]string{"author": ""})
+	})
+}
@@ -6,6 +6,10 @@ import (
 	"os"
 	"time"
 
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/log"
 	"github.com/pydio/cells/v4/common/nodes"
 	"github.com/pydio/cells/v4/common/nodes/compose"
 	"github.com/pydio/cells/v4/common/proto/tree"
@@ -65,38 +69,97 @@ func (t *TreeHandler) ReadNodeStream(s tree.NodeProviderStreamer_ReadNodeStreamS
 	return err
 }
 
+// CreateNodeStream creates nodes one by one through the router. A failing request does not close the stream,
+// an unsuccessful response carrying the error in the MetaFlagStreamError metadata is sent instead.
 func (t *TreeHandler) CreateNodeStream(s tree.NodeReceiverStream_CreateNodeStreamServer) error {
 	router := t.getRouter()
-	var err error
 	for {
 		r, e := s.Recv()
 		if e != nil {
-			if e != io.EOF {
-				s.SendMsg(e)
-				err = e
+			return t.streamRecvError(e)
+		}
+		var resp *tree.CreateNodeResponse
+		if r.GetNode() == nil {
+			resp = &tree.CreateNodeResponse{Node: t.streamErrorNode(nil, errors.BadRequest("missing.node", "request must provide a node"))}
+		} else {
+			t.fixMode(r.Node)
+			if rr, er := router.CreateNode(s.Context(), r); er != nil {
+				resp = &tree.CreateNodeResponse{Node: t.streamErrorNode(r.Node, er)}
+			} else {
+				resp = &tree.CreateNodeResponse{Success: true, Node: rr.GetNode()}
 			}
-			break
 		}
-		t.fixMode(r.Node)
-		resp, er := router.CreateNode(s.Context(), r)
-		if er != nil {
-			s.SendMsg(er)
-			err = er
-			break
+		if e := s.Send(resp); e != nil {
+			return e
 		}
-		if err = s.Send(resp); err != nil {
-			break
+	}
+}
+
+// UpdateNodeStream moves nodes one by one through the router. A failing request does not close the stream,
+// an unsuccessful response carrying the error in the MetaFlagStreamError metadata is sent instead.
+func (t *TreeHandler) UpdateNodeStream(s tree.NodeReceiverStream_UpdateNodeStreamServer) error {
+	router := t.getRouter()
+	for {
+		r, e := s.Recv()
+		if e != nil {
+			return t.streamRecvError(e)
+		}
+		var resp *tree.UpdateNodeResponse
+		if r.GetFrom() == nil || r.GetTo() == nil {
+			resp = &tree.UpdateNodeResponse{Node: t.streamErrorNode(r.GetFrom(), errors.BadRequest("missing.node", "request must provide both From and To nodes"))}
+		} else if rr, er := router.UpdateNode(s.Context(), r); er != nil {
+			resp = &tree.UpdateNodeResponse{Node: t.streamErrorNode(r.From, er)}
+		} else {
+			resp = &tree.UpdateNodeResponse{Success: true, Node: rr.GetNode()}
+		}
+		if e := s.Send(resp); e != nil {
+			return e
+		}
+	}
+}
+
+// DeleteNodeStream deletes nodes one by one through the router. A failing request does not close the stream,
+// an unsuccessful response carrying the error in the MetaFlagStreamError metadata is sent instead.
+func (t *TreeHandler) DeleteNodeStream(s tree.NodeReceiverStream_DeleteNodeStreamServer) error {
+	router := t.getRouter()
+	for {
+		r, e := s.Recv()
+		if e != nil {
+			return t.streamRecvError(e)
+		}
+		var resp *tree.DeleteNodeResponse
+		if r.GetNode() == nil {
+			resp = &tree.DeleteNodeResponse{Node: t.streamErrorNode(nil, errors.BadRequest("missing.node", "request must provide a node"))}
+		} else if _, er := router.DeleteNode(s.Context(), r); er != nil {
+			log.Logger(s.Context()).Warn("DeleteNodeStream: cannot delete node "+r.GetNode().GetPath(), zap.Error(er))
+			resp = &tree.DeleteNodeResponse{Node: t.streamErrorNode(r.Node, er)}
+		} else {
+			resp = &tree.DeleteNodeResponse{Success: true, Node: r.Node}
+		}
+		if e := s.Send(resp); e != nil {
+			return e
 		}
 	}
-	return err //errors.BadRequest("not.implemented", "CreateNodeStream not implemented yet")
 }
 
-func (t *TreeHandler) UpdateNodeStream(tree.NodeReceiverStream_UpdateNodeStreamServer) error {
-	return errors.BadRequest("not.implemented", "UpdateNodeStream not implemented yet")
+// streamRecvError filters out normal end-of-stream errors
+func (t *TreeHandler) streamRecvError(e error) error {
+	if e == io.EOF || e == io.ErrUnexpectedEOF {
+		return nil
+	}
+	return e
 }
 
-func (t *TreeHandler) DeleteNodeStream(tree.NodeReceiverStream_DeleteNodeStreamServer) error {
-	return errors.BadRequest("not.implemented", "DeleteNodeStream not implemented yet")
+// streamErrorNode returns a copy of the request node (to let clients correlate responses) flagged with the error
+func (t *TreeHandler) streamErrorNode(reqNode *tree.Node, e error) *tree.Node {
+	var n *tree.Node
+	if reqNode != nil {
+		n = reqNode.Clone()
+	} else {
+		n = &tree.Node{}
+	}
+	n.MustSetMeta(common.MetaFlagStreamError, e.Error())
+	return n
 }
 
 // ReadNode forwards to router
@@ -0,0 +1,196 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package grpc
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/nodes"
+	"github.com/pydio/cells/v4/common/proto/tree"
+	"github.com/pydio/cells/v4/common/service/errors"
+)
+
+// failingRouter is a mock router returning an error for any node whose path contains "fail"
+type failingRouter struct {
+	*nodes.HandlerMock
+}
+
+func (f *failingRouter) CreateNode(ctx context.Context, in *tree.CreateNodeRequest, opts ...grpc.CallOption) (*tree.CreateNodeResponse, error) {
+	if strings.Contains(in.GetNode().GetPath(), "fail") {
+		return nil, errors.Forbidden("create.forbidden", "cannot create "+in.GetNode().GetPath())
+	}
+	return &tree.CreateNodeResponse{Success: true, Node: in.Node}, nil
+}
+
+func (f *failingRouter) UpdateNode(ctx context.Context, in *tree.UpdateNodeRequest, opts ...grpc.CallOption) (*tree.UpdateNodeResponse, error) {
+	if strings.Contains(in.GetTo().GetPath(), "fail") {
+		return nil, errors.Forbidden("update.forbidden", "cannot move to "+in.GetTo().GetPath())
+	}
+	return &tree.UpdateNodeResponse{Success: true, Node: in.To}, nil
+}
+
+func (f *failingRouter) DeleteNode(ctx context.Context, in *tree.DeleteNodeRequest, opts ...grpc.CallOption) (*tree.DeleteNodeResponse, error) {
+	if strings.Contains(in.GetNode().GetPath(), "fail") {
+		return nil, errors.Forbidden("delete.forbidden", "cannot delete "+in.GetNode().GetPath())
+	}
+	return &tree.DeleteNodeResponse{Success: true}, nil
+}
+
+// mockStream feeds requests to a stream handler and records its responses
+type mockStream struct {
+	grpc.ServerStream
+	requests  []interface{}
+	responses []interface{}
+}
+
+func (m *mockStream) Context() context.Context {
+	return context.Background()
+}
+
+func (m *mockStream) next() (interface{}, error) {
+	if len(m.requests) == 0 {
+		return nil, io.EOF
+	}
+	r := m.requests[0]
+	m.requests = m.requests[1:]
+	return r, nil
+}
+
+type createStream struct{ *mockStream }
+
+func (s createStream) Recv() (*tree.CreateNodeRequest, error) {
+	r, e := s.next()
+	if e != nil {
+		return nil, e
+	}
+	return r.(*tree.CreateNodeRequest), nil
+}
+
+func (s createStream) Send(resp *tree.CreateNodeResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+type updateStream struct{ *mockStream }
+
+func (s updateStream) Recv() (*tree.UpdateNodeRequest, error) {
+	r, e := s.next()
+	if e != nil {
+		return nil, e
+	}
+	return r.(*tree.UpdateNodeRequest), nil
+}
+
+func (s updateStream) Send(resp *tree.UpdateNodeResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+type deleteStream struct{ *mockStream }
+
+func (s deleteStream) Recv() (*tree.DeleteNodeRequest, error) {
+	r, e := s.next()
+	if e != nil {
+		return nil, e
+	}
+	return r.(*tree.DeleteNodeRequest), nil
+}
+
+func (s deleteStream) Send(resp *tree.DeleteNodeResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func streamError(n *tree.Node) string {
+	return n.GetStringMeta(common.MetaFlagStreamError)
+}
+
+func TestTreeHandler_ReceiverStreams(t *testing.T) {
+
+	handler := &TreeHandler{router: &failingRouter{HandlerMock: nodes.NewHandlerMock()}}
+
+	Convey("CreateNodeStream reports per-item errors", t, func() {
+		s := createStream{&mockStream{requests: []interface{}{
+			&tree.CreateNodeRequest{Node: &tree.Node{Path: "ws/folder", Type: tree.NodeType_COLLECTION}},
+			&tree.CreateNodeRequest{Node: &tree.Node{Path: "ws/fail", Type: tree.NodeType_COLLECTION}},
+			&tree.CreateNodeRequest{},
+			&tree.CreateNodeRequest{Node: &tree.Node{Path: "ws/other", Type: tree.NodeType_COLLECTION}},
+		}}}
+		So(handler.CreateNodeStream(s), ShouldBeNil)
+		So(s.responses, ShouldHaveLength, 4)
+		r0, r1, r2, r3 := s.responses[0].(*tree.CreateNodeResponse), s.responses[1].(*tree.CreateNodeResponse), s.responses[2].(*tree.CreateNodeResponse), s.responses[3].(*tree.CreateNodeResponse)
+		So(r0.Success, ShouldBeTrue)
+		So(streamError(r0.Node), ShouldBeEmpty)
+		So(r1.Success, ShouldBeFalse)
+		So(r1.Node.Path, ShouldEqual, "ws/fail")
+		So(streamError(r1.Node), ShouldContainSubstring, "cannot create ws/fail")
+		So(r2.Success, ShouldBeFalse)
+		So(streamError(r2.Node), ShouldContainSubstring, "must provide a node")
+		So(r3.Success, ShouldBeTrue)
+	})
+
+	Convey("UpdateNodeStream reports per-item errors", t, func() {
+		s := updateStream{&mockStream{requests: []interface{}{
+			&tree.UpdateNodeRequest{From: &tree.Node{Path: "ws/a"}, To: &tree.Node{Path: "ws/b"}},
+			&tree.UpdateNodeRequest{From: &tree.Node{Path: "ws/c"}, To: &tree.Node{Path: "ws/fail"}},
+			&tree.UpdateNodeRequest{From: &tree.Node{Path: "ws/d"}},
+		}}}
+		So(handler.UpdateNodeStream(s), ShouldBeNil)
+		So(s.responses, ShouldHaveLength, 3)
+		r0, r1, r2 := s.responses[0].(*tree.UpdateNodeResponse), s.responses[1].(*tree.UpdateNodeResponse), s.responses[2].(*tree.UpdateNodeResponse)
+		So(r0.Success, ShouldBeTrue)
+		So(r0.Node.Path, ShouldEqual, "ws/b")
+		So(r1.Success, ShouldBeFalse)
+		So(r1.Node.Path, ShouldEqual, "ws/c")
+		So(streamError(r1.Node), ShouldContainSubstring, "cannot move to ws/fail")
+		So(r2.Success, ShouldBeFalse)
+		So(r2.Node.Path, ShouldEqual, "ws/d")
+		So(streamError(r2.Node), ShouldContainSubstring, "both From and To")
+	})
+
+	Convey("DeleteNodeStream reports per-item errors", t, func() {
+		s := deleteStream{&mockStream{requests: []interface{}{
+			&tree.DeleteNodeRequest{Node: &tree.Node{Path: "ws/a"}},
+			&tree.DeleteNodeRequest{Node: &tree.Node{Path: "ws/fail"}},
+			&tree.DeleteNodeRequest{},
+		}}}
+		So(handler.DeleteNodeStream(s), ShouldBeNil)
+		So(s.responses, ShouldHaveLength, 3)
+		r0, r1, r2 := s.responses[0].(*tree.DeleteNodeResponse), s.responses[1].(*tree.DeleteNodeResponse), s.responses[2].(*tree.DeleteNodeResponse)
+		So(r0.Success, ShouldBeTrue)
+		So(r0.Node.Path, ShouldEqual, "ws/a")
+		So(streamError(r0.Node), ShouldBeEmpty)
+		So(r1.Success, ShouldBeFalse)
+		So(r1.Node.Path, ShouldEqual, "ws/fail")
+		So(streamError(r1.Node), ShouldContainSubstring, "cannot delete ws/fail")
+		So(r2.Success, ShouldBeFalse)
+		So(streamError(r2.Node), ShouldContainSubstring, "must provide a node")
+	})
+
+}
@@ -7,19 +7,24 @@ require (
 	cloud.google.com/go/storage v1.18.2 // indirect
 	github.com/ajvb/kala v0.8.4
 	github.com/allegro/bigcache/v3 v3.0.1
+	github.com/beevik/etree v1.1.0
 	github.com/beevik/ntp v0.3.0
 	github.com/bep/debounce v1.2.0 // indirect
 	github.com/blevesearch/bleve/v2 v2.3.0
+	github.com/bodgit/sevenzip v1.3.0
 	github.com/caddyserver/caddy/v2 v2.4.6
+	github.com/coreos/go-oidc v2.2.1+incompatible
 	github.com/cskr/pubsub v1.0.2
 	github.com/disintegration/imaging v1.6.2
 	github.com/docker/docker v20.10.12+incompatible // indirect
 	github.com/doug-martin/goqu/v9 v9.18.0
+	github.com/dsnet/compress v0.0.1
 	github.com/dustin/go-humanize v1.0.1-0.20200219035652-afde56e7acac
 	github.com/emicklei/go-restful/v3 v3.7.3
 	github.com/fatih/color v1.13.0
 	github.com/fsnotify/fsnotify v1.5.1
 	github.com/ghodss/yaml v1.0.0
+	github.com/go-ldap/ldap/v3 v3.2.4
 	github.com/go-openapi/errors v0.20.2
 	github.com/go-openapi/loads v0.21.1
 	github.com/go-openapi/runtime v0.22.0
@@ -48,8 +53,10 @@ require (
 	github.com/json-iterator/go v1.1.12
 	github.com/kardianos/osext v0.0.0-20190222173326-2bc1f35cddc0
 	github.com/karrick/godirwalk v1.16.1
-	github.com/krolaw/zipstream v0.0.0-20180621105154-0a2661891f94
+	github.com/klauspost/compress v1.15.9
 	github.com/kylelemons/godebug v1.1.0
+	github.com/ledongthuc/pdf v0.0.0-20220302134840-0c2507a12d80
+	github.com/lib/pq v1.10.4
 	github.com/livekit/protocol v0.11.11
 	github.com/lpar/gzipped v1.1.0
 	github.com/lucas-clemente/quic-go v0.24.0 // indirect
@@ -72,10 +79,13 @@ require (
 	github.com/patrickmn/go-cache v2.1.0+incompatible
 	github.com/philopon/go-toposort v0.0.0-20170620085441-9be86dbd762f
 	github.com/pkg/errors v0.9.1
+	github.com/pkg/sftp v1.13.4
+	github.com/pquerna/cachecontrol v0.0.0-20200921180117-858c6e7e6b7e // indirect
 	github.com/pydio/go v0.0.0-20191211170306-d00ac19450ef
 	github.com/pydio/melody v0.0.0-20190928133520-4271c6513fb6
 	github.com/pydio/pydio-sdk-go v0.0.0-20190116153840-23ce5c39e65c
 	github.com/rjeczalik/notify v0.9.2
+	github.com/russellhaering/goxmldsig v1.2.0
 	github.com/robertkrimen/otto v0.0.0-20211024170158-b87d35c0b86f
 	github.com/rs/cors v1.8.2
 	github.com/rs/xid v1.3.0
@@ -93,10 +103,11 @@ require (
 	github.com/spf13/cobra v1.3.0
 	github.com/spf13/pflag v1.0.5
 	github.com/spf13/viper v1.10.1
-	github.com/stretchr/testify v1.7.0
+	github.com/stretchr/testify v1.7.1
 	github.com/tomwright/dasel v1.24.1
 	github.com/twmb/murmur3 v1.1.6 // indirect
 	github.com/uber-go/tally/v4 v4.1.1
+	github.com/ulikunitz/xz v0.5.10
 	github.com/yudai/gojsondiff v1.0.0
 	github.com/yudai/golcs v0.0.0-20170316035057-ecda9a501e82 // indirect
 	github.com/yudai/pp v2.0.1+incompatible // indirect
@@ -124,6 +135,7 @@ require (
 	gopkg.in/gomail.v2 v2.0.0-20160411212932-81ebce5c23df
 	gopkg.in/gorp.v1 v1.7.2
 	gopkg.in/natefinch/lumberjack.v2 v2.0.0
+	gopkg.in/square/go-jose.v2 v2.6.0
 	gopkg.in/yaml.v3
 	k8s.io/apimachinery v0.21.1
 	k8s.io/klog/v2 v2.30.0 // indirect
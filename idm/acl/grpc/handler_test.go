@@ -31,6 +31,7 @@ import (
 
 	"github.com/pydio/cells/v4/common/dao"
 	"github.com/pydio/cells/v4/common/dao/sqlite"
+	"github.com/pydio/cells/v4/common/dao/test"
 	"github.com/pydio/cells/v4/common/proto/idm"
 	service "github.com/pydio/cells/v4/common/proto/service"
 	"github.com/pydio/cells/v4/common/utils/configx"
@@ -47,7 +48,8 @@ var (
 
 func TestMain(m *testing.M) {
 
-	d, e := dao.InitDAO(sqlite.Driver, sqlite.SharedMemDSN, "test_", acl.NewDAO, options)
+	driver, dsn := test.SQLDriver(sqlite.Driver, sqlite.SharedMemDSN)
+	d, e := dao.InitDAO(driver, dsn, "test_", acl.NewDAO, options)
 	if e != nil {
 		panic(e)
 	}
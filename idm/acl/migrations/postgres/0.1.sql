@@ -0,0 +1,43 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS idm_acl_nodes (
+    id           BIGSERIAL PRIMARY KEY,
+    uuid         VARCHAR(500) NOT NULL,
+    UNIQUE(uuid)
+);
+
+CREATE TABLE IF NOT EXISTS idm_acl_roles (
+    id           BIGSERIAL PRIMARY KEY,
+    uuid         VARCHAR(500) NOT NULL,
+    UNIQUE(uuid)
+);
+
+CREATE TABLE IF NOT EXISTS idm_acl_workspaces (
+    id           BIGSERIAL PRIMARY KEY,
+    name         VARCHAR(500) NOT NULL,
+    UNIQUE(name)
+);
+
+CREATE TABLE IF NOT EXISTS idm_acls (
+    id           BIGSERIAL PRIMARY KEY,
+    action_name  VARCHAR(500),
+    action_value VARCHAR(500),
+    role_id      BIGINT NOT NULL DEFAULT 0,
+    node_id      BIGINT NOT NULL DEFAULT 0,
+    workspace_id BIGINT NOT NULL DEFAULT 0,
+    created_at   TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    expires_at   TIMESTAMP NULL DEFAULT NULL,
+    CONSTRAINT acl_f1 FOREIGN KEY (node_id) REFERENCES idm_acl_nodes(id),
+    CONSTRAINT acl_f2 FOREIGN KEY (workspace_id) REFERENCES idm_acl_workspaces(id),
+    CONSTRAINT acl_f3 FOREIGN KEY (role_id) REFERENCES idm_acl_roles(id),
+    CONSTRAINT acls_u1 UNIQUE(node_id, action_name, role_id, workspace_id)
+);
+
+INSERT INTO idm_acl_workspaces (id, name) VALUES (-1, '') ON CONFLICT DO NOTHING;
+INSERT INTO idm_acl_nodes (id, uuid) VALUES (-1, '') ON CONFLICT DO NOTHING;
+INSERT INTO idm_acl_roles (id, uuid) VALUES (-1, '') ON CONFLICT DO NOTHING;
+
+-- +migrate Down
+DROP TABLE idm_acls;
+DROP TABLE idm_acl_nodes;
+DROP TABLE idm_acl_roles;
+DROP TABLE idm_acl_workspaces;
@@ -29,6 +29,7 @@ import (
 
 	goqu "github.com/doug-martin/goqu/v9"
 	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
 	migrate "github.com/rubenv/sql-migrate"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
@@ -57,6 +58,12 @@ var (
 		"CleanRoles":              `DELETE FROM idm_acl_roles WHERE id != -1 and id NOT IN (select distinct(role_id) from idm_acls)`,
 		"CleanNodes":              `DELETE FROM idm_acl_nodes WHERE id != -1 and id NOT IN (select distinct(node_id) from idm_acls)`,
 		"CleanDuplicateIfExpired": `DELETE FROM idm_acls WHERE action_name=? AND role_id=? AND workspace_id=? AND node_id=? AND expires_at IS NOT NULL AND expires_at < ?`,
+
+		// Postgres does not support LastInsertId, generated ids are read from a RETURNING clause instead
+		"AddACL-postgres":          `insert into idm_acls (action_name, action_value, role_id, workspace_id, node_id) values (?, ?, ?, ?, ?) returning id`,
+		"AddACLNode-postgres":      `insert into idm_acl_nodes (uuid) values (?) on conflict do nothing returning id`,
+		"AddACLRole-postgres":      `insert into idm_acl_roles (uuid) values (?) on conflict do nothing returning id`,
+		"AddACLWorkspace-postgres": `insert into idm_acl_workspaces (name) values (?) on conflict do nothing returning id`,
 	}
 )
 
@@ -142,14 +149,9 @@ func (dao *sqlimpl) addWithDupCheck(in interface{}, check bool) error {
 	log.Logger(context.Background()).Debug("AddACL",
 		zap.String("r", roleID), zap.String("w", workspaceID), zap.String("n", nodeID), zap.Any("value", val))
 
-	stmt, er := dao.GetStmt("AddACL")
-	if er != nil {
-		return er
-	}
-
-	res, err := stmt.Exec(val.Action.Name, val.Action.Value, roleID, workspaceID, nodeID)
+	id, _, err := dao.insertWithID("AddACL", val.Action.Name, val.Action.Value, roleID, workspaceID, nodeID)
 	if err != nil {
-		if mErr, ok := err.(*mysql.MySQLError); ok && mErr.Number == 1062 && check {
+		if isDuplicateError(err) && check {
 			// fmt.Println("GOT DUPLICATE ERROR", mErr.Error(), mErr.Message)
 			// There is a duplicate : if it is expired, we can safely ignore it and replace it
 			deleteStmt, dE := dao.GetStmt("CleanDuplicateIfExpired")
@@ -168,11 +170,6 @@ func (dao *sqlimpl) addWithDupCheck(in interface{}, check bool) error {
 		return err
 	}
 
-	id, err := res.LastInsertId()
-	if err != nil {
-		return err
-	}
-
 	val.ID = fmt.Sprintf("%d", id)
 
 	return nil
@@ -329,29 +326,12 @@ func (dao *sqlimpl) Del(query sql.Enquirer) (int64, error) {
 
 func (dao *sqlimpl) addWorkspace(uuid string) (string, error) {
 
-	stmt, er := dao.GetStmt("AddACLWorkspace")
-	if er != nil {
-		return "", er
-	}
-	res, err := stmt.Exec(uuid)
-	if err == nil {
-		rows, err := res.RowsAffected()
-		if err != nil {
-			return "", err
-		}
-
-		if rows > 0 {
-			id, err := res.LastInsertId()
-			if err != nil {
-				return "", err
-			}
-
-			return fmt.Sprintf("%d", id), nil
-		}
+	if id, inserted, err := dao.insertWithID("AddACLWorkspace", uuid); err == nil && inserted {
+		return fmt.Sprintf("%d", id), nil
 	}
 
 	var id string
-	stmt, er = dao.GetStmt("GetACLWorkspace")
+	stmt, er := dao.GetStmt("GetACLWorkspace")
 	if er != nil {
 		return "", er
 	}
@@ -367,31 +347,14 @@ func (dao *sqlimpl) addWorkspace(uuid string) (string, error) {
 
 func (dao *sqlimpl) addNode(uuid string) (string, error) {
 
-	stmt, er := dao.GetStmt("AddACLNode")
-	if er != nil {
-		return "", er
-	}
-	res, err := stmt.Exec(uuid)
-	if err == nil {
-		rows, err := res.RowsAffected()
-		if err != nil {
-			return "", err
-		}
-
-		if rows > 0 {
-			id, err := res.LastInsertId()
-			if err != nil {
-				return "", err
-			}
-
-			return fmt.Sprintf("%d", id), nil
-		}
+	if id, inserted, err := dao.insertWithID("AddACLNode", uuid); err == nil && inserted {
+		return fmt.Sprintf("%d", id), nil
 	}
 
 	// Checking we didn't have a duplicate
 	var id string
 
-	stmt, er = dao.GetStmt("GetACLNode")
+	stmt, er := dao.GetStmt("GetACLNode")
 	if er != nil {
 		return "", er
 	}
@@ -406,31 +369,13 @@ func (dao *sqlimpl) addNode(uuid string) (string, error) {
 
 func (dao *sqlimpl) addRole(uuid string) (string, error) {
 
-	stmt, er := dao.GetStmt("AddACLRole")
-	if er != nil {
-		return "", er
-	}
-
-	res, err := stmt.Exec(uuid)
-	if err == nil {
-		rows, err := res.RowsAffected()
-		if err != nil {
-			return "", err
-		}
-
-		if rows > 0 {
-			id, err := res.LastInsertId()
-			if err != nil {
-				return "", err
-			}
-
-			return fmt.Sprintf("%d", id), nil
-		}
+	if id, inserted, err := dao.insertWithID("AddACLRole", uuid); err == nil && inserted {
+		return fmt.Sprintf("%d", id), nil
 	}
 
 	// Checking we didn't have a duplicate
 	var id string
-	stmt, er = dao.GetStmt("GetACLRole")
+	stmt, er := dao.GetStmt("GetACLRole")
 	if er != nil {
 		return "", er
 	}
@@ -443,6 +388,53 @@ func (dao *sqlimpl) addRole(uuid string) (string, error) {
 	return id, er
 }
 
+// insertWithID runs the insert statement registered under key and returns the generated id.
+// The inserted flag is false when the insert was ignored and no row was created.
+func (dao *sqlimpl) insertWithID(key string, args ...interface{}) (id int64, inserted bool, err error) {
+
+	if dao.Driver() == "postgres" {
+		stmt, er := dao.GetStmt(key + "-postgres")
+		if er != nil {
+			return 0, false, er
+		}
+		if err = stmt.QueryRow(args...).Scan(&id); err == sql.ErrNoRows {
+			return 0, false, nil
+		} else if err != nil {
+			return 0, false, err
+		}
+		return id, true, nil
+	}
+
+	stmt, er := dao.GetStmt(key)
+	if er != nil {
+		return 0, false, er
+	}
+	res, err := stmt.Exec(args...)
+	if err != nil {
+		return 0, false, err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil || rows == 0 {
+		return 0, false, err
+	}
+	id, err = res.LastInsertId()
+	if err != nil {
+		return 0, false, err
+	}
+	return id, true, nil
+}
+
+// isDuplicateError checks if err is a unique constraint violation reported by the driver
+func isDuplicateError(err error) bool {
+	if mErr, ok := err.(*mysql.MySQLError); ok {
+		return mErr.Number == 1062
+	}
+	if pErr, ok := err.(*pq.Error); ok {
+		return pErr.Code == "23505"
+	}
+	return false
+}
+
 type queryConverter idm.ACLSingleQuery
 
 func (c *queryConverter) Convert(val *anypb.Any, driver string) (goqu.Expression, bool) {
@@ -25,6 +25,7 @@ import (
 	"context"
 
 	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
 	"google.golang.org/grpc"
 
 	"github.com/pydio/cells/v4/common"
@@ -100,6 +101,10 @@ func defaultMetas(ctx context.Context) error {
 		},
 	})
 
+	if pe, ok := err.(*pq.Error); ok && pe.Code == "23505" {
+		// This is a duplicate error, we ignore it
+		return nil
+	}
 	me, ok := err.(*mysql.MySQLError)
 	if !ok {
 		return err
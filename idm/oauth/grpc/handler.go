@@ -474,7 +474,7 @@ func (h *Handler) Verify(ctx context.Context, in *pauth.VerifyTokenRequest) (*pa
 
 // PasswordCredentialsToken validates the login information and generates a token
 func (h *Handler) PasswordCredentialsToken(ctx context.Context, in *pauth.PasswordCredentialsTokenRequest) (*pauth.PasswordCredentialsTokenResponse, error) {
-	token, err := auth.LocalJWTVerifier().PasswordCredentialsToken(ctx, in.Username, in.Password)
+	token, err := auth.LocalJWTVerifier().PasswordCredentialsToken(auth.WithMfaCode(ctx, in.MfaCode), in.Username, in.Password)
 	if err != nil {
 		return nil, err
 	}
@@ -26,7 +26,9 @@ import (
 	log2 "github.com/pydio/cells/v4/common/log"
 	"log"
 
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/pydio/cells/v4/common"
 	"github.com/pydio/cells/v4/common/auth"
@@ -115,9 +117,10 @@ func init() {
 
 		auth.OnConfigurationInit(func(scanner common.Scanner) {
 			var m []struct {
-				ID   string
-				Name string
-				Type string
+				ID     string
+				Name   string
+				Type   string
+				Config map[string]interface{}
 			}
 
 			if err := scanner.Scan(&m); err != nil {
@@ -128,7 +131,15 @@ func init() {
 				if mm.Type == "pydio" {
 					// Registering the first connector
 					auth.RegisterConnector(mm.ID, mm.Name, mm.Type, nil)
+					continue
 				}
+				// Other connectors receive their own configuration
+				data, err := structpb.NewStruct(mm.Config)
+				if err != nil {
+					log2.Logger(ctx).Error("Cannot read connector configuration "+mm.ID, zap.Error(err))
+					continue
+				}
+				auth.RegisterConnector(mm.ID, mm.Name, mm.Type, data)
 			}
 		})
 
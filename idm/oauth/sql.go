@@ -57,6 +57,9 @@ var (
 		// Sqlite does not support CONCAT and SHA2 functions
 		"insert-sqlite":     `INSERT INTO idm_personal_tokens VALUES (?,?,?,?,?,?,?,?,?,?,?,?)`,
 		"validToken-sqlite": `SELECT * FROM idm_personal_tokens WHERE access_token=? AND expire_at > ? LIMIT 0,1`,
+		// Postgres hashes with sha256() and only supports the LIMIT n syntax
+		"insert-postgres":     `INSERT INTO idm_personal_tokens VALUES (?,'sha256:' || encode(sha256(convert_to(?, 'UTF8')), 'hex'),?,?,?,?,?,?,?,?,?,?)`,
+		"validToken-postgres": `SELECT * FROM idm_personal_tokens WHERE access_token='sha256:' || encode(sha256(convert_to(?, 'UTF8')), 'hex') AND expire_at > ? LIMIT 1`,
 	}
 )
 
@@ -112,6 +115,8 @@ func (s *sqlImpl) Load(accessToken string) (*auth.PersonalAccessToken, error) {
 	key := "validToken"
 	if s.Driver() == "sqlite3" {
 		key = "validToken-sqlite"
+	} else if s.Driver() == "postgres" {
+		key = "validToken-postgres"
 	}
 
 	stmt, er := s.GetStmt(key)
@@ -144,6 +149,8 @@ func (s *sqlImpl) Store(accessToken string, token *auth.PersonalAccessToken, upd
 		insertKey := "insert"
 		if s.Driver() == "sqlite3" {
 			insertKey = "insert-sqlite"
+		} else if s.Driver() == "postgres" {
+			insertKey = "insert-postgres"
 		}
 		insertStmt, er := s.GetStmt(insertKey)
 		if er != nil {
@@ -0,0 +1,252 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package web
+
+import (
+	"context"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v4/common/auth"
+	"github.com/pydio/cells/v4/common/auth/claim"
+	"github.com/pydio/cells/v4/common/auth/hydra"
+	"github.com/pydio/cells/v4/common/config"
+	"github.com/pydio/cells/v4/common/log"
+	serverhttp "github.com/pydio/cells/v4/common/server/http"
+	"github.com/pydio/cells/v4/common/server/middleware"
+	"github.com/pydio/cells/v4/common/utils/uuid"
+)
+
+const (
+	connectorsPrefix      = "/connectors/"
+	connectorsStateCookie = "pydio_connector_state"
+)
+
+// samlPOSTForm auto-submits the AuthnRequest to the IdP, as specified by the HTTP-POST binding.
+var samlPOSTForm = template.Must(template.New("saml").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Redirecting...</title></head>
+<body onload="document.forms[0].submit()">
+<form method="post" action="{{ .URL }}">
+<input type="hidden" name="SAMLRequest" value="{{ .SAMLRequest }}"/>
+<input type="hidden" name="RelayState" value="{{ .RelayState }}"/>
+<noscript><input type="submit" value="Continue"/></noscript>
+</form>
+</body>
+</html>`))
+
+// ConnectorsRouter serves the login and callback routes of CallbackConnectors and SAMLConnectors. The hydra
+// login challenge is used as state (or RelayState), and bound to the browser with a short-lived cookie.
+// Requests contexts are wrapped with the runtime ClientConn and Registry, used to synchronize local users.
+func ConnectorsRouter(ctx context.Context) http.Handler {
+	r := mux.NewRouter()
+	r.HandleFunc(connectorsPrefix+"{id}/login", connectorLogin).Methods(http.MethodGet)
+	r.HandleFunc(connectorsPrefix+"{id}/callback", connectorCallback).Methods(http.MethodGet)
+	r.HandleFunc(connectorsPrefix+"{id}/callback", connectorSAMLCallback).Methods(http.MethodPost)
+	var h http.Handler = r
+	h = serverhttp.ContextMiddlewareHandler(middleware.ClientConnIncomingContext(ctx))(h)
+	h = serverhttp.ContextMiddlewareHandler(middleware.RegistryIncomingContext(ctx))(h)
+	return h
+}
+
+func findConnector(id string) (auth.Connector, bool) {
+	for _, c := range auth.GetConnectors() {
+		if c.ID() == id {
+			return c.Conn(), true
+		}
+	}
+	return nil, false
+}
+
+func connectorLogin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := mux.Vars(r)["id"]
+	c, _ := findConnector(id)
+	cc, isCallback := c.(auth.CallbackConnector)
+	sc, isSAML := c.(auth.SAMLConnector)
+	if !isCallback && !isSAML {
+		http.Error(w, "unknown connector", http.StatusNotFound)
+		return
+	}
+
+	challenge := r.URL.Query().Get("login_challenge")
+	if challenge == "" {
+		c, err := hydra.CreateLogin(ctx, config.DefaultOAuthClientID, []string{"openid", "profile", "offline"}, []string{})
+		if err != nil {
+			log.Logger(ctx).Error("Cannot create login challenge", zap.Error(err))
+			http.Error(w, "cannot create login", http.StatusInternalServerError)
+			return
+		}
+		challenge = c.Challenge
+	}
+
+	if isSAML {
+		// Request ID must be a valid xsd:ID, thus cannot start with a digit
+		requestID := "_" + uuid.New()
+		ssoURL, samlRequest, err := sc.POSTData(auth.Scopes{}, requestID)
+		if err != nil {
+			log.Logger(ctx).Error("Cannot build SAML request", zap.String("connector", id), zap.Error(err))
+			http.Error(w, "connector is not available", http.StatusBadGateway)
+			return
+		}
+		setConnectorState(w, r, challenge+"|"+requestID, true)
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_ = samlPOSTForm.Execute(w, map[string]string{
+			"URL":         ssoURL,
+			"SAMLRequest": samlRequest,
+			"RelayState":  challenge,
+		})
+		return
+	}
+
+	loginURL, err := cc.LoginURL(auth.Scopes{}, auth.ConnectorCallbackURL(r, id), challenge)
+	if err != nil {
+		log.Logger(ctx).Error("Cannot compute connector login URL", zap.String("connector", id), zap.Error(err))
+		http.Error(w, "connector is not available", http.StatusBadGateway)
+		return
+	}
+
+	setConnectorState(w, r, challenge, false)
+	http.Redirect(w, r, loginURL, http.StatusFound)
+}
+
+// setConnectorState stores the state in a cookie. As IdPs POST SAML responses from their own site,
+// the cookie must be sent cross-site in that case, which browsers only allow for secure cookies.
+func setConnectorState(w http.ResponseWriter, r *http.Request, value string, crossSite bool) {
+	secure := r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https"
+	sameSite := http.SameSiteLaxMode
+	if crossSite && secure {
+		sameSite = http.SameSiteNoneMode
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     connectorsStateCookie,
+		Value:    value,
+		Path:     "/oidc" + connectorsPrefix,
+		MaxAge:   600,
+		HttpOnly: true,
+		Secure:   secure,
+		SameSite: sameSite,
+	})
+}
+
+// popConnectorState reads and clears the state cookie.
+func popConnectorState(w http.ResponseWriter, r *http.Request) string {
+	cookie, e := r.Cookie(connectorsStateCookie)
+	if e != nil {
+		return ""
+	}
+	http.SetCookie(w, &http.Cookie{Name: connectorsStateCookie, Path: "/oidc" + connectorsPrefix, MaxAge: -1})
+	return cookie.Value
+}
+
+func connectorCallback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := mux.Vars(r)["id"]
+	c, _ := findConnector(id)
+	cc, ok := c.(auth.CallbackConnector)
+	if !ok {
+		http.Error(w, "unknown connector", http.StatusNotFound)
+		return
+	}
+
+	challenge := r.URL.Query().Get("state")
+	if state := popConnectorState(w, r); challenge == "" || state != challenge {
+		http.Error(w, "invalid state", http.StatusForbidden)
+		return
+	}
+
+	identity, err := cc.HandleCallback(auth.Scopes{}, r)
+	if err != nil {
+		log.Logger(ctx).Error("Connector callback failed", zap.String("connector", id), zap.Error(err))
+		http.Error(w, "authentication failed", http.StatusUnauthorized)
+		return
+	}
+	acceptConnectorLogin(w, r, id, challenge, identity)
+}
+
+func connectorSAMLCallback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := mux.Vars(r)["id"]
+	c, _ := findConnector(id)
+	sc, ok := c.(auth.SAMLConnector)
+	if !ok {
+		http.Error(w, "unknown connector", http.StatusNotFound)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	challenge := r.PostFormValue("RelayState")
+	state := strings.SplitN(popConnectorState(w, r), "|", 2)
+	if challenge == "" || len(state) != 2 || state[0] != challenge {
+		http.Error(w, "invalid state", http.StatusForbidden)
+		return
+	}
+
+	identity, err := sc.HandlePOST(ctx, auth.Scopes{}, r.PostFormValue("SAMLResponse"), state[1])
+	if err != nil {
+		log.Logger(ctx).Error("SAML assertion rejected", zap.String("connector", id), zap.Error(err))
+		http.Error(w, "authentication failed", http.StatusUnauthorized)
+		return
+	}
+	acceptConnectorLogin(w, r, id, challenge, identity)
+}
+
+// acceptConnectorLogin accepts the hydra login challenge for the identity and redirects to the client with a code.
+func acceptConnectorLogin(w http.ResponseWriter, r *http.Request, id, challenge string, identity auth.Identity) {
+	ctx := r.Context()
+	code, err := auth.DefaultJWTVerifier().LoginChallengeCode(ctx, claim.Claims{
+		Subject: identity.UserID,
+		Name:    identity.Username,
+		Email:   identity.Email,
+	}, auth.SetChallenge(challenge))
+	if err != nil {
+		log.Logger(ctx).Error("Cannot accept login challenge", zap.String("connector", id), zap.Error(err))
+		http.Error(w, "authentication failed", http.StatusUnauthorized)
+		return
+	}
+
+	login, err := hydra.GetLogin(ctx, challenge)
+	if err != nil {
+		http.Error(w, "authentication failed", http.StatusUnauthorized)
+		return
+	}
+	requestURL, err := url.Parse(login.GetRequestURL())
+	if err != nil {
+		http.Error(w, "authentication failed", http.StatusUnauthorized)
+		return
+	}
+	requestURLValues := requestURL.Query()
+	redirectURL, err := auth.GetRedirectURIFromRequestValues(requestURLValues)
+	if err != nil {
+		http.Error(w, "authentication failed", http.StatusUnauthorized)
+		return
+	}
+
+	http.Redirect(w, r, redirectURL+"?code="+code+"&state="+requestURLValues.Get("state"), http.StatusFound)
+}
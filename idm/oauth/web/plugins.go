@@ -55,6 +55,8 @@ func init() {
 			service.Description("OAuth Provider"),
 			service.WithHTTP(func(ctx context.Context, serveMux server.HttpMux) error {
 				router := mux.NewRouter()
+				// Upstream connectors routes, registered before the hosts catch-all handlers
+				router.PathPrefix(connectorsPrefix).Handler(servicecontext.HttpWrapperMeta(ctx, ConnectorsRouter(ctx)))
 				hh := config.GetSitesAllowedURLs()
 				for _, u := range hh {
 					// fmt.Println("Registering router for host", u.String())
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package policy
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/ory/ladon"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/v4/common/dao"
+	"github.com/pydio/cells/v4/common/dao/sqlite"
+	"github.com/pydio/cells/v4/common/dao/test"
+	"github.com/pydio/cells/v4/common/proto/idm"
+	"github.com/pydio/cells/v4/common/utils/configx"
+)
+
+// Policies have no sqlite implementation, this DAO is only tested against postgres.
+func TestPostgresDAO(t *testing.T) {
+
+	if os.Getenv("CELLS_TEST_POSTGRES_DSN") == "" {
+		t.Skip("skipping test: CELLS_TEST_POSTGRES_DSN not defined")
+	}
+
+	driver, dsn := test.SQLDriver(sqlite.Driver, sqlite.SharedMemDSN)
+	d, e := dao.InitDAO(driver, dsn, "idm_policy", NewDAO, configx.New())
+	if e != nil {
+		t.Fatal(e)
+	}
+	mockDAO := d.(DAO)
+	ctx := context.Background()
+
+	Convey("Store, update, evaluate and delete a policy group", t, func() {
+
+		group, err := mockDAO.StorePolicyGroup(ctx, &idm.PolicyGroup{
+			Name: "Test Group",
+			Policies: []*idm.Policy{{
+				Description: "Allow alice",
+				Subjects:    []string{"user:alice"},
+				Resources:   []string{"rest:/a<.*>"},
+				Actions:     []string{"GET"},
+				Effect:      idm.PolicyEffect_allow,
+			}},
+		})
+		So(err, ShouldBeNil)
+		So(group.Uuid, ShouldNotBeEmpty)
+		So(group.Policies[0].Id, ShouldNotBeEmpty)
+
+		So(mockDAO.IsAllowed(&ladon.Request{Subject: "user:alice", Resource: "rest:/a/b", Action: "GET"}), ShouldBeNil)
+		So(mockDAO.IsAllowed(&ladon.Request{Subject: "user:bob", Resource: "rest:/a/b", Action: "GET"}), ShouldNotBeNil)
+
+		group.Name = "Renamed Group"
+		group.Policies = append(group.Policies, &idm.Policy{
+			Description: "Allow bob",
+			Subjects:    []string{"user:bob"},
+			Resources:   []string{"rest:/a<.*>"},
+			Actions:     []string{"GET"},
+			Effect:      idm.PolicyEffect_allow,
+		})
+		_, err = mockDAO.StorePolicyGroup(ctx, group)
+		So(err, ShouldBeNil)
+		So(mockDAO.IsAllowed(&ladon.Request{Subject: "user:bob", Resource: "rest:/a/b", Action: "GET"}), ShouldBeNil)
+
+		groups, err := mockDAO.ListPolicyGroups(ctx)
+		So(err, ShouldBeNil)
+		So(groups, ShouldHaveLength, 1)
+		So(groups[0].Name, ShouldEqual, "Renamed Group")
+		So(groups[0].Policies, ShouldHaveLength, 2)
+
+		So(mockDAO.DeletePolicyGroup(ctx, group), ShouldBeNil)
+		groups, err = mockDAO.ListPolicyGroups(ctx)
+		So(err, ShouldBeNil)
+		So(groups, ShouldBeEmpty)
+		So(mockDAO.IsAllowed(&ladon.Request{Subject: "user:alice", Resource: "rest:/a/b", Action: "GET"}), ShouldNotBeNil)
+	})
+}
@@ -74,6 +74,7 @@ var (
 						"rest:/frontend/plugins/<.*>",
 						"rest:/frontend/state",
 						"rest:/frontend/auth/state",
+						samlMetadataResource,
 						"rest:/frontend/login/connectors",
 					},
 					Actions: []string{"GET"},
@@ -87,6 +88,7 @@ var (
 					Actions:     []string{"POST"},
 					Effect:      ladon.AllowAccess,
 				}),
+				jobsWebhookPolicy,
 			},
 		},
 
@@ -800,3 +802,59 @@ func Upgrade399(ctx context.Context) error {
 	}
 	return nil
 }
+
+// samlMetadataResource opens the SAML service provider metadata to anonymous users.
+const samlMetadataResource = "rest:/frontend/auth/saml/<.+>"
+
+// jobsWebhookPolicy opens the jobs webhook endpoint, authorization is checked by the handler itself.
+var jobsWebhookPolicy = converter.LadonToProtoPolicy(&ladon.DefaultPolicy{
+	ID:          "jobs-webhook",
+	Description: "PolicyGroup.PublicAccess.Rule5",
+	Subjects:    []string{"profile:anon", "profile:standard", "profile:shared"},
+	Resources:   []string{"rest:/jobs/webhook/<.+>"},
+	Actions:     []string{"POST"},
+	Effect:      ladon.AllowAccess,
+})
+
+func Upgrade401(ctx context.Context) error {
+	dao := servicecontext.GetDAO(ctx).(DAO)
+	if dao == nil {
+		return fmt.Errorf("cannot find DAO for policies initialization")
+	}
+	groups, e := dao.ListPolicyGroups(ctx)
+	if e != nil {
+		return e
+	}
+	for _, group := range groups {
+		if group.Uuid == "public-access" {
+			var hasWebhook bool
+			for _, p := range group.Policies {
+				if p.Id == "frontend-state" {
+					if !containsString(p.Resources, samlMetadataResource) {
+						p.Resources = append(p.Resources, samlMetadataResource)
+					}
+				} else if p.Id == jobsWebhookPolicy.Id {
+					hasWebhook = true
+				}
+			}
+			if !hasWebhook {
+				group.Policies = append(group.Policies, jobsWebhookPolicy)
+			}
+			if _, er := dao.StorePolicyGroup(ctx, group); er != nil {
+				log.Logger(ctx).Error("could not update policy group "+group.Uuid, zap.Error(er))
+			} else {
+				log.Logger(ctx).Info("Updated policy group " + group.Uuid)
+			}
+		}
+	}
+	return nil
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
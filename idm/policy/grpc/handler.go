@@ -100,25 +100,32 @@ func (h *Handler) IsAllowed(ctx context.Context, request *idm.PolicyEngineReques
 	return response, nil
 }
 
+// ListPolicyGroups lists all policy groups. If request.Filter is set, only the group with this Uuid is returned.
 func (h *Handler) ListPolicyGroups(ctx context.Context, request *idm.ListPolicyGroupsRequest) (*idm.ListPolicyGroupsResponse, error) {
 
 	response := &idm.ListPolicyGroupsResponse{}
 
-	if groupsCacheValid {
-		response.PolicyGroups = groupsCache
-		response.Total = int32(len(groupsCache))
-		return response, nil
+	groups := groupsCache
+	if !groupsCacheValid {
+		var err error
+		if groups, err = h.dao.ListPolicyGroups(ctx); err != nil {
+			return nil, err
+		}
+		groupsCache = groups
+		groupsCacheValid = true
 	}
 
-	groups, err := h.dao.ListPolicyGroups(ctx)
-	if err != nil {
-		return nil, err
+	if request.GetFilter() != "" {
+		for _, g := range groups {
+			if g.GetUuid() == request.GetFilter() {
+				response.PolicyGroups = []*idm.PolicyGroup{g}
+				break
+			}
+		}
+	} else {
+		response.PolicyGroups = groups
 	}
-	response.PolicyGroups = groups
-	response.Total = int32(len(groups))
-
-	groupsCache = groups
-	groupsCacheValid = true
+	response.Total = int32(len(response.PolicyGroups))
 
 	return response, nil
 }
@@ -89,6 +89,10 @@ func init() {
 					TargetVersion: service.ValidVersion("3.9.99"),
 					Up:            policy.Upgrade399,
 				},
+				{
+					TargetVersion: service.ValidVersion("4.0.1"),
+					Up:            policy.Upgrade401,
+				},
 			}),
 			service.WithGRPC(func(ctx context.Context, server *grpc.Server) error {
 				handler := NewHandler(ctx, servicecontext.GetDAO(ctx).(policy.DAO))
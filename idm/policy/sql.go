@@ -55,12 +55,13 @@ var (
 	migrationsFS embed.FS
 
 	queries = map[string]string{
-		"upsertPolicyGroup": `INSERT INTO idm_policy_group (uuid,name,description,owner_uuid,resource_group,last_updated) VALUES (?,?,?,?,?,?) ON DUPLICATE KEY UPDATE name=?,description=?,owner_uuid=?,resource_group=?,last_updated=?`,
-		"deletePolicyGroup": `DELETE FROM idm_policy_group WHERE uuid=?`,
-		"insertRelPolicy":   `INSERT INTO idm_policy_rel (group_uuid,policy_id) VALUES (?,?)`,
-		"deleteRelPolicies": `DELETE FROM idm_policy_rel WHERE group_uuid=?`,
-		"listJoined":        `SELECT p.uuid,p.name,p.description,p.owner_uuid,p.resource_group,p.last_updated,r.policy_id FROM idm_policy_group as p,idm_policy_rel as r WHERE r.group_uuid=p.uuid`,
-		"listRelPolicies":   `SELECT policy_id FROM idm_policy_rel WHERE group_uuid=?`,
+		"upsertPolicyGroup":          `INSERT INTO idm_policy_group (uuid,name,description,owner_uuid,resource_group,last_updated) VALUES (?,?,?,?,?,?) ON DUPLICATE KEY UPDATE name=?,description=?,owner_uuid=?,resource_group=?,last_updated=?`,
+		"upsertPolicyGroup-postgres": `INSERT INTO idm_policy_group (uuid,name,description,owner_uuid,resource_group,last_updated) VALUES (?,?,?,?,?,?) ON CONFLICT (uuid) DO UPDATE SET name=?,description=?,owner_uuid=?,resource_group=?,last_updated=?`,
+		"deletePolicyGroup":          `DELETE FROM idm_policy_group WHERE uuid=?`,
+		"insertRelPolicy":            `INSERT INTO idm_policy_rel (group_uuid,policy_id) VALUES (?,?)`,
+		"deleteRelPolicies":          `DELETE FROM idm_policy_rel WHERE group_uuid=?`,
+		"listJoined":                 `SELECT p.uuid,p.name,p.description,p.owner_uuid,p.resource_group,p.last_updated,r.policy_id FROM idm_policy_group as p,idm_policy_rel as r WHERE r.group_uuid=p.uuid`,
+		"listRelPolicies":            `SELECT policy_id FROM idm_policy_rel WHERE group_uuid=?`,
 	}
 )
 
@@ -200,7 +201,11 @@ func (s *sqlimpl) StorePolicyGroup(ctx context.Context, group *idm.PolicyGroup)
 	// Insert Policy Group
 	now := int32(time.Now().Unix())
 
-	stmt, er := s.GetStmt("upsertPolicyGroup")
+	upsertKey := "upsertPolicyGroup"
+	if s.Driver() == "postgres" {
+		upsertKey = "upsertPolicyGroup-postgres"
+	}
+	stmt, er := s.GetStmt(upsertKey)
 	if er != nil {
 		return nil, er
 	}
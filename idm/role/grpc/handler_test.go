@@ -31,6 +31,7 @@ import (
 
 	"github.com/pydio/cells/v4/common/dao"
 	"github.com/pydio/cells/v4/common/dao/sqlite"
+	"github.com/pydio/cells/v4/common/dao/test"
 	"github.com/pydio/cells/v4/common/proto/idm"
 	service "github.com/pydio/cells/v4/common/proto/service"
 	"github.com/pydio/cells/v4/common/utils/configx"
@@ -50,7 +51,8 @@ func TestMain(m *testing.M) {
 	options.Val("exclusive").Set(true)
 	options.Val("prepare").Set(true)
 	// Instantiate and initialise the role DAO Mock
-	if d, e := dao.InitDAO(sqlite.Driver, sqlite.SharedMemDSN, "", role.NewDAO, options); e != nil {
+	driver, dsn := test.SQLDriver(sqlite.Driver, sqlite.SharedMemDSN)
+	if d, e := dao.InitDAO(driver, dsn, "", role.NewDAO, options); e != nil {
 		panic(e)
 	} else {
 		roleDAO = d.(role.DAO)
@@ -0,0 +1,15 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS idm_roles (
+    uuid 			VARCHAR(255) NOT NULL,
+    label 			VARCHAR(500) NOT NULL,
+    team_role 		BOOLEAN,
+    group_role 		BOOLEAN,
+    user_role 		BOOLEAN,
+    last_updated 	INT,
+    auto_applies 	VARCHAR(500),
+    override 		BOOLEAN,
+    PRIMARY KEY (uuid)
+);
+
+-- +migrate Down
+DROP TABLE idm_roles;
@@ -280,23 +280,23 @@ func (c *queryBuilder) Convert(val *anypb.Any, driver string) (goqu.Expression,
 	}
 	if q.IsGroupRole {
 		if q.Not {
-			expressions = append(expressions, goqu.C("group_role").Eq(0))
+			expressions = append(expressions, goqu.C("group_role").Eq(false))
 		} else {
-			expressions = append(expressions, goqu.C("group_role").Eq(1))
+			expressions = append(expressions, goqu.C("group_role").Eq(true))
 		}
 	}
 	if q.IsUserRole {
 		if q.Not {
-			expressions = append(expressions, goqu.C("user_role").Eq(0))
+			expressions = append(expressions, goqu.C("user_role").Eq(false))
 		} else {
-			expressions = append(expressions, goqu.C("user_role").Eq(1))
+			expressions = append(expressions, goqu.C("user_role").Eq(true))
 		}
 	}
 	if q.IsTeam {
 		if q.Not {
-			expressions = append(expressions, goqu.C("team_role").Eq(0))
+			expressions = append(expressions, goqu.C("team_role").Eq(false))
 		} else {
-			expressions = append(expressions, goqu.C("team_role").Eq(1))
+			expressions = append(expressions, goqu.C("team_role").Eq(true))
 		}
 	}
 	if q.HasAutoApply {
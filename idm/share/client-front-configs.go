@@ -70,6 +70,9 @@ func (sc *Client) CheckLinkOptionsAgainstConfigs(ctx context.Context, link *rest
 	if options.MaxExpiration > 0 && (link.AccessEnd == 0 || (link.AccessEnd-time.Now().Unix()) > int64(options.MaxExpiration*24*60*60)) {
 		return options, errors.Forbidden("link.max-expiration.mandatory", "Please set a maximum expiration date for links")
 	}
+	if link.AccessStart > 0 && link.AccessEnd > 0 && link.AccessStart >= link.AccessEnd {
+		return options, errors.BadRequest("link.access-start.invalid", "Link start date must be before its expiration date")
+	}
 	if options.ShareForcePassword && !link.PasswordRequired {
 		return options, errors.Forbidden("link.password.required", "Share links must use a password")
 	}
@@ -49,6 +49,7 @@ func (sc *Client) StoreHashDocument(ctx context.Context, ownerUser *idm.User, li
 		OwnerId:       ownerUser.Login,
 		TemplateName:  link.ViewTemplateName,
 		RepositoryId:  link.Uuid,
+		StartTime:     link.AccessStart,
 		ExpireTime:    link.AccessEnd,
 		DownloadLimit: link.MaxDownloads,
 		ShareType:     "minisite",
@@ -137,6 +138,7 @@ func (sc *Client) LoadHashDocumentData(ctx context.Context, shareLink *rest.Shar
 	var linkData *docstore.ShareDocument
 	if err := json.Unmarshal([]byte(linkDoc.Data), &linkData); err == nil {
 		shareLink.ViewTemplateName = linkData.TemplateName
+		shareLink.AccessStart = linkData.StartTime
 		shareLink.AccessEnd = linkData.ExpireTime
 		shareLink.MaxDownloads = linkData.DownloadLimit
 		shareLink.CurrentDownloads = linkData.DownloadCount
@@ -182,16 +184,23 @@ func (sc *Client) LoadHashDocumentData(ctx context.Context, shareLink *rest.Shar
 		}
 	}
 
-	shareLink.LinkUrl = path.Join(config.GetPublicBaseUri(), shareLink.LinkHash)
+	shareLink.LinkUrl = linkUrl(shareLink.LinkHash)
+
+	return nil
+
+}
+
+// linkUrl computes the public URL of a link from its hash. It is relative unless a base URL is
+// configured for the share service.
+func linkUrl(hash string) string {
+	u := path.Join(config.GetPublicBaseUri(), hash)
 	if configBase := config.Get("services", common.ServiceRestNamespace_+common.ServiceShare, "url").String(); configBase != "" {
 		if cfu, e := url.Parse(configBase); e == nil {
-			cfu.Path = path.Join(config.GetPublicBaseUri(), shareLink.LinkHash)
-			shareLink.LinkUrl = cfu.String()
+			cfu.Path = path.Join(config.GetPublicBaseUri(), hash)
+			u = cfu.String()
 		}
 	}
-
-	return nil
-
+	return u
 }
 
 // DeleteHashDocument removes link data from the storage.
@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pydio/cells/v4/common/client/grpc"
 
@@ -120,7 +121,8 @@ func (sc *Client) GetOrCreateHiddenUser(ctx context.Context, ownerUser *idm.User
 }
 
 // UpdateACLsForHiddenUser deletes and replaces access ACLs for a hidden user.
-func (sc *Client) UpdateACLsForHiddenUser(ctx context.Context, roleId string, workspaceId string, rootNodes []*tree.Node, permissions []rest.ShareLinkAccessType, parentPolicy string, update bool) error {
+// If accessStart is in the future, access is granted through a policy enforcing this start date.
+func (sc *Client) UpdateACLsForHiddenUser(ctx context.Context, roleId string, workspaceId string, rootNodes []*tree.Node, permissions []rest.ShareLinkAccessType, parentPolicy string, accessStart int64, update bool) error {
 
 	HasRead := false
 	HasWrite := false
@@ -143,6 +145,12 @@ func (sc *Client) UpdateACLsForHiddenUser(ctx context.Context, roleId string, wo
 		}
 	}
 
+	if update {
+		if e := sc.DeleteStartDatePolicy(ctx, workspaceId); e != nil {
+			return e
+		}
+	}
+
 	if !HasRead && !HasWrite {
 		return nil
 	}
@@ -151,8 +159,24 @@ func (sc *Client) UpdateACLsForHiddenUser(ctx context.Context, roleId string, wo
 	if err != nil {
 		return err
 	}
+	var startPolicy string
+	if accessStart > time.Now().Unix() {
+		if startPolicy, err = sc.StartDatePolicy(ctx, workspaceId, accessStart, parentPolicy, HasRead, HasWrite); err != nil {
+			return err
+		}
+	}
 	for _, rootNode := range rootNodes {
-		if parentPolicy != "" {
+		if startPolicy != "" {
+			acls = append(acls, &idm.ACL{
+				RoleID:      roleId,
+				WorkspaceID: workspaceId,
+				NodeID:      rootNode.Uuid,
+				Action: &idm.ACLAction{
+					Name:  permissions2.AclPolicy.Name,
+					Value: startPolicy,
+				},
+			})
+		} else if parentPolicy != "" {
 			newPol, e := sc.InheritPolicies(ctx, parentPolicy, HasRead, HasWrite)
 			if e != nil {
 				return e
@@ -0,0 +1,202 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package share
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/client/grpc"
+	"github.com/pydio/cells/v4/common/log"
+	"github.com/pydio/cells/v4/common/proto/idm"
+	"github.com/pydio/cells/v4/common/proto/rest"
+	service2 "github.com/pydio/cells/v4/common/proto/service"
+	"github.com/pydio/cells/v4/common/service/errors"
+	"github.com/pydio/cells/v4/common/utils/uuid"
+)
+
+// PutLink creates or updates a public link from a PutShareLinkRequest, on behalf of ownerUser, and returns the link
+// workspace. The checker is used to verify that an existing link can be edited, it may be nil when creating links.
+func (sc *Client) PutLink(ctx context.Context, ownerUser *idm.User, putRequest *rest.PutShareLinkRequest, checker ContextEditableChecker) (*idm.Workspace, error) {
+
+	start := time.Now()
+	track := func(msg string) {
+		log.Logger(ctx).Debug(msg, zap.Duration("t", time.Since(start)))
+	}
+
+	link := putRequest.ShareLink
+	rootWorkspaces, files, folders, e := sc.CheckLinkRootNodes(ctx, link)
+	if e != nil {
+		return nil, e
+	}
+	parentPolicy, e := sc.DetectInheritedPolicy(ctx, link.RootNodes, rootWorkspaces)
+	if e != nil {
+		return nil, e
+	}
+
+	pluginOptions, e := sc.CheckLinkOptionsAgainstConfigs(ctx, link, rootWorkspaces, files, folders)
+	if e != nil {
+		return nil, e
+	} else if pluginOptions.ShareForcePassword && !putRequest.PasswordEnabled {
+		return nil, errors.Forbidden("link.password.required", "password is required")
+	}
+
+	var workspace *idm.Workspace
+	var user *idm.User
+	var create bool
+	aclClient := idm.NewACLServiceClient(grpc.GetClientConnFromCtx(sc.RuntimeContext, common.ServiceAcl))
+	if link.Uuid == "" {
+		create = true
+		if link.ViewTemplateName == "" {
+			if files {
+				link.ViewTemplateName = "pydio_unique_strip"
+			} else {
+				link.ViewTemplateName = "pydio_shared_folder"
+			}
+		}
+		workspace, _, e = sc.GetOrCreateWorkspace(ctx, ownerUser, "", idm.WorkspaceScope_LINK, link.Label, link.Description, false)
+		if e != nil {
+			return nil, e
+		}
+		track("GetOrCreateWorkspace")
+		for _, node := range link.RootNodes {
+			if _, e := aclClient.CreateACL(ctx, &idm.CreateACLRequest{
+				ACL: &idm.ACL{
+					NodeID:      node.Uuid,
+					WorkspaceID: workspace.UUID,
+					Action:      &idm.ACLAction{Name: "workspace-path", Value: "uuid:" + node.Uuid},
+				},
+			}); e != nil {
+				return nil, e
+			}
+		}
+		track("CreateACL")
+		link.Uuid = workspace.UUID
+		link.LinkHash = strings.Replace(uuid.New(), "-", "", -1)[0:pluginOptions.HashMinLength]
+	} else {
+		if putRequest.UpdateCustomHash != "" {
+			if !pluginOptions.HashEditable {
+				return nil, errors.Forbidden("link.hash.not-editable", "You are not allowed to edit link manually")
+			}
+			if len(putRequest.UpdateCustomHash) < pluginOptions.HashMinLength {
+				return nil, errors.Forbidden("link.hash.min-length", "Please use a link hash with at least %d characters", pluginOptions.HashMinLength)
+			}
+		}
+		workspace, create, e = sc.GetOrCreateWorkspace(ctx, ownerUser, link.Uuid, idm.WorkspaceScope_LINK, link.Label, link.Description, true)
+		if e != nil {
+			return nil, e
+		}
+	}
+	if !create && (checker == nil || !checker.IsContextEditable(ctx, workspace.UUID, workspace.Policies)) {
+		return nil, errors.Forbidden("link.not-editable", "you are not allowed to edit this link")
+	}
+	track("IsContextEditable")
+
+	// Load Hidden User
+	user, e = sc.GetOrCreateHiddenUser(ctx, ownerUser, link, putRequest.PasswordEnabled, putRequest.CreatePassword, false)
+	if e != nil {
+		return nil, e
+	}
+	track("GetOrCreateHiddenUser")
+	if create {
+		link.UserLogin = user.Login
+		link.UserUuid = user.Uuid
+		link.PasswordRequired = putRequest.PasswordEnabled
+		// Update Workspace Policies to make sure it's readable by the new user
+		workspace.Policies = append(workspace.Policies, &service2.ResourcePolicy{
+			Resource: workspace.UUID,
+			Subject:  fmt.Sprintf("user:%s", user.Login),
+			Action:   service2.ResourcePolicyAction_READ,
+			Effect:   service2.ResourcePolicy_allow,
+		})
+		wsClient := idm.NewWorkspaceServiceClient(grpc.GetClientConnFromCtx(sc.RuntimeContext, common.ServiceWorkspace))
+		if _, e := wsClient.CreateWorkspace(ctx, &idm.CreateWorkspaceRequest{Workspace: workspace}); e != nil {
+			return nil, e
+		}
+		track("CreateWorkspace")
+	} else {
+		// Manage password if status was updated
+		storedLink := &rest.ShareLink{Uuid: link.Uuid}
+		sc.LoadHashDocumentData(ctx, storedLink, []*idm.ACL{})
+
+		link.PasswordRequired = storedLink.PasswordRequired
+		var passNewEnable = putRequest.PasswordEnabled && !storedLink.PasswordRequired
+		var passNewDisable = !putRequest.PasswordEnabled && storedLink.PasswordRequired
+		var passUpdated = putRequest.PasswordEnabled && storedLink.PasswordRequired && putRequest.UpdatePassword != ""
+		if passNewEnable || passNewDisable || passUpdated {
+			// Password conditions have changed : re-create a new hidden user
+			if e := sc.DeleteHiddenUser(ctx, storedLink); e != nil {
+				return nil, e
+			}
+			storedLink.UserLogin = ""
+			storedLink.UserUuid = ""
+			if passUpdated {
+				putRequest.CreatePassword = putRequest.UpdatePassword
+			}
+			uUser, e := sc.GetOrCreateHiddenUser(ctx, ownerUser, storedLink, putRequest.PasswordEnabled, putRequest.CreatePassword, false)
+			if e != nil {
+				return nil, e
+			}
+			user = uUser
+			link.UserLogin = user.Login
+			link.UserUuid = user.Uuid
+			if passNewEnable {
+				link.PasswordRequired = true
+			} else if passNewDisable {
+				link.PasswordRequired = false
+			}
+		}
+	}
+
+	if e := sc.UpdateACLsForHiddenUser(ctx, user.Uuid, workspace.UUID, link.RootNodes, link.Permissions, parentPolicy, link.AccessStart, !create); e != nil {
+		return nil, e
+	}
+	track("UpdateACLsForHiddenUser")
+	if create {
+		log.Auditer(ctx).Info(
+			fmt.Sprintf("Created share link [%s]", link.Label),
+			log.GetAuditId(common.AuditLinkCreate),
+			zap.String(common.KeyLinkUuid, link.Uuid),
+			zap.String(common.KeyWorkspaceUuid, link.Uuid),
+		)
+	} else {
+		log.Auditer(ctx).Info(
+			fmt.Sprintf("Updated share link [%s]", link.Label),
+			log.GetAuditId(common.AuditLinkUpdate),
+			zap.String(common.KeyLinkUuid, link.Uuid),
+			zap.String(common.KeyWorkspaceUuid, link.Uuid),
+		)
+	}
+
+	// Update HashDocument
+	if e := sc.StoreHashDocument(ctx, ownerUser, link, putRequest.UpdateCustomHash); e != nil {
+		return nil, e
+	}
+	track("StoreHashDocument")
+	link.LinkUrl = linkUrl(link.LinkHash)
+
+	return workspace, nil
+}
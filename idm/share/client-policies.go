@@ -23,15 +23,22 @@ package share
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pydio/cells/v4/common/client/grpc"
 
 	"github.com/pydio/cells/v4/common"
 	"github.com/pydio/cells/v4/common/proto/idm"
+	servicecontext "github.com/pydio/cells/v4/common/service/context"
+	json "github.com/pydio/cells/v4/common/utils/jsonx"
 	"github.com/pydio/cells/v4/common/utils/permissions"
 	"github.com/pydio/cells/v4/common/utils/uuid"
+	"github.com/pydio/cells/v4/idm/policy/conditions"
 )
 
+// conditionsTimeLayout is the layout used by policies date conditions to parse the ServerTime context value
+const conditionsTimeLayout = "2006-01-02T15:04-0700"
+
 // InheritPolicies find possible SecurityPolicy currently implied and compute a new one based on it.
 func (sc *Client) InheritPolicies(ctx context.Context, policyName string, read, write bool) (string, error) {
 	polClient := idm.NewPolicyEngineServiceClient(grpc.GetClientConnFromCtx(sc.RuntimeContext, common.ServicePolicy))
@@ -69,6 +76,111 @@ func (sc *Client) InheritPolicies(ctx context.Context, policyName string, read,
 	return roPol.Uuid, nil
 }
 
+// StartDatePolicy creates or updates a policy group dedicated to a link, that grants read and/or write
+// access only once the accessStart date is reached. If parentPolicy is not empty, its rules are derived
+// and the date condition is added to the allow rule.
+func (sc *Client) StartDatePolicy(ctx context.Context, workspaceId string, accessStart int64, parentPolicy string, read, write bool) (string, error) {
+	if !read && !write {
+		return "", fmt.Errorf("provide at least one of read or write for start date policy")
+	}
+	polClient := idm.NewPolicyEngineServiceClient(grpc.GetClientConnFromCtx(sc.RuntimeContext, common.ServicePolicy))
+	polUuid := startDatePolicyUuid(workspaceId)
+	var newG *idm.PolicyGroup
+
+	if parentPolicy != "" {
+		parent, ok, e := sc.policyById(ctx, polClient, parentPolicy)
+		if e != nil {
+			return "", e
+		} else if !ok {
+			return "", fmt.Errorf("cannot find parent policy %s", parentPolicy)
+		}
+		suffix := "ro"
+		if read && write {
+			suffix = "rw"
+		} else if write {
+			suffix = "wo"
+		}
+		if newG, e = sc.derivePolicy(parent, read, write, suffix); e != nil {
+			return "", e
+		}
+		newG.Uuid = polUuid
+		for _, p := range newG.Policies {
+			p.Subjects = []string{"policy:" + polUuid}
+		}
+	} else {
+		allow := &idm.Policy{
+			Id:        uuid.New(),
+			Subjects:  []string{"policy:" + polUuid},
+			Resources: []string{"acl"},
+			Effect:    idm.PolicyEffect_allow,
+		}
+		if read {
+			allow.Actions = append(allow.Actions, permissions.AclRead.Name)
+		}
+		if write {
+			allow.Actions = append(allow.Actions, permissions.AclWrite.Name)
+		}
+		newG = &idm.PolicyGroup{
+			Uuid:          polUuid,
+			Name:          "Public link start date",
+			ResourceGroup: idm.PolicyResourceGroup_acl,
+			Policies:      []*idm.Policy{allow},
+		}
+	}
+	newG.Description = fmt.Sprintf("Access granted after %s (generated for sharing)", time.Unix(accessStart, 0).Format(time.RFC3339))
+
+	// ServerTime has a minute precision: use the minute preceding accessStart as reference, so that
+	// access is never granted before the start date.
+	cond := &conditions.DateAfterCondition{Matches: time.Unix(accessStart-1, 0).Truncate(time.Minute).Format(conditionsTimeLayout)}
+	jsonCond, _ := json.Marshal(cond)
+	for _, p := range newG.Policies {
+		if p.Effect != idm.PolicyEffect_allow {
+			continue
+		}
+		if p.Conditions == nil {
+			p.Conditions = make(map[string]*idm.PolicyCondition)
+		}
+		p.Conditions[servicecontext.ServerTime] = &idm.PolicyCondition{
+			Type:        cond.GetName(),
+			JsonOptions: string(jsonCond),
+		}
+	}
+
+	if _, e := polClient.StorePolicyGroup(ctx, &idm.StorePolicyGroupRequest{PolicyGroup: newG}); e != nil {
+		return "", e
+	}
+	permissions.ClearCachedPolicies(ctx, "acl")
+	return polUuid, nil
+}
+
+// DeleteStartDatePolicy removes the start date policy group associated with a link, if it exists.
+func (sc *Client) DeleteStartDatePolicy(ctx context.Context, workspaceId string) error {
+	polClient := idm.NewPolicyEngineServiceClient(grpc.GetClientConnFromCtx(sc.RuntimeContext, common.ServicePolicy))
+	pg, ok, e := sc.policyById(ctx, polClient, startDatePolicyUuid(workspaceId))
+	if e != nil || !ok {
+		return e
+	}
+	if _, e := polClient.DeletePolicyGroup(ctx, &idm.DeletePolicyGroupRequest{PolicyGroup: pg}); e != nil {
+		return e
+	}
+	permissions.ClearCachedPolicies(ctx, "acl")
+	return nil
+}
+
+// policyById loads a single policy group from the policy service.
+func (sc *Client) policyById(ctx context.Context, polClient idm.PolicyEngineServiceClient, id string) (*idm.PolicyGroup, bool, error) {
+	response, e := polClient.ListPolicyGroups(ctx, &idm.ListPolicyGroupsRequest{Filter: id})
+	if e != nil {
+		return nil, false, e
+	}
+	pg, ok := sc.policyByName(response.GetPolicyGroups(), id)
+	return pg, ok, nil
+}
+
+func startDatePolicyUuid(workspaceId string) string {
+	return "link-start-" + workspaceId
+}
+
 func (sc *Client) derivePolicy(policy *idm.PolicyGroup, read, write bool, suffix string) (*idm.PolicyGroup, error) {
 	var label string
 	switch suffix {
@@ -0,0 +1,211 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package share
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/client/grpc"
+	"github.com/pydio/cells/v4/common/proto/idm"
+	"github.com/pydio/cells/v4/common/proto/rest"
+	"github.com/pydio/cells/v4/common/proto/service"
+	"github.com/pydio/cells/v4/common/proto/tree"
+	"github.com/pydio/cells/v4/common/server/stubs/idmtest"
+	servicecontext "github.com/pydio/cells/v4/common/service/context"
+	json "github.com/pydio/cells/v4/common/utils/jsonx"
+	"github.com/pydio/cells/v4/common/utils/permissions"
+	"github.com/pydio/cells/v4/idm/policy/conditions"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// policiesMock stores policy groups in memory and records ListPolicyGroups filters
+type policiesMock struct {
+	idm.UnimplementedPolicyEngineServiceServer
+	groups  map[string]*idm.PolicyGroup
+	filters []string
+}
+
+func (p *policiesMock) StorePolicyGroup(ctx context.Context, request *idm.StorePolicyGroupRequest) (*idm.StorePolicyGroupResponse, error) {
+	p.groups[request.GetPolicyGroup().GetUuid()] = request.GetPolicyGroup()
+	return &idm.StorePolicyGroupResponse{PolicyGroup: request.GetPolicyGroup()}, nil
+}
+
+func (p *policiesMock) ListPolicyGroups(ctx context.Context, request *idm.ListPolicyGroupsRequest) (*idm.ListPolicyGroupsResponse, error) {
+	p.filters = append(p.filters, request.GetFilter())
+	resp := &idm.ListPolicyGroupsResponse{}
+	for id, g := range p.groups {
+		if request.GetFilter() == "" || request.GetFilter() == id {
+			resp.PolicyGroups = append(resp.PolicyGroups, g)
+		}
+	}
+	return resp, nil
+}
+
+func (p *policiesMock) DeletePolicyGroup(ctx context.Context, request *idm.DeletePolicyGroupRequest) (*idm.DeletePolicyGroupResponse, error) {
+	if _, ok := p.groups[request.GetPolicyGroup().GetUuid()]; !ok {
+		return nil, fmt.Errorf("policy group not found")
+	}
+	delete(p.groups, request.GetPolicyGroup().GetUuid())
+	return &idm.DeletePolicyGroupResponse{Success: true}, nil
+}
+
+// allowCondition returns the ServerTime condition of the allow rule of a policy group
+func allowCondition(g *idm.PolicyGroup) *conditions.DateAfterCondition {
+	for _, p := range g.GetPolicies() {
+		if p.Effect != idm.PolicyEffect_allow {
+			continue
+		}
+		c, ok := p.GetConditions()[servicecontext.ServerTime]
+		So(ok, ShouldBeTrue)
+		So(c.Type, ShouldEqual, "DateAfterCondition")
+		cond := &conditions.DateAfterCondition{}
+		So(json.Unmarshal([]byte(c.JsonOptions), cond), ShouldBeNil)
+		return cond
+	}
+	return nil
+}
+
+func TestClient_StartDatePolicy(t *testing.T) {
+
+	mock := &policiesMock{groups: map[string]*idm.PolicyGroup{}}
+	grpc.RegisterMock(common.ServicePolicy, &idm.PolicyEngineServiceStub{PolicyEngineServiceServer: mock})
+	ctx := context.Background()
+	sc := NewClient(ctx)
+
+	Convey("Create a start date policy without parent", t, func() {
+		start := time.Now().Add(48 * time.Hour).Unix()
+		id, e := sc.StartDatePolicy(ctx, "link-ws", start, "", true, false)
+		So(e, ShouldBeNil)
+		So(id, ShouldEqual, "link-start-link-ws")
+		g, ok := mock.groups[id]
+		So(ok, ShouldBeTrue)
+		So(g.Policies, ShouldHaveLength, 1)
+		So(g.Policies[0].Actions, ShouldResemble, []string{permissions.AclRead.Name})
+		So(g.Policies[0].Subjects, ShouldResemble, []string{"policy:" + id})
+
+		cond := allowCondition(g)
+		So(cond, ShouldNotBeNil)
+		So(cond.Fulfills(time.Unix(start, 0).Add(-2*time.Minute).Format(conditionsTimeLayout), nil), ShouldBeFalse)
+		// ServerTime has a minute precision: access is granted from the first minute starting after accessStart
+		So(cond.Fulfills(time.Unix(start-1, 0).Format(conditionsTimeLayout), nil), ShouldBeFalse)
+		So(cond.Fulfills(time.Unix(start+60, 0).Format(conditionsTimeLayout), nil), ShouldBeTrue)
+	})
+
+	Convey("Refuse start date policy without permissions", t, func() {
+		_, e := sc.StartDatePolicy(ctx, "link-ws", time.Now().Unix()+60, "", false, false)
+		So(e, ShouldNotBeNil)
+	})
+
+	Convey("Derive a start date policy from a parent policy, looked up by its ID", t, func() {
+		mock.groups["parent"] = &idm.PolicyGroup{
+			Uuid:          "parent",
+			Name:          "Parent",
+			ResourceGroup: idm.PolicyResourceGroup_acl,
+			Policies: []*idm.Policy{
+				{Id: "deny", Subjects: []string{"policy:parent"}, Resources: []string{"acl"}, Actions: []string{permissions.AclWrite.Name}, Effect: idm.PolicyEffect_deny},
+				{Id: "allow", Subjects: []string{"policy:parent"}, Resources: []string{"acl"}, Actions: []string{permissions.AclRead.Name, permissions.AclWrite.Name}, Effect: idm.PolicyEffect_allow},
+			},
+		}
+		mock.filters = nil
+		id, e := sc.StartDatePolicy(ctx, "link-ws2", time.Now().Add(time.Hour).Unix(), "parent", true, true)
+		So(e, ShouldBeNil)
+		So(mock.filters, ShouldResemble, []string{"parent"})
+		g := mock.groups[id]
+		So(g.Policies, ShouldHaveLength, 2)
+		for _, p := range g.Policies {
+			So(p.Subjects, ShouldResemble, []string{"policy:" + id})
+			if p.Effect == idm.PolicyEffect_deny {
+				So(p.Conditions, ShouldBeEmpty)
+			}
+		}
+		So(allowCondition(g), ShouldNotBeNil)
+
+		_, e = sc.StartDatePolicy(ctx, "link-ws3", time.Now().Add(time.Hour).Unix(), "unknown", true, false)
+		So(e, ShouldNotBeNil)
+	})
+
+	Convey("Delete start date policies by ID", t, func() {
+		mock.filters = nil
+		So(sc.DeleteStartDatePolicy(ctx, "link-ws"), ShouldBeNil)
+		So(mock.filters, ShouldResemble, []string{"link-start-link-ws"})
+		_, ok := mock.groups["link-start-link-ws"]
+		So(ok, ShouldBeFalse)
+		// Deleting a missing policy is a no-op
+		So(sc.DeleteStartDatePolicy(ctx, "link-ws"), ShouldBeNil)
+		So(sc.DeleteStartDatePolicy(ctx, "link-ws2"), ShouldBeNil)
+		_, ok = mock.groups["parent"]
+		So(ok, ShouldBeTrue)
+	})
+
+}
+
+func TestClient_UpdateACLsForHiddenUser(t *testing.T) {
+
+	mock := &policiesMock{groups: map[string]*idm.PolicyGroup{}}
+	grpc.RegisterMock(common.ServicePolicy, &idm.PolicyEngineServiceStub{PolicyEngineServiceServer: mock})
+	ctx := context.Background()
+	sc := NewClient(ctx)
+
+	// roleAcls lists the actions of the ACLs set on the link root node for the hidden user role
+	roleAcls := func(roleId string) map[string]string {
+		aclClient := idm.NewACLServiceClient(grpc.GetClientConnFromCtx(ctx, common.ServiceAcl))
+		actions := map[string]string{}
+		q, _ := anypb.New(&idm.ACLSingleQuery{RoleIDs: []string{roleId}, NodeIDs: []string{"root-uuid"}})
+		st, e := aclClient.SearchACL(ctx, &idm.SearchACLRequest{Query: &service.Query{SubQueries: []*anypb.Any{q}}})
+		So(e, ShouldBeNil)
+		for {
+			r, er := st.Recv()
+			if er != nil {
+				break
+			}
+			actions[r.GetACL().GetAction().GetName()] = r.GetACL().GetAction().GetValue()
+		}
+		return actions
+	}
+
+	Convey("Links with a future access start use a start date policy", t, func() {
+		aclService, e := idmtest.NewACLService()
+		So(e, ShouldBeNil)
+		grpc.RegisterMock(common.ServiceAcl, aclService)
+
+		roots := []*tree.Node{{Uuid: "root-uuid"}}
+		perms := []rest.ShareLinkAccessType{rest.ShareLinkAccessType_Preview, rest.ShareLinkAccessType_Download}
+		start := time.Now().Add(24 * time.Hour).Unix()
+
+		So(sc.UpdateACLsForHiddenUser(ctx, "hidden-role", "link-uuid", roots, perms, "", start, false), ShouldBeNil)
+		So(roleAcls("hidden-role"), ShouldResemble, map[string]string{permissions.AclPolicy.Name: "link-start-link-uuid"})
+		_, ok := mock.groups["link-start-link-uuid"]
+		So(ok, ShouldBeTrue)
+
+		// Removing the access start replaces the policy by standard ACLs
+		So(sc.UpdateACLsForHiddenUser(ctx, "hidden-role", "link-uuid", roots, perms, "", 0, true), ShouldBeNil)
+		So(roleAcls("hidden-role"), ShouldResemble, map[string]string{permissions.AclRead.Name: permissions.AclRead.Value})
+		_, ok = mock.groups["link-start-link-uuid"]
+		So(ok, ShouldBeFalse)
+	})
+}
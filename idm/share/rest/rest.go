@@ -26,8 +26,6 @@ package rest
 import (
 	"context"
 	"fmt"
-	"strings"
-	"time"
 
 	restful "github.com/emicklei/go-restful/v3"
 	"go.uber.org/zap"
@@ -45,7 +43,6 @@ import (
 	"github.com/pydio/cells/v4/common/service/errors"
 	"github.com/pydio/cells/v4/common/service/resources"
 	"github.com/pydio/cells/v4/common/utils/permissions"
-	"github.com/pydio/cells/v4/common/utils/uuid"
 	"github.com/pydio/cells/v4/idm/share"
 )
 
@@ -300,10 +297,6 @@ func (h *SharesHandler) DeleteCell(req *restful.Request, rsp *restful.Response)
 func (h *SharesHandler) PutShareLink(req *restful.Request, rsp *restful.Response) {
 
 	ctx := req.Request.Context()
-	start := time.Now()
-	track := func(msg string) {
-		log.Logger(ctx).Debug(msg, zap.Duration("t", time.Since(start)))
-	}
 	var putRequest rest.PutShareLinkRequest
 	if err := req.ReadEntity(&putRequest); err != nil {
 		service.RestError500(req, rsp, err)
@@ -314,162 +307,11 @@ func (h *SharesHandler) PutShareLink(req *restful.Request, rsp *restful.Response
 		return
 	}
 
-	link := putRequest.ShareLink
-	rootWorkspaces, files, folders, e := h.sc.CheckLinkRootNodes(ctx, link)
-	if e != nil {
-		service.RestErrorDetect(req, rsp, e)
-		return
-	}
-	parentPolicy, e := h.sc.DetectInheritedPolicy(ctx, link.RootNodes, rootWorkspaces)
-	if e != nil {
-		service.RestErrorDetect(req, rsp, e)
-		return
-	}
-
-	pluginOptions, e := h.sc.CheckLinkOptionsAgainstConfigs(ctx, link, rootWorkspaces, files, folders)
-	if e != nil {
-		service.RestErrorDetect(req, rsp, e)
-		return
-	} else if pluginOptions.ShareForcePassword && !putRequest.PasswordEnabled {
-		service.RestError403(req, rsp, fmt.Errorf("password is required"))
-		return
-	}
-
-	ownerUser := h.IdmUserFromClaims(ctx)
-	var workspace *idm.Workspace
-	var user *idm.User
-	var err error
-	var create bool
-	aclClient := idm.NewACLServiceClient(grpc.GetClientConnFromCtx(h.ctx, common.ServiceAcl))
-	if link.Uuid == "" {
-		create = true
-		workspace, _, err = h.sc.GetOrCreateWorkspace(ctx, ownerUser, "", idm.WorkspaceScope_LINK, link.Label, link.Description, false)
-		if err != nil {
-			service.RestErrorDetect(req, rsp, err)
-			return
-		}
-		track("GetOrCreateWorkspace")
-		for _, node := range link.RootNodes {
-			aclClient.CreateACL(ctx, &idm.CreateACLRequest{
-				ACL: &idm.ACL{
-					NodeID:      node.Uuid,
-					WorkspaceID: workspace.UUID,
-					Action:      &idm.ACLAction{Name: "workspace-path", Value: "uuid:" + node.Uuid},
-				},
-			})
-		}
-		track("CreateACL")
-		link.Uuid = workspace.UUID
-		link.LinkHash = strings.Replace(uuid.New(), "-", "", -1)[0:pluginOptions.HashMinLength]
-	} else {
-		if putRequest.UpdateCustomHash != "" {
-			if !pluginOptions.HashEditable {
-				service.RestError403(req, rsp, errors.Forbidden("link.hash.not-editable", "You are not allowed to edit link manually"))
-				return
-			}
-			if len(putRequest.UpdateCustomHash) < pluginOptions.HashMinLength {
-				service.RestError403(req, rsp, errors.Forbidden("link.hash.min-length", "Please use a link hash with at least %d characters", pluginOptions.HashMinLength))
-				return
-			}
-		}
-		workspace, create, err = h.sc.GetOrCreateWorkspace(ctx, ownerUser, link.Uuid, idm.WorkspaceScope_LINK, link.Label, link.Description, true)
-	}
+	workspace, err := h.sc.PutLink(ctx, h.IdmUserFromClaims(ctx), &putRequest, h)
 	if err != nil {
-		service.RestError500(req, rsp, err)
-		return
-	}
-	if !create && !h.IsContextEditable(ctx, workspace.UUID, workspace.Policies) {
-		service.RestError403(req, rsp, fmt.Errorf("you are not allowed to edit this link"))
-		return
-	}
-	track("IsContextEditable")
-
-	// Load Hidden User
-	user, err = h.sc.GetOrCreateHiddenUser(ctx, ownerUser, link, putRequest.PasswordEnabled, putRequest.CreatePassword, false)
-	if err != nil {
-		service.RestError500(req, rsp, err)
-		return
-	}
-	track("GetOrCreateHiddenUser")
-	if create {
-		link.UserLogin = user.Login
-		link.UserUuid = user.Uuid
-		link.PasswordRequired = putRequest.PasswordEnabled
-		// Update Workspace Policies to make sure it's readable by the new user
-		workspace.Policies = append(workspace.Policies, &service2.ResourcePolicy{
-			Resource: workspace.UUID,
-			Subject:  fmt.Sprintf("user:%s", user.Login),
-			Action:   service2.ResourcePolicyAction_READ,
-			Effect:   service2.ResourcePolicy_allow,
-		})
-		wsClient := idm.NewWorkspaceServiceClient(grpc.GetClientConnFromCtx(h.ctx, common.ServiceWorkspace))
-		wsClient.CreateWorkspace(ctx, &idm.CreateWorkspaceRequest{Workspace: workspace})
-		track("CreateWorkspace")
-	} else {
-		// Manage password if status was updated
-		storedLink := &rest.ShareLink{Uuid: link.Uuid}
-		h.sc.LoadHashDocumentData(ctx, storedLink, []*idm.ACL{})
-
-		link.PasswordRequired = storedLink.PasswordRequired
-		var passNewEnable = putRequest.PasswordEnabled && !storedLink.PasswordRequired
-		var passNewDisable = !putRequest.PasswordEnabled && storedLink.PasswordRequired
-		var passUpdated = putRequest.PasswordEnabled && storedLink.PasswordRequired && putRequest.UpdatePassword != ""
-		if passNewEnable || passNewDisable || passUpdated {
-			// Password conditions have changed : re-create a new hidden user
-			if e := h.sc.DeleteHiddenUser(ctx, storedLink); e != nil {
-				service.RestError500(req, rsp, e)
-				return
-			}
-			storedLink.UserLogin = ""
-			storedLink.UserUuid = ""
-			if passUpdated {
-				putRequest.CreatePassword = putRequest.UpdatePassword
-			}
-			uUser, e := h.sc.GetOrCreateHiddenUser(ctx, ownerUser, storedLink, putRequest.PasswordEnabled, putRequest.CreatePassword, false)
-			if e != nil {
-				service.RestError500(req, rsp, e)
-				return
-			}
-			user = uUser
-			link.UserLogin = user.Login
-			link.UserUuid = user.Uuid
-			if passNewEnable {
-				link.PasswordRequired = true
-			} else if passNewDisable {
-				link.PasswordRequired = false
-			}
-		}
-	}
-
-	err = h.sc.UpdateACLsForHiddenUser(ctx, user.Uuid, workspace.UUID, link.RootNodes, link.Permissions, parentPolicy, !create)
-	track("UpdateACLsForHiddenUser")
-	if err != nil {
-		service.RestError500(req, rsp, err)
-		return
-	}
-	if create {
-		log.Auditer(ctx).Info(
-			fmt.Sprintf("Created share link [%s]", link.Label),
-			log.GetAuditId(common.AuditLinkCreate),
-			zap.String(common.KeyLinkUuid, link.Uuid),
-			zap.String(common.KeyWorkspaceUuid, link.Uuid),
-		)
-		track("Auditer")
-	} else {
-		log.Auditer(ctx).Info(
-			fmt.Sprintf("Updated share link [%s]", link.Label),
-			log.GetAuditId(common.AuditLinkUpdate),
-			zap.String(common.KeyLinkUuid, link.Uuid),
-			zap.String(common.KeyWorkspaceUuid, link.Uuid),
-		)
-	}
-
-	// Update HashDocument
-	if err := h.sc.StoreHashDocument(ctx, ownerUser, link, putRequest.UpdateCustomHash); err != nil {
-		service.RestError500(req, rsp, err)
+		service.RestErrorDetect(req, rsp, err)
 		return
 	}
-	track("StoreHashDocument")
 
 	// Reload
 	if output, e := h.sc.WorkspaceToShareLinkObject(ctx, workspace, h); e != nil {
@@ -477,7 +319,6 @@ func (h *SharesHandler) PutShareLink(req *restful.Request, rsp *restful.Response
 	} else {
 		rsp.WriteEntity(output)
 	}
-	track("WorkspaceToShareLinkObject")
 }
 
 // GetShareLink loads link information.
@@ -548,6 +389,14 @@ func (h *SharesHandler) DeleteShareLink(req *restful.Request, rsp *restful.Respo
 		return
 	}
 
+	// Delete associated start date policy
+	if storedLink.AccessStart > 0 {
+		if err := h.sc.DeleteStartDatePolicy(ctx, id); err != nil {
+			service.RestErrorDetect(req, rsp, err)
+			return
+		}
+	}
+
 	log.Auditer(ctx).Info(
 		fmt.Sprintf("Removed share link [%s]", id),
 		log.GetAuditId(common.AuditLinkUpdate),
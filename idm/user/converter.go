@@ -29,10 +29,12 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	goqu "github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/auth"
 	"github.com/pydio/cells/v4/common/log"
 	"github.com/pydio/cells/v4/common/proto/idm"
 	service "github.com/pydio/cells/v4/common/proto/service"
@@ -166,16 +168,16 @@ func (c *queryConverter) Convert(val *anypb.Any, driver string) (goqu.Expression
 		mpath, _, err := c.treeDao.Path(groupPath, false)
 		if err != nil {
 			log.Logger(context.Background()).Error("Error while getting parent mpath", zap.Any("g", groupPath), zap.Error(err))
-			return goqu.L("0"), true
+			return goqu.L("1 = 0"), true
 		}
 		if mpath == nil {
 			log.Logger(context.Background()).Debug("Nil mpath for groupPath", zap.Any("g", groupPath))
-			return goqu.L("0"), true
+			return goqu.L("1 = 0"), true
 		}
 		parentNode, err := c.treeDao.GetNode(mpath)
 		if err != nil {
 			log.Logger(context.Background()).Error("Error while getting parent node", zap.Any("g", groupPath), zap.Error(err))
-			return goqu.L("0"), true
+			return goqu.L("1 = 0"), true
 		}
 		if fullPath {
 			expressions = append(expressions, goqu.L(unPrepared["WhereGroupPathIncludeParent"](parentNode.MPath.String())))
@@ -208,6 +210,16 @@ func (c *queryConverter) Convert(val *anypb.Any, driver string) (goqu.Expression
 		q.AttributeValue = q.HasProfile
 	}
 
+	if q.AttributeName == idm.UserAttrPasswordScheme {
+		// Virtual attribute, computed from the stored hash
+		ex := passwordSchemeExpression(gt, q.AttributeValue)
+		if q.Not {
+			ex = goqu.L("NOT (?)", ex)
+		}
+		expressions = append(expressions, gt.Col("leaf").Eq(1), ex)
+		q.AttributeName = ""
+	}
+
 	if len(q.AttributeName) > 0 {
 
 		db := goqu.New(driver, nil)
@@ -275,6 +287,22 @@ func (c *queryConverter) Convert(val *anypb.Any, driver string) (goqu.Expression
 
 }
 
+// passwordSchemeExpression matches users whose password hash uses the given scheme.
+func passwordSchemeExpression(gt exp.IdentifierExpression, scheme string) goqu.Expression {
+	etag := gt.Col("etag")
+	switch scheme {
+	case auth.PasswordSchemeArgon2id:
+		return etag.Like("$argon2id$%")
+	case auth.PasswordSchemeBcrypt:
+		return etag.Like("$2%")
+	case auth.PasswordSchemePBKDF2:
+		return goqu.And(etag.NotLike("$%"), etag.Like("%:%:%:%"))
+	case auth.PasswordSchemeMD5:
+		return goqu.And(etag.NotLike("$%"), etag.NotLike("%:%"), etag.Neq(""))
+	}
+	return goqu.L("1 = 0")
+}
+
 func userToNode(u *idm.User) *tree.Node {
 
 	path := strings.TrimRight(u.GroupPath, "/") + "/" + u.Login
@@ -32,8 +32,10 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/auth"
 	"github.com/pydio/cells/v4/common/dao"
 	"github.com/pydio/cells/v4/common/dao/sqlite"
+	"github.com/pydio/cells/v4/common/dao/test"
 	"github.com/pydio/cells/v4/common/proto/idm"
 	"github.com/pydio/cells/v4/common/proto/service"
 	"github.com/pydio/cells/v4/common/utils/cache"
@@ -55,7 +57,8 @@ func TestMain(m *testing.M) {
 		"autoApplyProfile": {{Uuid: "auto-apply", AutoApplies: []string{"autoApplyProfile"}}},
 	})
 
-	if d, e := dao.InitDAO(sqlite.Driver, sqlite.SharedMemDSN, "idm_user", user.NewDAO, configx.New()); e != nil {
+	driver, dsn := test.SQLDriver(sqlite.Driver, sqlite.SharedMemDSN)
+	if d, e := dao.InitDAO(driver, dsn, "idm_user", user.NewDAO, configx.New()); e != nil {
 		panic(e)
 	} else {
 		mockDAO = d.(user.DAO)
@@ -70,7 +73,8 @@ func TestLoginCIDAO(t *testing.T) {
 
 	cfg := configx.New()
 	_ = cfg.Val("loginCI").Set(true)
-	ciDAO, e := dao.InitDAO(sqlite.Driver, sqlite.SharedMemDSN, "idm_user", user.NewDAO, cfg)
+	driver, dsn := test.SQLDriver(sqlite.Driver, sqlite.SharedMemDSN)
+	ciDAO, e := dao.InitDAO(driver, dsn, "idm_user", user.NewDAO, cfg)
 	if e != nil {
 		t.Fail()
 		return
@@ -90,6 +94,8 @@ func TestLoginCIDAO(t *testing.T) {
 		_, e4 := h.BindUser(ctx, &idm.BindUserRequest{UserName: "MixedLoginz", Password: "azerty"})
 		So(e4, ShouldNotBeNil)
 
+		delQ, _ := anypb.New(&idm.UserSingleQuery{Login: "MixedLogin"})
+		h2.DeleteUser(ctx, &idm.DeleteUserRequest{Query: &service.Query{SubQueries: []*anypb.Any{delQ}}})
 	})
 
 	Convey("Test LoginCI Not set", t, func() {
@@ -250,9 +256,27 @@ func TestUser(t *testing.T) {
 		So(err, ShouldBeNil)
 		So(resp.GetUser().GetLogin(), ShouldEqual, "legacy")
 
+		countScheme := func(scheme string) int32 {
+			q, _ := anypb.New(&idm.UserSingleQuery{AttributeName: idm.UserAttrPasswordScheme, AttributeValue: scheme})
+			r, e := h.CountUser(ctx, &idm.SearchUserRequest{Query: &service.Query{SubQueries: []*anypb.Any{q}}})
+			So(e, ShouldBeNil)
+			return r.Count
+		}
+		pbkdf2Count := countScheme(auth.PasswordSchemePBKDF2)
+		So(pbkdf2Count, ShouldBeGreaterThan, 0)
+		argonCount := countScheme(auth.PasswordSchemeArgon2id)
+
 		bindResp, err := h.BindUser(ctx, &idm.BindUserRequest{UserName: "legacy", Password: "P@ssw0rd"})
 		So(err, ShouldBeNil)
 		So(bindResp.User, ShouldNotBeNil)
+
+		// Password hash must have been upgraded
+		So(countScheme(auth.PasswordSchemePBKDF2), ShouldEqual, pbkdf2Count-1)
+		So(countScheme(auth.PasswordSchemeArgon2id), ShouldEqual, argonCount+1)
+		_, err = h.BindUser(ctx, &idm.BindUserRequest{UserName: "legacy", Password: "P@ssw0rd"})
+		So(err, ShouldBeNil)
+		_, err = h.BindUser(ctx, &idm.BindUserRequest{UserName: "legacy", Password: "wrong"})
+		So(err, ShouldNotBeNil)
 	})
 
 	Convey("Test password change lock", t, func() {
@@ -0,0 +1,20 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS idm_user_attributes (
+    uuid       VARCHAR(128) NOT NULL,
+    name       VARCHAR(255) NOT NULL,
+    value      TEXT,
+    PRIMARY KEY (uuid, name),
+    FOREIGN KEY (uuid) REFERENCES idm_user_idx_tree(uuid) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS idm_user_roles (
+    uuid       VARCHAR(128) NOT NULL,
+    role       VARCHAR(255) NOT NULL,
+    weight     INT DEFAULT 0,
+    PRIMARY KEY (uuid, role),
+    FOREIGN KEY (uuid) REFERENCES idm_user_idx_tree(uuid) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE idm_user_attributes;
+DROP TABLE idm_user_roles;
@@ -59,15 +59,18 @@ var (
 	migrationsFS embed.FS
 
 	queries = map[string]string{
-		"AddAttribute":     `replace into idm_user_attributes (uuid, name, value) values (?, ?, ?)`,
-		"GetAttributes":    `select name, value from idm_user_attributes where uuid = ?`,
-		"DeleteAttribute":  `delete from idm_user_attributes where uuid = ? and name = ?`,
-		"DeleteAttributes": `delete from idm_user_attributes where uuid = ?`,
-		"AddRole":          `replace into idm_user_roles (uuid, role, weight) values (?, ?, ?)`,
-		"GetRoles":         `select role from idm_user_roles where uuid = ? order by weight ASC`,
-		"DeleteUserRoles":  `delete from idm_user_roles where uuid = ?`,
-		"DeleteRoleById":   `delete from idm_user_roles where role = ?`,
-		"TouchUser":        `update idm_user_idx_tree set mtime = ? where uuid = ?`,
+		"AddAttribute":          `replace into idm_user_attributes (uuid, name, value) values (?, ?, ?)`,
+		"AddAttribute-postgres": `insert into idm_user_attributes (uuid, name, value) values (?, ?, ?) on conflict (uuid, name) do update set value = excluded.value`,
+		"GetAttributes":         `select name, value from idm_user_attributes where uuid = ?`,
+		"DeleteAttribute":       `delete from idm_user_attributes where uuid = ? and name = ?`,
+		"DeleteAttributes":      `delete from idm_user_attributes where uuid = ?`,
+		"AddRole":               `replace into idm_user_roles (uuid, role, weight) values (?, ?, ?)`,
+		"AddRole-postgres":      `insert into idm_user_roles (uuid, role, weight) values (?, ?, ?) on conflict (uuid, role) do update set weight = excluded.weight`,
+		"GetRoles":              `select role from idm_user_roles where uuid = ? order by weight ASC`,
+		"DeleteUserRoles":       `delete from idm_user_roles where uuid = ?`,
+		"DeleteRoleById":        `delete from idm_user_roles where role = ?`,
+		"TouchUser":             `update idm_user_idx_tree set mtime = ? where uuid = ?`,
+		"UpdatePassword":        `update idm_user_idx_tree set etag = ? where uuid = ?`,
 		//"DeleteAttsClean":      `delete from idm_user_attributes where uuid not in (select uuid from idm_user_idx_tree)`,
 		//"DeleteUserRolesClean": `delete from idm_user_roles where uuid not in (select uuid from idm_user_idx_tree)`,
 	}
@@ -95,7 +98,7 @@ var (
 		},
 	}
 
-	hasher = auth.PydioPW{
+	legacyHasher = auth.PydioPW{
 		PBKDF2_HASH_ALGORITHM: "sha256",
 		PBKDF2_ITERATIONS:     1000,
 		PBKDF2_SALT_BYTE_SIZE: 32,
@@ -106,6 +109,9 @@ var (
 		HASH_SALT_INDEX:       2,
 		HASH_PBKDF2_INDEX:     3,
 	}
+
+	// hasher creates argon2id hashes, and still verifies bcrypt, PBKDF2 and MD5 ones
+	hasher = auth.NewPasswordHashers(auth.NewArgon2idHasher(), &auth.BcryptHasher{}, legacyHasher)
 )
 
 // Impl of the SQL interface
@@ -326,7 +332,11 @@ func (s *sqlimpl) Add(in interface{}) (interface{}, []*tree.Node, error) {
 		errTx = er
 		return nil, createdNodes, er
 	}
-	addAttribute, er := s.GetStmt("AddAttribute")
+	addAttributeKey, addRoleKey := "AddAttribute", "AddRole"
+	if s.Driver() == "postgres" {
+		addAttributeKey, addRoleKey = "AddAttribute-postgres", "AddRole-postgres"
+	}
+	addAttribute, er := s.GetStmt(addAttributeKey)
 	if er != nil {
 		errTx = er
 		return nil, createdNodes, errTx
@@ -336,7 +346,7 @@ func (s *sqlimpl) Add(in interface{}) (interface{}, []*tree.Node, error) {
 		errTx = er
 		return nil, createdNodes, errTx
 	}
-	addUserRole, er := s.GetStmt("AddRole")
+	addUserRole, er := s.GetStmt(addRoleKey)
 	if er != nil {
 		errTx = er
 		return nil, createdNodes, errTx
@@ -447,17 +457,30 @@ func (s *sqlimpl) Bind(userName string, password string) (user *idm.User, e erro
 	}
 	hashedPass := user.Password
 	// Check password
-	valid, _ := hasher.CheckDBKDF2PydioPwd(password, hashedPass)
-	if valid {
-		return user, nil
-	}
-	// Check with legacy format (coming from PHP, Salt []byte is built differently)
-	valid, _ = hasher.CheckDBKDF2PydioPwd(password, hashedPass, true)
-	if valid {
-		return user, nil
+	valid, rehash, _ := hasher.Check(password, hashedPass)
+	if !valid {
+		return nil, errors.Forbidden(common.ServiceUser, "password does not match")
+	}
+	if rehash {
+		// Transparently upgrade hash to the current scheme, without failing the login
+		if er := s.updatePassword(user.Uuid, hasher.CreateHash(password)); er != nil {
+			log.Logger(context.Background()).Warn("Cannot upgrade password hash for user "+user.Login, zap.Error(er))
+		} else {
+			log.Logger(context.Background()).Info("Upgraded password hash for user "+user.Login, zap.String("from", hasher.Scheme(hashedPass)))
+		}
 	}
+	return user, nil
+
+}
+
+func (s *sqlimpl) updatePassword(userUuid string, hash string) error {
 
-	return nil, errors.Forbidden(common.ServiceUser, "password does not match")
+	st, er := s.GetStmt("UpdatePassword")
+	if er != nil {
+		return er
+	}
+	_, err := st.Exec(hash, userUuid)
+	return err
 
 }
 
@@ -770,7 +793,7 @@ func getMPathEquals(mpath []byte) string {
 
 	for {
 		cnt := (len(mpath) - 1) / indexLen
-		res = append(res, fmt.Sprintf(`mpath%d LIKE "%s"`, cnt+1, mpath[(cnt*indexLen):]))
+		res = append(res, fmt.Sprintf(`mpath%d LIKE '%s'`, cnt+1, mpath[(cnt*indexLen):]))
 
 		if idx := cnt * indexLen; idx == 0 {
 			break
@@ -792,10 +815,10 @@ func getMPathLike(mpath []byte) string {
 	for {
 		cnt := (len(mpath) - 1) / indexLen
 		if !done {
-			res = append(res, fmt.Sprintf(`mpath%d LIKE "%s"`, cnt+1, mpath[(cnt*indexLen):]))
+			res = append(res, fmt.Sprintf(`mpath%d LIKE '%s'`, cnt+1, mpath[(cnt*indexLen):]))
 			done = true
 		} else {
-			res = append(res, fmt.Sprintf(`mpath%d LIKE "%s"`, cnt+1, mpath[(cnt*indexLen):]))
+			res = append(res, fmt.Sprintf(`mpath%d LIKE '%s'`, cnt+1, mpath[(cnt*indexLen):]))
 		}
 
 		if idx := cnt * indexLen; idx == 0 {
@@ -46,6 +46,7 @@ var (
 
 	queries = map[string]string{
 		"AddWorkspace":            `replace into idm_workspaces (uuid, label, description, attributes, slug, scope, last_updated) values (?, ?, ?, ?, ?, ?, ?)`,
+		"AddWorkspace-postgres":   `insert into idm_workspaces (uuid, label, description, attributes, slug, scope, last_updated) values (?, ?, ?, ?, ?, ?, ?) on conflict (uuid) do update set label = excluded.label, description = excluded.description, attributes = excluded.attributes, slug = excluded.slug, scope = excluded.scope, last_updated = excluded.last_updated`,
 		"GetWorkspace":            `select uuid from idm_workspaces where uuid = ?`,
 		"ExistsWorkspace":         `select count(uuid) from idm_workspaces where uuid = ?`,
 		"ExistsWorkspaceWithSlug": `select count(uuid) from idm_workspaces where slug = ?`,
@@ -123,7 +124,11 @@ func (s *sqlimpl) Add(in interface{}) (bool, error) {
 		}
 		workspace.Slug = testSlug
 	}
-	stmt, er = s.GetStmt("AddWorkspace")
+	addKey := "AddWorkspace"
+	if s.Driver() == "postgres" {
+		addKey = "AddWorkspace-postgres"
+	}
+	stmt, er = s.GetStmt(addKey)
 	if er != nil {
 		return false, er
 	}
@@ -93,10 +93,12 @@ import (
 
 	// Scheduler Actions
 	_ "github.com/pydio/cells/v4/broker/activity/actions"
+	_ "github.com/pydio/cells/v4/broker/mailer/actions"
 	_ "github.com/pydio/cells/v4/common/etl/actions"
 	_ "github.com/pydio/cells/v4/data/versions"
 	_ "github.com/pydio/cells/v4/scheduler/actions/archive"
 	_ "github.com/pydio/cells/v4/scheduler/actions/cmd"
+	_ "github.com/pydio/cells/v4/scheduler/actions/contents"
 	_ "github.com/pydio/cells/v4/scheduler/actions/idm"
 	_ "github.com/pydio/cells/v4/scheduler/actions/images"
 	_ "github.com/pydio/cells/v4/scheduler/actions/scheduler"
@@ -114,6 +116,7 @@ import (
 	_ "github.com/pydio/cells/v4/common/dao/boltdb"
 	_ "github.com/pydio/cells/v4/common/dao/mongodb"
 	_ "github.com/pydio/cells/v4/common/dao/mysql"
+	_ "github.com/pydio/cells/v4/common/dao/postgres"
 )
 
 func main() {
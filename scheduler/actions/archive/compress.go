@@ -22,20 +22,29 @@ package archive
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"os"
 	"path"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/config"
 	"github.com/pydio/cells/v4/common/forms"
 	"github.com/pydio/cells/v4/common/log"
+	"github.com/pydio/cells/v4/common/nodes"
 	"github.com/pydio/cells/v4/common/nodes/archive"
 	"github.com/pydio/cells/v4/common/nodes/models"
 	"github.com/pydio/cells/v4/common/proto/jobs"
 	"github.com/pydio/cells/v4/common/proto/tree"
 	json "github.com/pydio/cells/v4/common/utils/jsonx"
+	"github.com/pydio/cells/v4/common/utils/uuid"
 	"github.com/pydio/cells/v4/scheduler/actions"
 	"github.com/pydio/cells/v4/scheduler/actions/tools"
 )
@@ -45,19 +54,47 @@ var (
 )
 
 const (
-	detectFormat = "detect"
-	zipFormat    = "zip"
-	tarFormat    = "tar"
-	tarGzFormat  = "tar.gz"
+	detectFormat   = "detect"
+	zipFormat      = archive.FormatZip
+	tarFormat      = archive.FormatTar
+	tarGzFormat    = archive.FormatTarGz
+	tarBz2Format   = archive.FormatTarBz2
+	tarXzFormat    = archive.FormatTarXz
+	tarZstFormat   = archive.FormatTarZst
+	sevenZipFormat = archive.Format7z
+
+	compressCheckpointInterval = 5 * time.Second
 )
 
-// CompressAction implements compression. Currently, it supports zip, tar and tar.gz formats.
+// compressFormats lists the formats that can be produced by the CompressAction
+var compressFormats = []string{zipFormat, tarFormat, tarGzFormat, tarBz2Format, tarXzFormat, tarZstFormat}
+
+// CompressAction implements compression. Currently, it supports zip, tar, tar.gz, tar.bz2, tar.xz and tar.zst formats.
 type CompressAction struct {
 	tools.ScopedRouterConsumer
-	Format     string
-	TargetName string
+	Format      string
+	TargetName  string
+	Password    string
+	PasswordKey string
+	Resumable   bool
+
+	filter       *jobs.NodesSelector
+	checkpointer actions.Checkpointer
+}
+
+// compressCursor is saved as task checkpoint while building a tar archive in a local spool file
+type compressCursor struct {
+	Target string `json:"Target"`
+	Spool  string `json:"Spool"`
+	Offset int64  `json:"Offset"`
+	Last   string `json:"Last"`
+}
 
-	filter *jobs.NodesSelector
+// SetCheckpointer implements ResumableAction. If the action is flagged as Resumable, Tar archives are built in
+// a local spool file that can be appended to when the task is resumed. Otherwise, or for Zip archives whose
+// central directory is only written at the end of the file, archives are streamed and restarted from scratch.
+func (c *CompressAction) SetCheckpointer(cp actions.Checkpointer) {
+	c.checkpointer = cp
 }
 
 // SetNodeFilterAsWalkFilter declares this action as RecursiveNodeWalkerAction
@@ -72,7 +109,7 @@ func (c *CompressAction) GetDescription(lang ...string) actions.ActionDescriptio
 		Category:          actions.ActionCategoryArchives,
 		Label:             "Create Archive",
 		Icon:              "package-down",
-		Description:       "Create a Zip, Tar or Tar.gz archive from the input",
+		Description:       "Create a Zip or Tar archive from the input, Tar being optionally compressed with gzip, bzip2, xz or zstd. Zip archives can be encrypted with a password (WinZip AES-256)",
 		InputDescription:  "Selection of node(s). Folders will be recursively walked through.",
 		OutputDescription: "One single node pointing to the created archive file.",
 		SummaryTemplate:   "",
@@ -106,8 +143,36 @@ func (c *CompressAction) GetParametersForm() *forms.Form {
 						{zipFormat: "Zip"},
 						{tarFormat: "Tar"},
 						{tarGzFormat: "TarGz"},
+						{tarBz2Format: "TarBz2"},
+						{tarXzFormat: "TarXz"},
+						{tarZstFormat: "TarZst"},
 					},
 				},
+				&forms.FormField{
+					Name:        "password",
+					Type:        forms.ParamPassword,
+					Label:       "Password",
+					Description: "Optional password to encrypt a Zip archive with AES-256. Can be evaluated from a job parameter",
+					Mandatory:   false,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "passwordKey",
+					Type:        forms.ParamString,
+					Label:       "Vault Password",
+					Description: "Identifier of the archive password stored in the configuration vault, used if Password is empty",
+					Mandatory:   false,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "resumable",
+					Type:        forms.ParamBool,
+					Label:       "Resumable",
+					Description: "Build Tar archives in a local file before uploading them, so that the task can be resumed after a restart",
+					Default:     false,
+					Mandatory:   false,
+					Editable:    true,
+				},
 			},
 		},
 	}}
@@ -128,6 +193,11 @@ func (c *CompressAction) Init(job *jobs.Job, action *jobs.Action) error {
 	if target, ok := action.Parameters["target"]; ok {
 		c.TargetName = target
 	}
+	c.Password = action.Parameters["password"]
+	c.PasswordKey = action.Parameters["passwordKey"]
+	if resumable, ok := action.Parameters["resumable"]; ok {
+		c.Resumable, _ = strconv.ParseBool(resumable)
+	}
 	c.ParseScope(job.Owner, action.Parameters)
 	return nil
 }
@@ -169,45 +239,63 @@ func (c *CompressAction) Run(ctx context.Context, channels *actions.RunnableChan
 	}
 	format := jobs.EvaluateFieldStr(ctx, input, c.Format)
 	if format == detectFormat {
-		switch strings.ToLower(path.Ext(base)) {
-		case ".zip":
-			format = zipFormat
-		case ".tar":
-			format = tarFormat
-		case ".tar.gz":
-			format = tarGzFormat
-		default:
+		if format = archive.FormatFromPath(base); format == "" {
 			e := fmt.Errorf("could not detect archive format from file name " + base)
 			return input.WithError(e), e
 		}
 	}
 	// Final check for format
-	if format != zipFormat && format != tarFormat && format != tarGzFormat {
+	if !isFormatIn(format, compressFormats) {
 		er := fmt.Errorf("unsupported archive format")
 		return input.WithError(er), er
 	}
+	password, er := resolvePassword(ctx, input, c.Password, c.PasswordKey)
+	if er != nil {
+		return input.WithError(er), er
+	}
+	if password != "" {
+		if format != zipFormat {
+			er := fmt.Errorf("password protection is only supported for zip archives")
+			return input.WithError(er), er
+		}
+		compressor.Password = password
+	}
 	// Remove extension
-	base = strings.TrimSuffix(base, "."+format)
-	targetFile := computeTargetName(ctx, handler, dir, base, format)
+	if len(base) > len(format) && strings.EqualFold(base[len(base)-len(format)-1:], "."+format) {
+		base = base[:len(base)-len(format)-1]
+	}
 
-	reader, writer := io.Pipe()
+	resumable := c.Resumable && c.checkpointer != nil && format != zipFormat
+	var cursor compressCursor
+	var processed int64
+	if resumable {
+		if last, p, ok := c.checkpointer.LastCheckpoint(); ok && json.Unmarshal([]byte(last), &cursor) == nil && cursor.Target != "" {
+			processed = p
+		}
+	}
+	targetFile := cursor.Target
+	if targetFile == "" {
+		targetFile = computeTargetName(ctx, handler, dir, base, format)
+	}
 
 	var written int64
-	var err error
-
-	go func() {
-		defer writer.Close()
-		switch format {
-		case "zip":
-			written, err = compressor.ZipSelection(ctx, writer, input.Nodes, channels.StatusMsg)
-		case "tar":
-			written, err = compressor.TarSelection(ctx, writer, false, input.Nodes, channels.StatusMsg)
-		case "tar.gz":
-			written, err = compressor.TarSelection(ctx, writer, true, input.Nodes, channels.StatusMsg)
-		}
-	}()
+	var err, err2 error
+
+	if resumable {
+		written, err = c.spoolTarSelection(ctx, compressor, handler, targetFile, format, cursor, processed, input.Nodes, channels.StatusMsg)
+	} else {
+		reader, writer := io.Pipe()
+		go func() {
+			defer writer.Close()
+			if format == zipFormat {
+				written, err = compressor.ZipSelection(ctx, writer, input.Nodes, channels.StatusMsg)
+			} else {
+				written, err = compressor.TarSelection(ctx, writer, format, input.Nodes, channels.StatusMsg)
+			}
+		}()
 
-	_, err2 := handler.PutObject(ctx, &tree.Node{Path: targetFile}, reader, &models.PutRequestData{Size: -1})
+		_, err2 = handler.PutObject(ctx, &tree.Node{Path: targetFile}, reader, &models.PutRequestData{Size: -1})
+	}
 
 	if err != nil {
 		log.TasksLogger(ctx).Error("Error PutObject", zap.Error(err))
@@ -238,3 +326,167 @@ func (c *CompressAction) Run(ctx context.Context, channels *actions.RunnableChan
 	}
 	return output, nil
 }
+
+// spoolTarSelection writes a tar archive to a local spool file, saving checkpoints after archived entries, then
+// uploads it to targetFile. If cursor points to an existing spool, the file is truncated to the last checkpoint
+// and the archive is appended from the next entry. Compressed streams are ended at each checkpoint, producing a
+// concatenation of compressed streams (e.g. a multi-member gzip file).
+func (c *CompressAction) spoolTarSelection(ctx context.Context, compressor *archive.Writer, handler nodes.Handler, targetFile string, format string, cursor compressCursor, processed int64, selection []*tree.Node, logs chan string) (int64, error) {
+
+	var file *os.File
+	if cursor.Spool != "" {
+		if f, e := os.OpenFile(cursor.Spool, os.O_RDWR, 0600); e == nil {
+			if f.Truncate(cursor.Offset) == nil {
+				if _, e := f.Seek(cursor.Offset, io.SeekStart); e == nil {
+					file = f
+				}
+			}
+			if file == nil {
+				f.Close()
+			}
+		}
+		if file != nil {
+			log.TasksLogger(ctx).Info(fmt.Sprintf("Resuming archive %s after %s", path.Base(targetFile), cursor.Last))
+			compressor.SkipUntil = cursor.Last
+		} else {
+			log.TasksLogger(ctx).Warn("Cannot reopen partial archive, restarting from scratch")
+			os.Remove(cursor.Spool)
+		}
+	}
+	if file == nil {
+		dir := compressSpoolDir()
+		if e := os.MkdirAll(dir, 0755); e != nil {
+			return 0, e
+		}
+		cursor = compressCursor{Target: targetFile, Spool: filepath.Join(dir, uuid.New())}
+		processed = 0
+		f, e := os.Create(cursor.Spool)
+		if e != nil {
+			return 0, e
+		}
+		file = f
+		compressor.SkipUntil = ""
+	}
+	// Spool is removed when the action returns, as the task then reaches a terminal status. It is kept if the
+	// context was cancelled by a restart, as it is referenced by the last checkpoint if the task is resumed.
+	spool := cursor.Spool
+	defer func() {
+		file.Close()
+		if ctx.Err() == nil {
+			os.Remove(spool)
+		}
+	}()
+
+	counter := &countingWriter{w: file, n: cursor.Offset}
+	output, e := archive.NewTarCompressor(counter, format)
+	if e != nil {
+		return 0, e
+	}
+	lastSave := time.Now()
+	compressor.OnEntry = func(internalPath string) {
+		processed++
+		if time.Since(lastSave) < compressCheckpointInterval {
+			return
+		}
+		lastSave = time.Now()
+		// End current compressed stream so that the spool can be truncated at this offset
+		if e := output.Restart(); e != nil {
+			return
+		}
+		data, _ := json.Marshal(compressCursor{Target: targetFile, Spool: spool, Offset: counter.n, Last: internalPath})
+		c.checkpointer.Checkpoint(string(data), processed)
+	}
+
+	written, e := compressor.TarSelection(ctx, output, tarFormat, selection, logs)
+	if errors.Is(e, archive.ErrSkipUntilNotFound) {
+		log.TasksLogger(ctx).Warn("Selection has changed since task was interrupted, restarting archive from scratch")
+		file.Close()
+		os.Remove(spool)
+		return c.spoolTarSelection(ctx, compressor, handler, targetFile, format, compressCursor{}, 0, selection, logs)
+	} else if e != nil {
+		return written, e
+	}
+	if e := output.Close(); e != nil {
+		return written, e
+	}
+
+	if _, e := file.Seek(0, io.SeekStart); e != nil {
+		return written, e
+	}
+	if _, e := handler.PutObject(ctx, &tree.Node{Path: targetFile}, file, &models.PutRequestData{Size: counter.n}); e != nil {
+		return written, e
+	}
+	return written, nil
+}
+
+// SweepSpools implements SpoolingAction. It removes the spool files created before the given time that are not
+// referenced by the cursors of the tasks being resumed.
+func (c *CompressAction) SweepSpools(ctx context.Context, cursors []string, before time.Time) {
+	dir := compressSpoolDir()
+	entries, e := os.ReadDir(dir)
+	if e != nil {
+		return
+	}
+	referenced := make(map[string]bool, len(cursors))
+	for _, cu := range cursors {
+		var cursor compressCursor
+		if json.Unmarshal([]byte(cu), &cursor) == nil && cursor.Spool != "" {
+			referenced[cursor.Spool] = true
+		}
+	}
+	for _, entry := range entries {
+		spool := filepath.Join(dir, entry.Name())
+		if entry.IsDir() || referenced[spool] {
+			continue
+		}
+		if info, er := entry.Info(); er != nil || !info.ModTime().Before(before) {
+			continue
+		}
+		if er := os.Remove(spool); er != nil {
+			log.Logger(ctx).Warn("Cannot remove unused archive spool", zap.String("spool", spool), zap.Error(er))
+		} else {
+			log.Logger(ctx).Info("Removed unused archive spool " + entry.Name())
+		}
+	}
+}
+
+// compressSpoolDir is the folder where tar archives are built when the action is resumable
+func compressSpoolDir() string {
+	return filepath.Join(config.MustServiceDataDir(common.ServiceGrpcNamespace_+common.ServiceTasks), "archives")
+}
+
+// countingWriter counts bytes written to the spool file
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, e := c.w.Write(p)
+	c.n += int64(n)
+	return n, e
+}
+
+// resolvePassword evaluates the password parameter, falling back to the vault secret identified by passwordKey
+func resolvePassword(ctx context.Context, input jobs.ActionMessage, password, passwordKey string) (string, error) {
+	if p := jobs.EvaluateFieldStr(ctx, input, password); p != "" {
+		return p, nil
+	}
+	if key := jobs.EvaluateFieldStr(ctx, input, passwordKey); key != "" {
+		p := config.GetSecret(key).String()
+		if p == "" {
+			return "", fmt.Errorf("cannot find password " + key + " in vault")
+		}
+		return p, nil
+	}
+	return "", nil
+}
+
+func isFormatIn(format string, formats []string) bool {
+	for _, f := range formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
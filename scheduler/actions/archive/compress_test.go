@@ -54,12 +54,26 @@ func TestCompressAction_Init(t *testing.T) {
 		// Valid Cmd
 		e = action.Init(job, &jobs.Action{
 			Parameters: map[string]string{
-				"format": "tar.gz",
-				"target": "path",
+				"format":      "tar.gz",
+				"target":      "path",
+				"password":    "{{.JobParameters.password}}",
+				"passwordKey": "vault-key",
 			},
 		})
 		So(e, ShouldBeNil)
 		So(action.Format, ShouldEqual, "tar.gz")
 		So(action.TargetName, ShouldEqual, "path")
+		So(action.Password, ShouldEqual, "{{.JobParameters.password}}")
+		So(action.PasswordKey, ShouldEqual, "vault-key")
+		So(action.Resumable, ShouldBeFalse)
+
+		e = action.Init(job, &jobs.Action{
+			Parameters: map[string]string{
+				"format":    "tar.gz",
+				"resumable": "true",
+			},
+		})
+		So(e, ShouldBeNil)
+		So(action.Resumable, ShouldBeTrue)
 	})
 }
@@ -44,8 +44,10 @@ var (
 
 type ExtractAction struct {
 	tools.ScopedRouterConsumer
-	format     string
-	targetName string
+	format      string
+	targetName  string
+	password    string
+	passwordKey string
 }
 
 // GetDescription returns action description
@@ -55,7 +57,7 @@ func (ex *ExtractAction) GetDescription(_ ...string) actions.ActionDescription {
 		Label:             "Extract Archive",
 		Icon:              "package-up",
 		Category:          actions.ActionCategoryArchives,
-		Description:       "Extract files and folders from a Zip, Tar or Tar.gz archive",
+		Description:       "Extract files and folders from a Zip, 7z or Tar archive, Tar being optionally compressed with gzip, bzip2, xz or zstd. Encrypted Zip archives (WinZip AES) require a password",
 		SummaryTemplate:   "",
 		HasForm:           true,
 		InputDescription:  "Single-node selection pointing to an archive to extract",
@@ -90,8 +92,28 @@ func (ex *ExtractAction) GetParametersForm() *forms.Form {
 						{zipFormat: "Zip"},
 						{tarFormat: "Tar"},
 						{tarGzFormat: "TarGz"},
+						{tarBz2Format: "TarBz2"},
+						{tarXzFormat: "TarXz"},
+						{tarZstFormat: "TarZst"},
+						{sevenZipFormat: "7z"},
 					},
 				},
+				&forms.FormField{
+					Name:        "password",
+					Type:        forms.ParamPassword,
+					Label:       "Password",
+					Description: "Password of an encrypted Zip archive (WinZip AES). Can be evaluated from a job parameter",
+					Mandatory:   false,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "passwordKey",
+					Type:        forms.ParamString,
+					Label:       "Vault Password",
+					Description: "Identifier of the archive password stored in the configuration vault, used if Password is empty",
+					Mandatory:   false,
+					Editable:    true,
+				},
 			},
 		},
 	}}
@@ -110,6 +132,8 @@ func (ex *ExtractAction) Init(job *jobs.Job, action *jobs.Action) error {
 	if target, ok := action.Parameters["target"]; ok {
 		ex.targetName = target
 	}
+	ex.password = action.Parameters["password"]
+	ex.passwordKey = action.Parameters["passwordKey"]
 	ex.ParseScope(job.Owner, action.Parameters)
 	return nil
 }
@@ -128,8 +152,9 @@ func (ex *ExtractAction) Run(ctx context.Context, channels *actions.RunnableChan
 
 	archiveNode := input.Nodes[0]
 	ext := filepath.Ext(archiveNode.Path)
-	if ext == ".gz" && strings.HasSuffix(archiveNode.Path, ".tar.gz") {
-		ext = ".tar.gz"
+	if f := archive.FormatFromPath(archiveNode.Path); f != "" {
+		// Keep original case, but include all parts of the extension, e.g. .tar.gz
+		ext = archiveNode.Path[len(archiveNode.Path)-len(f)-1:]
 	}
 	if archiveNode.Size == 0 {
 		resp, e := handler.ReadNode(ctx, &tree.ReadNodeRequest{Node: archiveNode})
@@ -142,7 +167,7 @@ func (ex *ExtractAction) Run(ctx context.Context, channels *actions.RunnableChan
 	format := jobs.EvaluateFieldStr(ctx, input, ex.format)
 	if format == "" || format == detectFormat {
 		format = strings.ToLower(strings.TrimLeft(ext, "."))
-		if format != zipFormat && format != tarFormat && format != tarGzFormat {
+		if !isFormatIn(format, archive.Formats) {
 			e := fmt.Errorf("Could not extract format from file extension (" + ext + ")")
 			return input.WithError(e), e
 		}
@@ -158,17 +183,22 @@ func (ex *ExtractAction) Run(ctx context.Context, channels *actions.RunnableChan
 		return input.WithError(e), e
 	}
 
+	password, er := resolvePassword(ctx, input, ex.password, ex.passwordKey)
+	if er != nil {
+		return input.WithError(er), er
+	}
 	reader := &archive.Reader{
-		Router: handler,
+		Router:   handler,
+		Password: password,
 	}
 	var err error
 	switch format {
-	case "zip":
+	case zipFormat:
 		err = reader.ExtractAllZip(ctx, archiveNode, targetNode, channels.StatusMsg)
-	case "tar":
-		err = reader.ExtractAllTar(ctx, false, archiveNode, targetNode, channels.StatusMsg)
-	case "tar.gz":
-		err = reader.ExtractAllTar(ctx, true, archiveNode, targetNode, channels.StatusMsg)
+	case sevenZipFormat:
+		err = reader.ExtractAll7z(ctx, archiveNode, targetNode, channels.StatusMsg)
+	case tarFormat, tarGzFormat, tarBz2Format, tarXzFormat, tarZstFormat:
+		err = reader.ExtractAllTar(ctx, format, archiveNode, targetNode, channels.StatusMsg)
 	default:
 		err = errors.BadRequest(common.ServiceJobs, "Unsupported archive format:"+format)
 	}
@@ -35,6 +35,18 @@ func init() {
 		return &WGetAction{}
 	})
 
+	manager.Register(pushActionName, func() actions.ConcreteAction {
+		return &PushAction{}
+	})
+
+	manager.Register(webhookActionName, func() actions.ConcreteAction {
+		return &WebhookAction{}
+	})
+
+	manager.Register(shellActionName, func() actions.ConcreteAction {
+		return &ShellAction{}
+	})
+
 	manager.Register(resyncActionName, func() actions.ConcreteAction {
 		return &ResyncAction{}
 	})
@@ -0,0 +1,280 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	minio "github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	remoteDialTimeout     = 30 * time.Second
+	remoteResponseTimeout = 5 * time.Minute
+)
+
+// remoteTarget is an external server where files can be pushed.
+type remoteTarget interface {
+	// MkdirAll creates a folder and its parents if they do not exist
+	MkdirAll(ctx context.Context, p string) error
+	// Put uploads a file and returns its ETag if the server provides one
+	Put(ctx context.Context, p string, r io.Reader, size int64) (string, error)
+	// URL returns a displayable URL of the file, without credentials
+	URL(p string) string
+	// Close releases the connection
+	Close() error
+}
+
+// newRemoteTarget opens a connection to the target, depending on the URL scheme: sftp://, webdav:// or
+// webdavs:// (or plain http:// and https://), and s3:// or s3s://host/bucket. The URL path is the base
+// path of all files sent to the target.
+func newRemoteTarget(u *url.URL, login, secret, hostKey string) (remoteTarget, error) {
+	switch u.Scheme {
+	case "sftp":
+		return newSftpTarget(u, login, secret, hostKey)
+	case "webdav", "webdavs", "http", "https":
+		return newWebdavTarget(u, login, secret), nil
+	case "s3", "s3s":
+		return newS3Target(u, login, secret)
+	default:
+		return nil, fmt.Errorf("unsupported remote scheme %s", u.Scheme)
+	}
+}
+
+// displayURL strips credentials from a target URL and replaces its path.
+func displayURL(u *url.URL, p string) string {
+	du := *u
+	du.User = nil
+	du.RawQuery = ""
+	du.Path = p
+	return du.String()
+}
+
+type sftpTarget struct {
+	base *url.URL
+	conn *ssh.Client
+	cli  *sftp.Client
+}
+
+func newSftpTarget(u *url.URL, login, secret, hostKey string) (*sftpTarget, error) {
+	conf := &ssh.ClientConfig{User: login}
+	if strings.Contains(secret, "PRIVATE KEY") {
+		signer, e := ssh.ParsePrivateKey([]byte(secret))
+		if e != nil {
+			return nil, fmt.Errorf("cannot parse private key: %v", e)
+		}
+		conf.Auth = []ssh.AuthMethod{ssh.PublicKeys(signer)}
+	} else {
+		conf.Auth = []ssh.AuthMethod{ssh.Password(secret)}
+	}
+	if hostKey == "" {
+		return nil, fmt.Errorf("missing host key for SFTP server %s", u.Host)
+	}
+	conf.HostKeyCallback = func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		if ssh.FingerprintSHA256(key) == hostKey {
+			return nil
+		}
+		if pk, _, _, _, e := ssh.ParseAuthorizedKey([]byte(hostKey)); e == nil && string(pk.Marshal()) == string(key.Marshal()) {
+			return nil
+		}
+		return fmt.Errorf("host key %s does not match the expected key", ssh.FingerprintSHA256(key))
+	}
+	conf.Timeout = remoteDialTimeout
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "22")
+	}
+	conn, e := ssh.Dial("tcp", host, conf)
+	if e != nil {
+		return nil, e
+	}
+	cli, e := sftp.NewClient(conn)
+	if e != nil {
+		conn.Close()
+		return nil, e
+	}
+	return &sftpTarget{base: u, conn: conn, cli: cli}, nil
+}
+
+func (s *sftpTarget) MkdirAll(ctx context.Context, p string) error {
+	return s.cli.MkdirAll(p)
+}
+
+func (s *sftpTarget) Put(ctx context.Context, p string, r io.Reader, size int64) (string, error) {
+	f, e := s.cli.Create(p)
+	if e != nil {
+		return "", e
+	}
+	if _, e := f.ReadFrom(r); e != nil {
+		f.Close()
+		return "", e
+	}
+	return "", f.Close()
+}
+
+func (s *sftpTarget) URL(p string) string {
+	return displayURL(s.base, p)
+}
+
+func (s *sftpTarget) Close() error {
+	s.cli.Close()
+	return s.conn.Close()
+}
+
+type webdavTarget struct {
+	base    *url.URL
+	login   string
+	secret  string
+	cli     *http.Client
+	folders map[string]bool
+}
+
+func newWebdavTarget(u *url.URL, login, secret string) *webdavTarget {
+	base := *u
+	switch u.Scheme {
+	case "webdav":
+		base.Scheme = "http"
+	case "webdavs":
+		base.Scheme = "https"
+	}
+	base.User = nil
+	// Do not set a global timeout on the client, as it would interrupt large uploads
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = (&net.Dialer{Timeout: remoteDialTimeout}).DialContext
+	transport.ResponseHeaderTimeout = remoteResponseTimeout
+	return &webdavTarget{base: &base, login: login, secret: secret, cli: &http.Client{Transport: transport}, folders: map[string]bool{}}
+}
+
+func (w *webdavTarget) do(ctx context.Context, method, p string, body io.Reader, size int64) (*http.Response, error) {
+	req, e := http.NewRequestWithContext(ctx, method, w.URL(p), body)
+	if e != nil {
+		return nil, e
+	}
+	if body != nil {
+		req.ContentLength = size
+	}
+	if w.login != "" {
+		req.SetBasicAuth(w.login, w.secret)
+	}
+	return w.cli.Do(req)
+}
+
+// MkdirAll creates the missing folders below the base URL path, which is expected to exist on the server.
+// Folders already created by this target are not requested again.
+func (w *webdavTarget) MkdirAll(ctx context.Context, p string) error {
+	p = path.Clean("/" + p)
+	if p == "/" || p == path.Clean("/"+w.base.Path) || w.folders[p] {
+		return nil
+	}
+	if e := w.MkdirAll(ctx, path.Dir(p)); e != nil {
+		return e
+	}
+	resp, e := w.do(ctx, "MKCOL", p+"/", nil, 0)
+	if e != nil {
+		return e
+	}
+	resp.Body.Close()
+	// 405 is returned when the collection already exists
+	if resp.StatusCode >= 300 && resp.StatusCode != http.StatusMethodNotAllowed {
+		return fmt.Errorf("cannot create folder %s: %s", p, resp.Status)
+	}
+	w.folders[p] = true
+	return nil
+}
+
+func (w *webdavTarget) Put(ctx context.Context, p string, r io.Reader, size int64) (string, error) {
+	resp, e := w.do(ctx, http.MethodPut, p, r, size)
+	if e != nil {
+		return "", e
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return "", fmt.Errorf("cannot upload %s: %s", p, resp.Status)
+	}
+	return strings.Trim(resp.Header.Get("ETag"), "\""), nil
+}
+
+func (w *webdavTarget) URL(p string) string {
+	return displayURL(w.base, p)
+}
+
+func (w *webdavTarget) Close() error {
+	return nil
+}
+
+type s3Target struct {
+	base   *url.URL
+	mc     *minio.Client
+	bucket string
+}
+
+func newS3Target(u *url.URL, login, secret string) (*s3Target, error) {
+	bucket := strings.SplitN(strings.TrimPrefix(u.Path, "/"), "/", 2)[0]
+	if bucket == "" {
+		return nil, fmt.Errorf("missing bucket name in s3 URL")
+	}
+	mc, e := minio.New(u.Host, &minio.Options{
+		Creds:  credentials.NewStaticV4(login, secret, ""),
+		Secure: u.Scheme == "s3s",
+		Region: u.Query().Get("region"),
+	})
+	if e != nil {
+		return nil, e
+	}
+	return &s3Target{base: u, mc: mc, bucket: bucket}, nil
+}
+
+// key removes the bucket name from the path to compute the object key
+func (s *s3Target) key(p string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(p, "/"), s.bucket+"/")
+}
+
+func (s *s3Target) MkdirAll(ctx context.Context, p string) error {
+	// Folders do not exist on S3
+	return nil
+}
+
+func (s *s3Target) Put(ctx context.Context, p string, r io.Reader, size int64) (string, error) {
+	info, e := s.mc.PutObject(ctx, s.bucket, s.key(p), r, size, minio.PutObjectOptions{})
+	if e != nil {
+		return "", e
+	}
+	return info.ETag, nil
+}
+
+func (s *s3Target) URL(p string) string {
+	return displayURL(s.base, path.Join("/", p))
+}
+
+func (s *s3Target) Close() error {
+	return nil
+}
@@ -0,0 +1,303 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/client/grpc"
+	"github.com/pydio/cells/v4/common/config"
+	"github.com/pydio/cells/v4/common/forms"
+	"github.com/pydio/cells/v4/common/log"
+	"github.com/pydio/cells/v4/common/nodes"
+	"github.com/pydio/cells/v4/common/nodes/compose"
+	"github.com/pydio/cells/v4/common/nodes/models"
+	"github.com/pydio/cells/v4/common/proto/jobs"
+	"github.com/pydio/cells/v4/common/proto/tree"
+	"github.com/pydio/cells/v4/common/service/errors"
+	json "github.com/pydio/cells/v4/common/utils/jsonx"
+	"github.com/pydio/cells/v4/scheduler/actions"
+)
+
+var (
+	pushActionName = "actions.cmd.push"
+)
+
+const (
+	// MetaRemotePush stores the location of the last copy pushed to a remote server
+	MetaRemotePush = "remote_push"
+)
+
+// RemotePushMeta is stored as node metadata after a successful push
+type RemotePushMeta struct {
+	Url  string `json:"Url"`
+	ETag string `json:"ETag,omitempty"`
+	Time int64  `json:"Time"`
+}
+
+// PushAction uploads the input nodes to an external SFTP server, WebDAV share or S3 bucket
+type PushAction struct {
+	common.RuntimeHolder
+	Router     nodes.Handler
+	MetaClient tree.NodeReceiverClient
+	remoteUrl  string
+	targetPath string
+	login      string
+	secretKey  string
+	hostKey    string
+}
+
+// GetDescription returns action description
+func (p *PushAction) GetDescription(lang ...string) actions.ActionDescription {
+	return actions.ActionDescription{
+		ID:                pushActionName,
+		Category:          actions.ActionCategoryPutGet,
+		Label:             "Push to Remote",
+		Icon:              "upload",
+		Description:       "Upload files or folders to an external SFTP server, WebDAV share or S3 bucket",
+		InputDescription:  "Multiple selection of files or folders",
+		OutputDescription: "List of uploaded files with their remote URL",
+		SummaryTemplate:   "",
+		HasForm:           true,
+	}
+}
+
+// GetParametersForm returns a UX form
+func (p *PushAction) GetParametersForm() *forms.Form {
+	return &forms.Form{Groups: []*forms.Group{
+		{
+			Fields: []forms.Field{
+				&forms.FormField{
+					Name:        "url",
+					Type:        forms.ParamString,
+					Label:       "Remote URL",
+					Description: "Target server and base path, e.g. sftp://host:22/archives, webdavs://host/remote.php/dav/files/user or s3s://s3.amazonaws.com/bucket/prefix",
+					Mandatory:   true,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "targetPath",
+					Type:        forms.ParamString,
+					Label:       "Target Path",
+					Description: "Optional sub-folder of the base path where to upload files",
+					Mandatory:   false,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "login",
+					Type:        forms.ParamString,
+					Label:       "Login",
+					Description: "User name, or Access Key for S3",
+					Mandatory:   false,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "secretKey",
+					Type:        forms.ParamString,
+					Label:       "Vault Secret",
+					Description: "Identifier of the password, private key or S3 secret stored in the configuration vault",
+					Mandatory:   false,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "hostKey",
+					Type:        forms.ParamString,
+					Label:       "SFTP Host Key",
+					Description: "Expected public key of the SFTP server, as a SHA256 fingerprint or an authorized_keys line. Required for sftp:// targets",
+					Mandatory:   false,
+					Editable:    true,
+				},
+			},
+		},
+	}}
+}
+
+// GetName returns the unique identifier of this action
+func (p *PushAction) GetName() string {
+	return pushActionName
+}
+
+// Init passes parameters
+func (p *PushAction) Init(job *jobs.Job, action *jobs.Action) error {
+	var ok bool
+	if p.remoteUrl, ok = action.Parameters["url"]; !ok || p.remoteUrl == "" {
+		return errors.BadRequest(common.ServiceTasks, "missing parameter url in Action")
+	}
+	p.targetPath = action.Parameters["targetPath"]
+	p.login = action.Parameters["login"]
+	p.secretKey = action.Parameters["secretKey"]
+	p.hostKey = action.Parameters["hostKey"]
+	if strings.HasPrefix(p.remoteUrl, "sftp://") && p.hostKey == "" {
+		return errors.BadRequest(common.ServiceTasks, "missing parameter hostKey for SFTP target")
+	}
+	if !nodes.IsUnitTestEnv {
+		p.Router = compose.PathClientAdmin(p.GetRuntimeContext())
+		p.MetaClient = tree.NewNodeReceiverClient(grpc.GetClientConnFromCtx(p.GetRuntimeContext(), common.ServiceMeta))
+	}
+	return nil
+}
+
+// Run the actual action code
+func (p *PushAction) Run(ctx context.Context, channels *actions.RunnableChannels, input jobs.ActionMessage) (jobs.ActionMessage, error) {
+
+	if len(input.Nodes) == 0 {
+		return input.WithIgnore(), nil
+	}
+
+	u, e := url.Parse(jobs.EvaluateFieldStr(ctx, input, p.remoteUrl))
+	if e != nil {
+		return input.WithError(e), e
+	}
+	login := jobs.EvaluateFieldStr(ctx, input, p.login)
+	if login == "" && u.User != nil {
+		login = u.User.Username()
+	}
+	var secret string
+	if p.secretKey != "" {
+		if secret = config.GetSecret(p.secretKey).String(); secret == "" {
+			e := errors.NotFound(common.ServiceTasks, "cannot find secret "+p.secretKey+" in vault")
+			return input.WithError(e), e
+		}
+	}
+	target, e := newRemoteTarget(u, login, secret, p.hostKey)
+	if e != nil {
+		return input.WithError(e), e
+	}
+	defer target.Close()
+
+	basePath := path.Join("/", u.Path, jobs.EvaluateFieldStr(ctx, input, p.targetPath))
+	if e := target.MkdirAll(ctx, basePath); e != nil {
+		return input.WithError(e), e
+	}
+
+	var pushed []*RemotePushMeta
+	for _, n := range input.Nodes {
+		resp, er := p.Router.ReadNode(ctx, &tree.ReadNodeRequest{Node: n})
+		if er != nil {
+			return input.WithError(er), er
+		}
+		node := resp.GetNode()
+		remotePath := path.Join(basePath, path.Base(node.GetPath()))
+		if node.IsLeaf() {
+			m, er := p.pushFile(ctx, target, node, remotePath)
+			if er != nil {
+				return input.WithError(er), er
+			}
+			pushed = append(pushed, m)
+			continue
+		}
+		mm, er := p.pushFolder(ctx, target, node, remotePath)
+		if er != nil {
+			return input.WithError(er), er
+		}
+		pushed = append(pushed, mm...)
+		p.storeMeta(ctx, node, &RemotePushMeta{Url: target.URL(remotePath), Time: time.Now().Unix()})
+	}
+
+	log.TasksLogger(ctx).Info(fmt.Sprintf("Pushed %d file(s) to %s", len(pushed), target.URL(basePath)))
+	jsonBody, _ := json.Marshal(pushed)
+	input.AppendOutput(&jobs.ActionOutput{
+		Success:  true,
+		JsonBody: jsonBody,
+	})
+	return input, nil
+}
+
+// pushFolder recursively creates the folder and its children on the target
+func (p *PushAction) pushFolder(ctx context.Context, target remoteTarget, folder *tree.Node, remotePath string) ([]*RemotePushMeta, error) {
+	if e := target.MkdirAll(ctx, remotePath); e != nil {
+		return nil, e
+	}
+	streamer, e := p.Router.ListNodes(ctx, &tree.ListNodesRequest{Node: folder, Recursive: true})
+	if e != nil {
+		return nil, e
+	}
+	defer streamer.CloseSend()
+	var children []*tree.Node
+	for {
+		resp, er := streamer.Recv()
+		if er == io.EOF {
+			break
+		} else if er != nil {
+			return nil, er
+		}
+		if resp.GetNode() != nil && path.Base(resp.GetNode().GetPath()) != common.PydioSyncHiddenFile {
+			children = append(children, resp.GetNode())
+		}
+	}
+	var pushed []*RemotePushMeta
+	for _, child := range children {
+		rel := strings.TrimPrefix(strings.TrimPrefix(child.GetPath(), strings.TrimRight(folder.GetPath(), "/")), "/")
+		childPath := path.Join(remotePath, rel)
+		if !child.IsLeaf() {
+			if e := target.MkdirAll(ctx, childPath); e != nil {
+				return nil, e
+			}
+			continue
+		}
+		if e := target.MkdirAll(ctx, path.Dir(childPath)); e != nil {
+			return nil, e
+		}
+		m, e := p.pushFile(ctx, target, child, childPath)
+		if e != nil {
+			return nil, e
+		}
+		pushed = append(pushed, m)
+	}
+	return pushed, nil
+}
+
+// pushFile streams the file content to the target and records the remote location as metadata
+func (p *PushAction) pushFile(ctx context.Context, target remoteTarget, node *tree.Node, remotePath string) (*RemotePushMeta, error) {
+	reader, e := p.Router.GetObject(ctx, node, &models.GetRequestData{Length: node.GetSize()})
+	if e != nil {
+		return nil, e
+	}
+	defer reader.Close()
+	etag, e := target.Put(ctx, remotePath, reader, node.GetSize())
+	if e != nil {
+		return nil, e
+	}
+	m := &RemotePushMeta{Url: target.URL(remotePath), ETag: etag, Time: time.Now().Unix()}
+	log.TasksLogger(ctx).Debug("Pushed "+node.GetPath()+" to "+m.Url, node.ZapPath())
+	p.storeMeta(ctx, node, m)
+	return m, nil
+}
+
+func (p *PushAction) storeMeta(ctx context.Context, node *tree.Node, m *RemotePushMeta) {
+	if p.MetaClient == nil || node.GetUuid() == "" {
+		return
+	}
+	metaNode := &tree.Node{Uuid: node.GetUuid(), Path: node.GetPath()}
+	metaNode.MustSetMeta(MetaRemotePush, m)
+	if _, e := p.MetaClient.UpdateNode(ctx, &tree.UpdateNodeRequest{From: metaNode, To: metaNode}); e != nil {
+		log.TasksLogger(ctx).Warn("Cannot store remote location for "+node.GetPath(), zap.Error(e))
+	}
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/v4/common/nodes"
+	"github.com/pydio/cells/v4/common/proto/jobs"
+	"github.com/pydio/cells/v4/common/proto/tree"
+	json "github.com/pydio/cells/v4/common/utils/jsonx"
+	"github.com/pydio/cells/v4/scheduler/actions"
+)
+
+func TestPushAction_Init(t *testing.T) {
+
+	Convey("Test Init", t, func() {
+		action := &PushAction{}
+		So(action.GetName(), ShouldEqual, pushActionName)
+		So(action.Init(&jobs.Job{}, &jobs.Action{}), ShouldNotBeNil)
+		So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{"url": "ftp://host/path"}}), ShouldBeNil)
+		_, e := action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{Nodes: []*tree.Node{{Path: "file"}}})
+		So(e, ShouldNotBeNil)
+		So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{"url": "sftp://host/path"}}), ShouldNotBeNil)
+		So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{"url": "sftp://host/path", "hostKey": "SHA256:abc"}}), ShouldBeNil)
+		u, _ := url.Parse("sftp://host/path")
+		_, e = newRemoteTarget(u, "john", "secret", "")
+		So(e, ShouldNotBeNil)
+	})
+}
+
+func TestPushAction_RunWebdav(t *testing.T) {
+
+	Convey("Push files and folders to a WebDAV server", t, func() {
+		var lock sync.Mutex
+		folders := map[string]int{}
+		files := map[string]string{}
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lock.Lock()
+			defer lock.Unlock()
+			if u, p, _ := r.BasicAuth(); u != "john" || p != "" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			switch r.Method {
+			case "MKCOL":
+				folders[r.URL.Path]++
+				if folders[r.URL.Path] > 1 {
+					w.WriteHeader(http.StatusMethodNotAllowed)
+					return
+				}
+				w.WriteHeader(http.StatusCreated)
+			case http.MethodPut:
+				data, _ := io.ReadAll(r.Body)
+				files[r.URL.Path] = string(data)
+				w.Header().Set("ETag", `"etag-`+r.URL.Path+`"`)
+				w.WriteHeader(http.StatusCreated)
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		}))
+		defer srv.Close()
+
+		mock := nodes.NewHandlerMock()
+		for _, n := range []*tree.Node{
+			{Path: "single.txt", Type: tree.NodeType_LEAF},
+			{Path: "folder", Type: tree.NodeType_COLLECTION},
+			{Path: "folder/a.txt", Type: tree.NodeType_LEAF},
+			{Path: "folder/sub", Type: tree.NodeType_COLLECTION},
+			{Path: "folder/sub/b.txt", Type: tree.NodeType_LEAF},
+		} {
+			// Mock content is the node path followed by "hello world"
+			if n.IsLeaf() {
+				n.Size = int64(len(n.Path + "hello world"))
+			}
+			mock.Nodes[n.Path] = n
+		}
+
+		action := &PushAction{}
+		So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{
+			"url":        "webdav://john@" + srv.Listener.Addr().String() + "/base",
+			"targetPath": "archives",
+		}}), ShouldBeNil)
+		action.Router = mock
+
+		output, e := action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{
+			Nodes: []*tree.Node{{Path: "single.txt"}, {Path: "folder"}},
+		})
+		So(e, ShouldBeNil)
+		// Base path is not created, and each folder is created once
+		So(folders, ShouldResemble, map[string]int{
+			"/base/archives/":            1,
+			"/base/archives/folder/":     1,
+			"/base/archives/folder/sub/": 1,
+		})
+		So(files, ShouldHaveLength, 3)
+		So(files["/base/archives/single.txt"], ShouldEqual, "single.txthello world")
+		So(files["/base/archives/folder/sub/b.txt"], ShouldEqual, "folder/sub/b.txthello world")
+
+		var pushed []*RemotePushMeta
+		So(json.Unmarshal(output.GetLastOutput().GetJsonBody(), &pushed), ShouldBeNil)
+		So(pushed, ShouldHaveLength, 3)
+		So(pushed[0].Url, ShouldEqual, srv.URL+"/base/archives/single.txt")
+		So(pushed[0].ETag, ShouldEqual, "etag-/base/archives/single.txt")
+	})
+}
@@ -0,0 +1,446 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/config"
+	"github.com/pydio/cells/v4/common/forms"
+	"github.com/pydio/cells/v4/common/log"
+	"github.com/pydio/cells/v4/common/nodes"
+	"github.com/pydio/cells/v4/common/nodes/compose"
+	"github.com/pydio/cells/v4/common/nodes/models"
+	"github.com/pydio/cells/v4/common/proto/jobs"
+	"github.com/pydio/cells/v4/common/proto/tree"
+	"github.com/pydio/cells/v4/common/service/errors"
+	json "github.com/pydio/cells/v4/common/utils/jsonx"
+	"github.com/pydio/cells/v4/scheduler/actions"
+)
+
+var (
+	shellActionName = "actions.cmd.shell"
+)
+
+const (
+	// Placeholders replaced in the command arguments
+	shellInputPlaceholder     = "$INPUT"
+	shellInputsPlaceholder    = "$INPUTS"
+	shellOutputDirPlaceholder = "$OUTDIR"
+
+	// shellMaxOutputSize limits the size of stdout and stderr kept in the action output
+	shellMaxOutputSize = 64 * 1024
+)
+
+// ShellAction runs an external command on local copies of the input nodes, and uploads the produced files.
+// Commands must be allowed by an administrator in the "commands" list of the tasks service configuration.
+type ShellAction struct {
+	common.RuntimeHolder
+	Router       nodes.Handler
+	command      string
+	args         string
+	targetFolder string
+	timeout      time.Duration
+}
+
+// ShellOutput is the JSON body of the action output
+type ShellOutput struct {
+	ExitCode int      `json:"ExitCode"`
+	Stdout   string   `json:"Stdout"`
+	Stderr   string   `json:"Stderr"`
+	Files    []string `json:"Files"`
+}
+
+// GetDescription returns action description
+func (s *ShellAction) GetDescription(lang ...string) actions.ActionDescription {
+	return actions.ActionDescription{
+		ID:                shellActionName,
+		Label:             "Run Command",
+		Category:          actions.ActionCategoryCmd,
+		Icon:              "console",
+		Description:       "Run an external command allowed by the administrator (services/" + common.ServiceGrpcNamespace_ + common.ServiceTasks + "/commands) on local copies of the input files",
+		InputDescription:  "Files to be passed to the command",
+		OutputDescription: "Files produced by the command",
+		SummaryTemplate:   "",
+		HasForm:           true,
+	}
+}
+
+// GetParametersForm returns a UX form
+func (s *ShellAction) GetParametersForm() *forms.Form {
+	return &forms.Form{Groups: []*forms.Group{
+		{
+			Fields: []forms.Field{
+				&forms.FormField{
+					Name:        "command",
+					Type:        forms.ParamString,
+					Label:       "Command",
+					Description: "Name of the command, must be allowed in the tasks service configuration",
+					Mandatory:   true,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "args",
+					Type:        forms.ParamTextarea,
+					Label:       "Arguments",
+					Description: "One argument per line. Use $INPUT for the first input file, $OUTDIR for the folder where output files must be written, and $INPUTS alone on the last line to pass all input files",
+					Mandatory:   false,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "targetFolder",
+					Type:        forms.ParamString,
+					Label:       "Target Folder",
+					Description: "Folder where output files are uploaded, defaults to the folder of the first input file",
+					Mandatory:   false,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "timeout",
+					Type:        forms.ParamString,
+					Label:       "Timeout",
+					Description: "Maximum execution time (10s, 10m, 1h...)",
+					Default:     "10m",
+					Mandatory:   false,
+					Editable:    true,
+				},
+			},
+		},
+	}}
+}
+
+// GetName provides unique identifier
+func (s *ShellAction) GetName() string {
+	return shellActionName
+}
+
+// CanStop tells the task it can be interrupted
+func (s *ShellAction) CanStop() bool {
+	return true
+}
+
+// CanPause is not supported
+func (s *ShellAction) CanPause() bool {
+	return false
+}
+
+// Init passes parameters
+func (s *ShellAction) Init(job *jobs.Job, action *jobs.Action) error {
+	if s.command = action.Parameters["command"]; s.command == "" {
+		return errors.BadRequest(common.ServiceTasks, "missing parameter command in Action")
+	}
+	s.args = action.Parameters["args"]
+	var lines []string
+	for _, line := range strings.Split(s.args, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	for i, line := range lines {
+		if strings.Contains(line, shellInputsPlaceholder) && (line != shellInputsPlaceholder || i < len(lines)-1) {
+			return errors.BadRequest(common.ServiceTasks, shellInputsPlaceholder+" must be alone on the last line of arguments")
+		}
+	}
+	s.targetFolder = action.Parameters["targetFolder"]
+	s.timeout = 10 * time.Minute
+	if t := action.Parameters["timeout"]; t != "" {
+		d, e := time.ParseDuration(t)
+		if e != nil || d <= 0 {
+			return errors.BadRequest(common.ServiceTasks, "invalid parameter timeout in Action")
+		}
+		s.timeout = d
+	}
+	if !nodes.IsUnitTestEnv {
+		s.Router = compose.PathClientAdmin(s.GetRuntimeContext())
+	}
+	return nil
+}
+
+// Run perform actual action code
+func (s *ShellAction) Run(ctx context.Context, channels *actions.RunnableChannels, input jobs.ActionMessage) (jobs.ActionMessage, error) {
+
+	binary, e := s.resolveCommand()
+	if e != nil {
+		return input.WithError(e), e
+	}
+
+	workDir, e := os.MkdirTemp("", "cells-cmd-")
+	if e != nil {
+		return input.WithError(e), e
+	}
+	defer os.RemoveAll(workDir)
+	inDir, outDir := filepath.Join(workDir, "in"), filepath.Join(workDir, "out")
+	for _, d := range []string{inDir, outDir} {
+		if e := os.Mkdir(d, 0700); e != nil {
+			return input.WithError(e), e
+		}
+	}
+
+	localFiles, e := s.materialize(ctx, input.Nodes, inDir)
+	if e != nil {
+		return input.WithError(e), e
+	}
+	args := s.buildArgs(ctx, &input, localFiles, outDir)
+
+	runCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	stopped := make(chan struct{})
+	go func() {
+		select {
+		case <-channels.Stop:
+			close(stopped)
+			cancel()
+		case <-runCtx.Done():
+		}
+	}()
+
+	stdout, stderr := &limitedBuffer{max: shellMaxOutputSize}, &limitedBuffer{max: shellMaxOutputSize}
+	cmd := exec.Command(binary, args...)
+	cmd.Dir = workDir
+	cmd.Env = []string{"PATH=" + os.Getenv("PATH"), "HOME=" + workDir, "TMPDIR=" + workDir}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	setProcessGroup(cmd)
+	log.TasksLogger(ctx).Info("Running command " + s.command + " " + strings.Join(args, " "))
+	runErr := cmd.Start()
+	if runErr == nil {
+		exited := make(chan struct{})
+		go func() {
+			select {
+			case <-runCtx.Done():
+				// Kill children as well, otherwise they keep the output pipes open
+				killProcessGroup(cmd)
+			case <-exited:
+			}
+		}()
+		runErr = cmd.Wait()
+		close(exited)
+	}
+
+	output := &ShellOutput{Stdout: stdout.String(), Stderr: stderr.String()}
+	if cmd.ProcessState != nil {
+		output.ExitCode = cmd.ProcessState.ExitCode()
+	}
+	select {
+	case <-stopped:
+		runErr = fmt.Errorf("command was interrupted")
+	default:
+		if runCtx.Err() == context.DeadlineExceeded {
+			runErr = fmt.Errorf("command timed out after %s", s.timeout)
+		}
+	}
+	if runErr != nil {
+		log.TasksLogger(ctx).Error("Command "+s.command+" failed", zap.Error(runErr), zap.String("stderr", output.Stderr))
+		jsonBody, _ := json.Marshal(output)
+		input.AppendOutput(&jobs.ActionOutput{
+			ErrorString: runErr.Error(),
+			StringBody:  output.Stdout,
+			JsonBody:    jsonBody,
+		})
+		return input, runErr
+	}
+
+	uploaded, e := s.uploadOutputs(ctx, &input, outDir)
+	if e != nil {
+		return input.WithError(e), e
+	}
+	for _, n := range uploaded {
+		output.Files = append(output.Files, n.GetPath())
+		input.Nodes = append(input.Nodes, n)
+	}
+	log.TasksLogger(ctx).Info(fmt.Sprintf("Command %s succeeded and produced %d file(s)", s.command, len(output.Files)))
+
+	jsonBody, _ := json.Marshal(output)
+	input.AppendOutput(&jobs.ActionOutput{
+		Success:    true,
+		StringBody: output.Stdout,
+		JsonBody:   jsonBody,
+	})
+	return input, nil
+}
+
+// DryRun checks that the command is allowed and describes the command line that would be executed,
+// using the input nodes paths in place of their local copies.
+func (s *ShellAction) DryRun(ctx context.Context, input *jobs.ActionMessage) (*jobs.ActionMessage, string, error) {
+	if _, e := s.resolveCommand(); e != nil {
+		return nil, "", e
+	}
+	var files []string
+	for _, n := range input.Nodes {
+		files = append(files, n.GetPath())
+	}
+	args := s.buildArgs(ctx, input, files, shellOutputDirPlaceholder)
+	plan := "Would run command " + strings.TrimSpace(s.command+" "+strings.Join(args, " "))
+	if target := s.uploadTarget(ctx, input); target != "" {
+		plan += ", and upload produced files to " + target
+	}
+	return input, plan, nil
+}
+
+// resolveCommand checks that the command is allowed in the configuration and finds its binary.
+func (s *ShellAction) resolveCommand() (string, error) {
+	allowed := config.Get("services", common.ServiceGrpcNamespace_+common.ServiceTasks, "commands").StringArray()
+	for _, a := range allowed {
+		if a == s.command {
+			return exec.LookPath(a)
+		}
+	}
+	return "", errors.Forbidden(common.ServiceTasks, "command "+s.command+" is not allowed, it must be added to the tasks service commands")
+}
+
+// materialize copies the input files to the local folder, and returns their local paths.
+func (s *ShellAction) materialize(ctx context.Context, nn []*tree.Node, dir string) ([]string, error) {
+	var files []string
+	for i, n := range nn {
+		resp, e := s.Router.ReadNode(ctx, &tree.ReadNodeRequest{Node: n})
+		if e != nil {
+			return nil, e
+		}
+		node := resp.GetNode()
+		if !node.IsLeaf() {
+			log.TasksLogger(ctx).Warn("Ignoring folder " + node.GetPath() + ", only files can be passed to a command")
+			continue
+		}
+		// Prefix with index to avoid collisions between files with the same name
+		local := filepath.Join(dir, fmt.Sprintf("%d-%s", i, path.Base(node.GetPath())))
+		if e := s.download(ctx, node, local); e != nil {
+			return nil, e
+		}
+		files = append(files, local)
+	}
+	return files, nil
+}
+
+func (s *ShellAction) download(ctx context.Context, node *tree.Node, local string) error {
+	reader, e := s.Router.GetObject(ctx, node, &models.GetRequestData{Length: node.GetSize()})
+	if e != nil {
+		return e
+	}
+	defer reader.Close()
+	f, e := os.OpenFile(local, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if e != nil {
+		return e
+	}
+	if _, e := io.Copy(f, reader); e != nil {
+		f.Close()
+		return e
+	}
+	return f.Close()
+}
+
+// buildArgs evaluates each argument line and replaces the input/output placeholders. The $INPUTS line is expanded
+// to one argument per file, after a "--" separator so that file names are never read as options.
+func (s *ShellAction) buildArgs(ctx context.Context, input *jobs.ActionMessage, files []string, outDir string) []string {
+	var first string
+	if len(files) > 0 {
+		first = files[0]
+	}
+	var args []string
+	for _, line := range strings.Split(s.args, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if line == shellInputsPlaceholder {
+			args = append(args, "--")
+			args = append(args, files...)
+			continue
+		}
+		line = jobs.EvaluateFieldStr(ctx, *input, line)
+		line = strings.ReplaceAll(line, shellOutputDirPlaceholder, outDir)
+		line = strings.ReplaceAll(line, shellInputPlaceholder, first)
+		args = append(args, line)
+	}
+	return args
+}
+
+// uploadTarget evaluates the target folder, or falls back to the folder of the first input node. It returns an
+// empty string if no folder can be found.
+func (s *ShellAction) uploadTarget(ctx context.Context, input *jobs.ActionMessage) string {
+	target := jobs.EvaluateFieldStr(ctx, *input, s.targetFolder)
+	if target == "" && len(input.Nodes) > 0 {
+		target = path.Dir(input.Nodes[0].GetPath())
+	}
+	if strings.Trim(target, "/.") == "" {
+		return ""
+	}
+	return target
+}
+
+// uploadOutputs sends all files found in the output folder to the target folder. Files are never uploaded to the
+// root of the router.
+func (s *ShellAction) uploadOutputs(ctx context.Context, input *jobs.ActionMessage, outDir string) ([]*tree.Node, error) {
+	target := s.uploadTarget(ctx, input)
+	var uploaded []*tree.Node
+	e := filepath.Walk(outDir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !info.Mode().IsRegular() {
+			return err
+		}
+		if target == "" {
+			return errors.BadRequest(common.ServiceTasks, "command produced files but no target folder could be found, please set the targetFolder parameter")
+		}
+		rel, _ := filepath.Rel(outDir, p)
+		f, er := os.Open(p)
+		if er != nil {
+			return er
+		}
+		defer f.Close()
+		node := &tree.Node{Path: path.Join(target, filepath.ToSlash(rel)), Type: tree.NodeType_LEAF}
+		if _, er := s.Router.PutObject(ctx, node, f, &models.PutRequestData{Size: info.Size()}); er != nil {
+			return er
+		}
+		if resp, er := s.Router.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Path: node.Path}}); er == nil {
+			node = resp.GetNode()
+		}
+		uploaded = append(uploaded, node)
+		return nil
+	})
+	return uploaded, e
+}
+
+// limitedBuffer keeps the first max bytes written and discards the rest.
+type limitedBuffer struct {
+	bytes.Buffer
+	max int
+}
+
+func (l *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := l.max - l.Buffer.Len(); remaining > 0 {
+		if len(p) > remaining {
+			l.Buffer.Write(p[:remaining])
+		} else {
+			l.Buffer.Write(p)
+		}
+	}
+	return len(p), nil
+}
@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/config"
+	"github.com/pydio/cells/v4/common/nodes"
+	"github.com/pydio/cells/v4/common/proto/jobs"
+	"github.com/pydio/cells/v4/common/proto/tree"
+	json "github.com/pydio/cells/v4/common/utils/jsonx"
+	"github.com/pydio/cells/v4/scheduler/actions"
+)
+
+func TestShellAction_Init(t *testing.T) {
+
+	Convey("Test Init", t, func() {
+		action := &ShellAction{}
+		So(action.GetName(), ShouldEqual, shellActionName)
+		So(action.Init(&jobs.Job{}, &jobs.Action{}), ShouldNotBeNil)
+		So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{"command": "cp", "timeout": "never"}}), ShouldNotBeNil)
+		So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{"command": "cp", "timeout": "1m"}}), ShouldBeNil)
+		So(action.timeout, ShouldEqual, time.Minute)
+		So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{"command": "cp", "args": "$INPUTS $OUTDIR"}}), ShouldNotBeNil)
+		So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{"command": "cp", "args": "$INPUTS\n$OUTDIR"}}), ShouldNotBeNil)
+		So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{"command": "cp", "args": "-t\n$OUTDIR\n$INPUTS\n"}}), ShouldBeNil)
+		args := action.buildArgs(context.Background(), &jobs.ActionMessage{}, []string{"/in/-a", "/in/b c"}, "/out")
+		So(args, ShouldResemble, []string{"-t", "/out", "--", "/in/-a", "/in/b c"})
+	})
+}
+
+func TestShellAction_Run(t *testing.T) {
+
+	Convey("Run whitelisted commands on local copies of the input files", t, func() {
+		So(config.Set([]string{"cp", "sleep", "sh"}, "services", common.ServiceGrpcNamespace_+common.ServiceTasks, "commands"), ShouldBeNil)
+		rootDir := t.TempDir()
+		So(os.WriteFile(filepath.Join(rootDir, "source.txt"), []byte("some content"), 0600), ShouldBeNil)
+		So(os.Mkdir(filepath.Join(rootDir, "target"), 0700), ShouldBeNil)
+		mock := nodes.NewHandlerMock()
+		mock.RootDir = rootDir
+		mock.Nodes["source.txt"] = &tree.Node{Path: "source.txt", Type: tree.NodeType_LEAF, Size: 12}
+
+		Convey("Command must be allowed", func() {
+			action := &ShellAction{}
+			So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{"command": "rm", "args": "-rf\n/"}}), ShouldBeNil)
+			action.Router = mock
+			_, e := action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{Nodes: []*tree.Node{{Path: "source.txt"}}})
+			So(e, ShouldNotBeNil)
+		})
+
+		Convey("Output files are uploaded to the target folder", func() {
+			action := &ShellAction{}
+			So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{
+				"command":      "cp",
+				"args":         "$INPUT\n$OUTDIR/copy.txt",
+				"targetFolder": "target",
+			}}), ShouldBeNil)
+			action.Router = mock
+			output, e := action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{Nodes: []*tree.Node{{Path: "source.txt"}}})
+			So(e, ShouldBeNil)
+			data, e := os.ReadFile(filepath.Join(rootDir, "target", "copy.txt"))
+			So(e, ShouldBeNil)
+			So(string(data), ShouldEqual, "some content")
+			So(output.Nodes, ShouldHaveLength, 2)
+			So(output.Nodes[1].GetPath(), ShouldEqual, "target/copy.txt")
+			var result ShellOutput
+			So(json.Unmarshal(output.GetLastOutput().GetJsonBody(), &result), ShouldBeNil)
+			So(result.ExitCode, ShouldEqual, 0)
+			So(result.Files, ShouldResemble, []string{"target/copy.txt"})
+		})
+
+		Convey("Output files are not uploaded to the root", func() {
+			action := &ShellAction{}
+			So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{
+				"command": "sh",
+				"args":    "-c\necho content > $OUTDIR/file.txt",
+			}}), ShouldBeNil)
+			action.Router = mock
+			_, e := action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{})
+			So(e, ShouldNotBeNil)
+			_, e = os.Stat(filepath.Join(rootDir, "file.txt"))
+			So(os.IsNotExist(e), ShouldBeTrue)
+		})
+
+		Convey("Stdout, stderr and exit code are captured", func() {
+			action := &ShellAction{}
+			So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{
+				"command": "sh",
+				"args":    "-c\necho out; echo err >&2; exit 3",
+			}}), ShouldBeNil)
+			action.Router = mock
+			output, e := action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{})
+			So(e, ShouldNotBeNil)
+			var result ShellOutput
+			So(json.Unmarshal(output.GetLastOutput().GetJsonBody(), &result), ShouldBeNil)
+			So(result.ExitCode, ShouldEqual, 3)
+			So(result.Stdout, ShouldEqual, "out\n")
+			So(result.Stderr, ShouldEqual, "err\n")
+		})
+
+		Convey("Command is killed on timeout", func() {
+			action := &ShellAction{}
+			So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{
+				"command": "sleep",
+				"args":    "10",
+				"timeout": "100ms",
+			}}), ShouldBeNil)
+			action.Router = mock
+			start := time.Now()
+			_, e := action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{})
+			So(e, ShouldNotBeNil)
+			So(time.Since(start), ShouldBeLessThan, 5*time.Second)
+		})
+
+		Convey("Children of the command are killed on timeout", func() {
+			action := &ShellAction{}
+			So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{
+				"command": "sh",
+				"args":    "-c\nsleep 10; echo done",
+				"timeout": "100ms",
+			}}), ShouldBeNil)
+			action.Router = mock
+			start := time.Now()
+			_, e := action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{})
+			So(e, ShouldNotBeNil)
+			So(time.Since(start), ShouldBeLessThan, 5*time.Second)
+		})
+
+		Convey("Command is killed when the task is stopped", func() {
+			action := &ShellAction{}
+			So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{
+				"command": "sleep",
+				"args":    "10",
+			}}), ShouldBeNil)
+			action.Router = mock
+			stop := make(chan interface{})
+			go func() {
+				<-time.After(100 * time.Millisecond)
+				close(stop)
+			}()
+			start := time.Now()
+			_, e := action.Run(context.Background(), &actions.RunnableChannels{Stop: stop}, jobs.ActionMessage{})
+			So(e, ShouldNotBeNil)
+			So(e.Error(), ShouldContainSubstring, "interrupted")
+			So(time.Since(start), ShouldBeLessThan, 5*time.Second)
+		})
+	})
+}
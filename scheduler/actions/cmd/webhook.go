@@ -0,0 +1,402 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/config"
+	"github.com/pydio/cells/v4/common/forms"
+	"github.com/pydio/cells/v4/common/log"
+	"github.com/pydio/cells/v4/common/proto/jobs"
+	"github.com/pydio/cells/v4/common/service/errors"
+	json "github.com/pydio/cells/v4/common/utils/jsonx"
+	"github.com/pydio/cells/v4/scheduler/actions"
+)
+
+var (
+	webhookActionName = "actions.cmd.webhook"
+)
+
+const (
+	// webhookSignatureHeader carries the hex-encoded HMAC-SHA256 of the timestamp and the body joined
+	// by a dot, prefixed with "sha256="
+	webhookSignatureHeader = "X-Cells-Signature-256"
+	// webhookTimestampHeader carries the signed timestamp, in seconds since epoch
+	webhookTimestampHeader = "X-Cells-Timestamp"
+	// webhookMaxResponseSize limits the size of the response body stored in the action output
+	webhookMaxResponseSize = 1024 * 1024
+)
+
+// WebhookAction sends an HTTP request with a templated body to a remote URL
+type WebhookAction struct {
+	common.RuntimeHolder
+	url         string
+	method      string
+	body        string
+	jsonBody    interface{}
+	contentType string
+	headers     string
+	secretKey   string
+	maxRetries  int
+	backoff     time.Duration
+	timeout     time.Duration
+}
+
+// GetDescription returns action description
+func (w *WebhookAction) GetDescription(lang ...string) actions.ActionDescription {
+	return actions.ActionDescription{
+		ID:              webhookActionName,
+		Category:        actions.ActionCategoryNotify,
+		Label:           "Webhook",
+		Icon:            "webhook",
+		Description:     "Send an HTTP request with a templated body to a remote URL, e.g. to notify a chat or a ticketing system",
+		SummaryTemplate: "",
+		HasForm:         true,
+	}
+}
+
+// GetParametersForm returns a UX form
+func (w *WebhookAction) GetParametersForm() *forms.Form {
+	return &forms.Form{Groups: []*forms.Group{
+		{
+			Fields: []forms.Field{
+				&forms.FormField{
+					Name:        "url",
+					Type:        forms.ParamString,
+					Label:       "URL",
+					Description: "Target URL of the webhook",
+					Mandatory:   true,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "method",
+					Type:        forms.ParamSelect,
+					Label:       "Method",
+					Description: "HTTP method used to send the request",
+					Default:     http.MethodPost,
+					Mandatory:   false,
+					Editable:    true,
+					ChoicePresetList: []map[string]string{
+						{http.MethodPost: "POST"},
+						{http.MethodPut: "PUT"},
+						{http.MethodPatch: "PATCH"},
+					},
+				},
+				&forms.FormField{
+					Name:        "body",
+					Type:        forms.ParamTextarea,
+					Label:       "Body",
+					Description: "Body template, evaluated against the input nodes, users and event. For JSON content types, it must be a JSON document: each string value is evaluated separately. If empty, a JSON summary of the input is sent",
+					Mandatory:   false,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "contentType",
+					Type:        forms.ParamString,
+					Label:       "Content Type",
+					Description: "Content-Type header of the request",
+					Default:     "application/json",
+					Mandatory:   false,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "headers",
+					Type:        forms.ParamTextarea,
+					Label:       "Headers",
+					Description: "Additional headers, one 'Name: Value' per line",
+					Mandatory:   false,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "secretKey",
+					Type:        forms.ParamString,
+					Label:       "Vault Signing Secret",
+					Description: "Identifier of a secret stored in the configuration vault. If set, the timestamp and body are signed with HMAC-SHA256 and the signature sent in the " + webhookSignatureHeader + " header",
+					Mandatory:   false,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "maxRetries",
+					Type:        forms.ParamInteger,
+					Label:       "Retries",
+					Description: "Number of retries on network errors or 5xx/429 responses",
+					Default:     3,
+					Mandatory:   false,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "backoff",
+					Type:        forms.ParamString,
+					Label:       "Backoff",
+					Description: "Delay before the first retry, doubled at each attempt (golang duration, e.g. 2s)",
+					Default:     "2s",
+					Mandatory:   false,
+					Editable:    true,
+				},
+				&forms.FormField{
+					Name:        "timeout",
+					Type:        forms.ParamString,
+					Label:       "Timeout",
+					Description: "Timeout of each request (golang duration, e.g. 30s)",
+					Default:     "30s",
+					Mandatory:   false,
+					Editable:    true,
+				},
+			},
+		},
+	}}
+}
+
+// GetName returns the unique identifier of this action
+func (w *WebhookAction) GetName() string {
+	return webhookActionName
+}
+
+// Init passes parameters
+func (w *WebhookAction) Init(job *jobs.Job, action *jobs.Action) error {
+	var ok bool
+	if w.url, ok = action.Parameters["url"]; !ok || w.url == "" {
+		return errors.BadRequest(common.ServiceTasks, "missing parameter url in Action")
+	}
+	w.method = http.MethodPost
+	if m := strings.ToUpper(action.Parameters["method"]); m != "" {
+		w.method = m
+	}
+	w.contentType = "application/json"
+	if ct := action.Parameters["contentType"]; ct != "" {
+		w.contentType = ct
+	}
+	w.body = action.Parameters["body"]
+	w.jsonBody = nil
+	if w.body != "" && isJSONContentType(w.contentType) {
+		if e := json.Unmarshal([]byte(w.body), &w.jsonBody); e != nil {
+			return errors.BadRequest(common.ServiceTasks, "body must be a JSON document for content type "+w.contentType)
+		}
+	}
+	w.headers = action.Parameters["headers"]
+	w.secretKey = action.Parameters["secretKey"]
+
+	w.maxRetries = 3
+	if r := action.Parameters["maxRetries"]; r != "" {
+		i, e := strconv.Atoi(r)
+		if e != nil || i < 0 {
+			return errors.BadRequest(common.ServiceTasks, "invalid parameter maxRetries in Action")
+		}
+		w.maxRetries = i
+	}
+	w.backoff = 2 * time.Second
+	if b := action.Parameters["backoff"]; b != "" {
+		d, e := time.ParseDuration(b)
+		if e != nil || d < 0 {
+			return errors.BadRequest(common.ServiceTasks, "invalid parameter backoff in Action")
+		}
+		w.backoff = d
+	}
+	w.timeout = 30 * time.Second
+	if t := action.Parameters["timeout"]; t != "" {
+		d, e := time.ParseDuration(t)
+		if e != nil || d <= 0 {
+			return errors.BadRequest(common.ServiceTasks, "invalid parameter timeout in Action")
+		}
+		w.timeout = d
+	}
+	return nil
+}
+
+// Run the actual action code
+func (w *WebhookAction) Run(ctx context.Context, channels *actions.RunnableChannels, input jobs.ActionMessage) (jobs.ActionMessage, error) {
+
+	target := jobs.EvaluateFieldStr(ctx, input, w.url)
+	body, e := w.buildBody(ctx, input)
+	if e != nil {
+		return input.WithError(e), e
+	}
+	var secret string
+	if key := jobs.EvaluateFieldStr(ctx, input, w.secretKey); key != "" {
+		if secret = config.GetSecret(key).String(); secret == "" {
+			e := errors.NotFound(common.ServiceTasks, "cannot find secret "+key+" in vault")
+			return input.WithError(e), e
+		}
+	}
+	headers := w.parseHeaders(ctx, input)
+
+	cli := &http.Client{Timeout: w.timeout}
+	delay := w.backoff
+	var status int
+	var respBody []byte
+	for attempt := 0; ; attempt++ {
+		var retry bool
+		status, respBody, retry, e = w.send(ctx, cli, target, headers, body, secret)
+		if e == nil || !retry || attempt >= w.maxRetries {
+			break
+		}
+		log.TasksLogger(ctx).Warn(fmt.Sprintf("Webhook request to %s failed, retrying in %s", target, delay.String()), zap.Error(e))
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return input.WithError(ctx.Err()), ctx.Err()
+		}
+		delay *= 2
+	}
+	if e != nil {
+		log.TasksLogger(ctx).Error("Webhook request to "+target+" failed", zap.Error(e))
+		return input.WithError(e), e
+	}
+	log.TasksLogger(ctx).Info(fmt.Sprintf("Webhook sent to %s, received status %d", target, status))
+
+	output := &jobs.ActionOutput{Success: true}
+	var parsed interface{}
+	if len(respBody) > 0 && json.Unmarshal(respBody, &parsed) == nil {
+		output.JsonBody = respBody
+	} else {
+		output.StringBody = string(respBody)
+	}
+	input.AppendOutput(output)
+	return input, nil
+}
+
+// send performs a single request. It returns the response status and body, and whether the request
+// can be retried in case of error.
+func (w *WebhookAction) send(ctx context.Context, cli *http.Client, target string, headers http.Header, body []byte, secret string) (int, []byte, bool, error) {
+	req, er := http.NewRequestWithContext(ctx, w.method, target, bytes.NewReader(body))
+	if er != nil {
+		return 0, nil, false, er
+	}
+	for k, vv := range headers {
+		for _, v := range vv {
+			req.Header.Add(k, v)
+		}
+	}
+	req.Header.Set("Content-Type", w.contentType)
+	if secret != "" {
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(ts + "."))
+		mac.Write(body)
+		req.Header.Set(webhookTimestampHeader, ts)
+		req.Header.Set(webhookSignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	resp, er := cli.Do(req)
+	if er != nil {
+		return 0, nil, ctx.Err() == nil, er
+	}
+	defer resp.Body.Close()
+	respBody, er := io.ReadAll(io.LimitReader(resp.Body, webhookMaxResponseSize))
+	if er != nil {
+		return resp.StatusCode, nil, true, er
+	}
+	if resp.StatusCode >= 300 {
+		retry := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+		return resp.StatusCode, respBody, retry, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, respBody, false, nil
+}
+
+// buildBody evaluates the body template, or builds a JSON summary of the input if no template is set.
+// JSON templates are evaluated value by value and marshaled again, so that evaluated values are escaped.
+func (w *WebhookAction) buildBody(ctx context.Context, input jobs.ActionMessage) ([]byte, error) {
+	if w.jsonBody != nil {
+		return json.Marshal(evaluateJSONValues(ctx, input, w.jsonBody))
+	}
+	if w.body != "" {
+		return []byte(jobs.EvaluateFieldStr(ctx, input, w.body)), nil
+	}
+	type nodeSummary struct {
+		Uuid  string `json:"Uuid"`
+		Path  string `json:"Path"`
+		Size  int64  `json:"Size"`
+		MTime int64  `json:"MTime"`
+	}
+	type userSummary struct {
+		Uuid  string `json:"Uuid"`
+		Login string `json:"Login"`
+	}
+	summary := struct {
+		Nodes []nodeSummary `json:"Nodes"`
+		Users []userSummary `json:"Users"`
+		Event string        `json:"Event,omitempty"`
+	}{Nodes: []nodeSummary{}, Users: []userSummary{}}
+	for _, n := range input.GetNodes() {
+		summary.Nodes = append(summary.Nodes, nodeSummary{Uuid: n.GetUuid(), Path: n.GetPath(), Size: n.GetSize(), MTime: n.GetMTime()})
+	}
+	for _, u := range input.GetUsers() {
+		summary.Users = append(summary.Users, userSummary{Uuid: u.GetUuid(), Login: u.GetLogin()})
+	}
+	if ev := input.GetEvent(); ev != nil {
+		summary.Event = ev.GetTypeUrl()
+	}
+	return json.Marshal(summary)
+}
+
+// parseHeaders reads "Name: Value" lines and evaluates their values.
+func (w *WebhookAction) parseHeaders(ctx context.Context, input jobs.ActionMessage) http.Header {
+	headers := http.Header{}
+	for _, line := range strings.Split(w.headers, "\n") {
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			continue
+		}
+		headers.Add(strings.TrimSpace(parts[0]), jobs.EvaluateFieldStr(ctx, input, strings.TrimSpace(parts[1])))
+	}
+	return headers
+}
+
+// evaluateJSONValues evaluates all string values of a decoded JSON document.
+func evaluateJSONValues(ctx context.Context, input jobs.ActionMessage, v interface{}) interface{} {
+	switch tv := v.(type) {
+	case string:
+		return jobs.EvaluateFieldStr(ctx, input, tv)
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(tv))
+		for k, val := range tv {
+			out[k] = evaluateJSONValues(ctx, input, val)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(tv))
+		for i, val := range tv {
+			out[i] = evaluateJSONValues(ctx, input, val)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+func isJSONContentType(contentType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
@@ -0,0 +1,190 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/v4/common/config"
+	"github.com/pydio/cells/v4/common/config/mock"
+	"github.com/pydio/cells/v4/common/proto/idm"
+	"github.com/pydio/cells/v4/common/proto/jobs"
+	"github.com/pydio/cells/v4/common/proto/tree"
+	"github.com/pydio/cells/v4/common/utils/configx"
+	json "github.com/pydio/cells/v4/common/utils/jsonx"
+	"github.com/pydio/cells/v4/scheduler/actions"
+)
+
+// loginEvaluator replaces the [LOGIN] marker with the login of the first input user
+type loginEvaluator struct{}
+
+func (loginEvaluator) EvaluateField(ctx context.Context, input jobs.ActionMessage, value string) string {
+	if len(input.Users) == 0 {
+		return value
+	}
+	return strings.ReplaceAll(value, "[LOGIN]", input.Users[0].Login)
+}
+
+func TestWebhookAction_Init(t *testing.T) {
+
+	Convey("Test Init", t, func() {
+		action := &WebhookAction{}
+		So(action.GetName(), ShouldEqual, webhookActionName)
+		job := &jobs.Job{}
+		So(action.Init(job, &jobs.Action{}), ShouldNotBeNil)
+		So(action.Init(job, &jobs.Action{Parameters: map[string]string{"url": "http://localhost", "maxRetries": "-1"}}), ShouldNotBeNil)
+		So(action.Init(job, &jobs.Action{Parameters: map[string]string{"url": "http://localhost", "backoff": "soon"}}), ShouldNotBeNil)
+		So(action.Init(job, &jobs.Action{Parameters: map[string]string{"url": "http://localhost", "body": "not json"}}), ShouldNotBeNil)
+		So(action.Init(job, &jobs.Action{Parameters: map[string]string{"url": "http://localhost", "body": "text", "contentType": "text/plain"}}), ShouldBeNil)
+		So(action.Init(job, &jobs.Action{Parameters: map[string]string{"url": "http://localhost"}}), ShouldBeNil)
+		So(action.method, ShouldEqual, http.MethodPost)
+		So(action.maxRetries, ShouldEqual, 3)
+	})
+}
+
+func TestWebhookAction_Run(t *testing.T) {
+
+	vault := &mock.MockStore{Values: configx.New(configx.WithJSON())}
+	config.RegisterVault(vault)
+	config.SetSecret("webhook-secret", "secret")
+
+	Convey("Send signed request with default body", t, func() {
+		var received []byte
+		var signature, timestamp, custom string
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received, _ = io.ReadAll(r.Body)
+			signature = r.Header.Get(webhookSignatureHeader)
+			timestamp = r.Header.Get(webhookTimestampHeader)
+			custom = r.Header.Get("X-Custom")
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"ok":true}`))
+		}))
+		defer srv.Close()
+
+		action := &WebhookAction{}
+		So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{
+			"url":       srv.URL,
+			"secretKey": "webhook-secret",
+			"headers":   "X-Custom: value\ninvalid line",
+		}}), ShouldBeNil)
+		input := jobs.ActionMessage{
+			Nodes: []*tree.Node{{Uuid: "node-uuid", Path: "drop/file.txt", Size: 12}},
+			Users: []*idm.User{{Uuid: "user-uuid", Login: "admin"}},
+		}
+		output, e := action.Run(context.Background(), &actions.RunnableChannels{}, input)
+		So(e, ShouldBeNil)
+		So(custom, ShouldEqual, "value")
+
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write([]byte(timestamp + "."))
+		mac.Write(received)
+		So(signature, ShouldEqual, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+
+		var data map[string]interface{}
+		So(json.Unmarshal(received, &data), ShouldBeNil)
+		So(data["Nodes"], ShouldHaveLength, 1)
+		So(data["Users"], ShouldHaveLength, 1)
+
+		So(output.GetLastOutput().GetSuccess(), ShouldBeTrue)
+		So(string(output.GetLastOutput().GetJsonBody()), ShouldEqual, `{"ok":true}`)
+	})
+
+	Convey("Secret must exist in the vault", t, func() {
+		action := &WebhookAction{}
+		So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{"url": "http://localhost", "secretKey": "unknown"}}), ShouldBeNil)
+		_, e := action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{})
+		So(e, ShouldNotBeNil)
+	})
+
+	Convey("Evaluated values are escaped in JSON bodies", t, func() {
+		jobs.RegisterFieldEvaluator(loginEvaluator{})
+		var received []byte
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received, _ = io.ReadAll(r.Body)
+		}))
+		defer srv.Close()
+
+		action := &WebhookAction{}
+		So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{
+			"url":  srv.URL,
+			"body": `{"text": "Hello [LOGIN]", "tags": ["[LOGIN]"], "count": 1}`,
+		}}), ShouldBeNil)
+		_, e := action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{
+			Users: []*idm.User{{Login: `john", "admin": true, "x": "`}},
+		})
+		So(e, ShouldBeNil)
+		var data map[string]interface{}
+		So(json.Unmarshal(received, &data), ShouldBeNil)
+		So(data, ShouldHaveLength, 3)
+		So(data["text"], ShouldEqual, `Hello john", "admin": true, "x": "`)
+		So(data["count"], ShouldEqual, 1)
+	})
+
+	Convey("Retry on server errors", t, func() {
+		var calls int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte("done"))
+		}))
+		defer srv.Close()
+
+		action := &WebhookAction{}
+		So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{
+			"url":         srv.URL,
+			"body":        "plain text",
+			"contentType": "text/plain",
+			"backoff":     "1ms",
+		}}), ShouldBeNil)
+		output, e := action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{})
+		So(e, ShouldBeNil)
+		So(atomic.LoadInt32(&calls), ShouldEqual, 3)
+		So(output.GetLastOutput().GetStringBody(), ShouldEqual, "done")
+	})
+
+	Convey("Do not retry on client errors", t, func() {
+		var calls int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer srv.Close()
+
+		action := &WebhookAction{}
+		So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{"url": srv.URL, "backoff": "1ms"}}), ShouldBeNil)
+		_, e := action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{})
+		So(e, ShouldNotBeNil)
+		So(atomic.LoadInt32(&calls), ShouldEqual, 1)
+	})
+}
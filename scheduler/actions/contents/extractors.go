@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package contents
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/ledongthuc/pdf"
+	"golang.org/x/net/html"
+)
+
+// textExtractor reads a whole file content and returns its plain text.
+type textExtractor func(data []byte) (string, error)
+
+// extractors maps lower-cased file extensions to their text extractor.
+var extractors = map[string]textExtractor{
+	"txt":      plainText,
+	"text":     plainText,
+	"csv":      plainText,
+	"log":      plainText,
+	"md":       plainText,
+	"markdown": plainText,
+	"htm":      htmlText,
+	"html":     htmlText,
+	"pdf":      pdfText,
+	"docx":     officeText("word/document.xml", "word/header", "word/footer", "word/footnotes.xml"),
+	"xlsx":     officeText("xl/sharedStrings.xml"),
+	"pptx":     officeText("ppt/slides/slide", "ppt/notesSlides/notesSlide"),
+	"odt":      officeText("content.xml"),
+	"ods":      officeText("content.xml"),
+	"odp":      officeText("content.xml"),
+}
+
+// extractorFor finds the extractor matching the file name extension.
+func extractorFor(name string) (textExtractor, bool) {
+	ext := strings.ToLower(strings.TrimPrefix(path.Ext(name), "."))
+	x, ok := extractors[ext]
+	return x, ok
+}
+
+func plainText(data []byte) (string, error) {
+	return strings.ToValidUTF8(string(data), ""), nil
+}
+
+// htmlText returns the text nodes of an HTML document, ignoring scripts and styles.
+func htmlText(data []byte) (string, error) {
+	var sb strings.Builder
+	var skip bool
+	tokenizer := html.NewTokenizer(bytes.NewReader(data))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			if e := tokenizer.Err(); e != io.EOF {
+				return "", e
+			}
+			return sb.String(), nil
+		case html.StartTagToken:
+			name, _ := tokenizer.TagName()
+			skip = string(name) == "script" || string(name) == "style"
+		case html.EndTagToken:
+			skip = false
+			sb.WriteString("\n")
+		case html.TextToken:
+			if !skip {
+				sb.Write(tokenizer.Text())
+			}
+		}
+	}
+}
+
+func pdfText(data []byte) (text string, err error) {
+	// PDF library panics on some malformed documents
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("cannot read pdf: %v", r)
+		}
+	}()
+	reader, e := pdf.NewReader(bytes.NewReader(data), int64(len(data)))
+	if e != nil {
+		return "", e
+	}
+	plain, e := reader.GetPlainText()
+	if e != nil {
+		return "", e
+	}
+	b, e := io.ReadAll(plain)
+	if e != nil {
+		return "", e
+	}
+	return string(b), nil
+}
+
+// officeText reads the XML parts of an OOXML or ODF zip package whose name starts with one of the prefixes,
+// and returns their character data.
+func officeText(prefixes ...string) textExtractor {
+	return func(data []byte) (string, error) {
+		zr, e := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if e != nil {
+			return "", e
+		}
+		// Parts are read in the order of prefixes, then slide1, slide2... slide10 in natural order
+		var parts []*zip.File
+		for _, p := range prefixes {
+			var matches []*zip.File
+			for _, f := range zr.File {
+				if strings.HasPrefix(f.Name, p) && strings.HasSuffix(f.Name, ".xml") {
+					matches = append(matches, f)
+				}
+			}
+			sort.Slice(matches, func(i, j int) bool {
+				if len(matches[i].Name) != len(matches[j].Name) {
+					return len(matches[i].Name) < len(matches[j].Name)
+				}
+				return matches[i].Name < matches[j].Name
+			})
+			parts = append(parts, matches...)
+		}
+		var sb strings.Builder
+		for _, f := range parts {
+			rc, e := f.Open()
+			if e != nil {
+				return "", e
+			}
+			e = xmlText(rc, &sb)
+			rc.Close()
+			if e != nil {
+				return "", e
+			}
+		}
+		return sb.String(), nil
+	}
+}
+
+// xmlText writes the character data of an XML document, breaking lines at the end of paragraphs and cells.
+func xmlText(r io.Reader, sb *strings.Builder) error {
+	decoder := xml.NewDecoder(r)
+	for {
+		token, e := decoder.Token()
+		if e == io.EOF {
+			return nil
+		} else if e != nil {
+			return e
+		}
+		switch t := token.(type) {
+		case xml.CharData:
+			sb.Write(t)
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "p", "si", "h", "table-cell":
+				sb.WriteString("\n")
+			case "tab":
+				sb.WriteString(" ")
+			}
+		}
+	}
+}
@@ -0,0 +1,236 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package contents
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/client/grpc"
+	"github.com/pydio/cells/v4/common/forms"
+	"github.com/pydio/cells/v4/common/log"
+	"github.com/pydio/cells/v4/common/nodes"
+	"github.com/pydio/cells/v4/common/nodes/compose"
+	"github.com/pydio/cells/v4/common/nodes/models"
+	"github.com/pydio/cells/v4/common/proto/jobs"
+	"github.com/pydio/cells/v4/common/proto/tree"
+	"github.com/pydio/cells/v4/common/service/context/metadata"
+	"github.com/pydio/cells/v4/common/service/errors"
+	"github.com/pydio/cells/v4/scheduler/actions"
+)
+
+const (
+	// MetadataContentRef points to the gzipped plain text of a file, read by the search engine
+	// when the indexContent option is enabled.
+	MetadataContentRef = "ContentRef"
+
+	defaultMaxFileSize = 50 * 1024 * 1024
+	maxTextSize        = 10 * 1024 * 1024
+)
+
+var (
+	textActionName = "actions.contents.text"
+)
+
+// TextExtractor extracts the plain text of documents and stores it in the docstore binaries, so that it can be
+// indexed by the search engine.
+type TextExtractor struct {
+	common.RuntimeHolder
+	Router      nodes.Handler
+	MetaClient  tree.NodeReceiverClient
+	maxFileSize int64
+}
+
+// GetDescription returns action description
+func (t *TextExtractor) GetDescription(_ ...string) actions.ActionDescription {
+	return actions.ActionDescription{
+		ID:                textActionName,
+		Label:             "Extract Text",
+		Icon:              "text-recognition",
+		Description:       "Extract plain text from PDF, Office, OpenDocument, HTML, Markdown and text files for full-text search",
+		SummaryTemplate:   "",
+		HasForm:           true,
+		Category:          actions.ActionCategoryContents,
+		InputDescription:  "Multiple selection of files. Temporary, zero-bytes and unsupported files will be ignored",
+		OutputDescription: "Input files with updated metadata",
+	}
+}
+
+// GetParametersForm returns a UX form
+func (t *TextExtractor) GetParametersForm() *forms.Form {
+	return &forms.Form{Groups: []*forms.Group{
+		{
+			Fields: []forms.Field{
+				&forms.FormField{
+					Name:        "maxFileSize",
+					Type:        forms.ParamIntegerBytes,
+					Label:       "Maximum File Size",
+					Description: "Ignore files bigger than this size",
+					Default:     defaultMaxFileSize,
+					Mandatory:   false,
+					Editable:    true,
+				},
+			},
+		},
+	}}
+}
+
+// GetName returns this action unique identifier.
+func (t *TextExtractor) GetName() string {
+	return textActionName
+}
+
+// Init passes parameters to the action.
+func (t *TextExtractor) Init(job *jobs.Job, action *jobs.Action) error {
+	t.maxFileSize = defaultMaxFileSize
+	if s, ok := action.Parameters["maxFileSize"]; ok && s != "" {
+		size, e := strconv.ParseInt(s, 10, 64)
+		if e != nil || size <= 0 {
+			return errors.BadRequest(common.ServiceTasks, "invalid value for maxFileSize parameter: "+s)
+		}
+		t.maxFileSize = size
+	}
+	if !nodes.IsUnitTestEnv {
+		t.Router = compose.PathClientAdmin(t.GetRuntimeContext())
+		t.MetaClient = tree.NewNodeReceiverClient(grpc.GetClientConnFromCtx(t.GetRuntimeContext(), common.ServiceMeta))
+	}
+	return nil
+}
+
+// Run the actual action code.
+func (t *TextExtractor) Run(ctx context.Context, _ *actions.RunnableChannels, input jobs.ActionMessage) (jobs.ActionMessage, error) {
+
+	var processed int
+	for i, n := range input.Nodes {
+		if n.Size == -1 || n.Etag == common.NodeFlagEtagTemporary {
+			continue
+		}
+		extract, ok := extractorFor(n.GetPath())
+		if !ok {
+			log.Logger(ctx).Debug("[TEXT EXTRACTOR] Ignoring unsupported file", n.ZapPath())
+			continue
+		}
+		resp, e := t.Router.ReadNode(ctx, &tree.ReadNodeRequest{Node: n})
+		if e != nil {
+			return input.WithError(e), e
+		}
+		node := resp.GetNode()
+		if !node.IsLeaf() || node.GetSize() == 0 {
+			continue
+		}
+		if node.GetSize() > t.maxFileSize {
+			log.TasksLogger(ctx).Info(fmt.Sprintf("Ignoring %s for text extraction, file is too big (%d bytes)", node.GetPath(), node.GetSize()), node.ZapPath())
+			continue
+		}
+		if e := t.extract(ctx, node, extract); e != nil {
+			// A corrupted document should not fail the whole job
+			log.TasksLogger(ctx).Error("Cannot extract text from "+node.GetPath(), node.ZapPath(), zap.Error(e))
+			continue
+		}
+		input.Nodes[i] = node
+		processed++
+	}
+
+	if processed == 0 {
+		return input.WithIgnore(), nil
+	}
+	log.TasksLogger(ctx).Info(fmt.Sprintf("Extracted text from %d file(s)", processed))
+	input.AppendOutput(&jobs.ActionOutput{Success: true})
+	return input, nil
+}
+
+// extract reads the file, stores its gzipped text in the binaries store and updates the node ContentRef metadata.
+// Updating metadata emits an event that triggers the node re-indexation.
+func (t *TextExtractor) extract(ctx context.Context, node *tree.Node, extract textExtractor) error {
+	reader, e := t.Router.GetObject(ctx, node, &models.GetRequestData{Length: node.GetSize()})
+	if e != nil {
+		return e
+	}
+	data, e := io.ReadAll(io.LimitReader(reader, t.maxFileSize))
+	reader.Close()
+	if e != nil {
+		return e
+	}
+	text, e := extract(data)
+	if e != nil {
+		return e
+	}
+	text = normalizeText(text)
+	if text == "" {
+		return nil
+	}
+
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	if _, e := gz.Write([]byte(text)); e != nil {
+		return e
+	}
+	if e := gz.Close(); e != nil {
+		return e
+	}
+	ref := &tree.Node{
+		Type:  tree.NodeType_LEAF,
+		Path:  path.Join(common.PydioDocstoreBinariesNamespace, "contents-"+node.GetUuid()+".txt.gz"),
+		Size:  int64(buf.Len()),
+		MTime: time.Now().Unix(),
+	}
+	sysCtx := metadata.WithUserNameMetadata(ctx, common.PydioSystemUsername)
+	if _, e := t.Router.PutObject(sysCtx, ref, buf, &models.PutRequestData{
+		Size:     ref.Size,
+		Metadata: map[string]string{common.XContentType: "application/gzip"},
+	}); e != nil {
+		return e
+	}
+
+	node.MustSetMeta(MetadataContentRef, ref.GetPath())
+	if _, e := t.MetaClient.UpdateNode(ctx, &tree.UpdateNodeRequest{From: node, To: node}); e != nil {
+		return e
+	}
+	log.Logger(ctx).Debug("[TEXT EXTRACTOR] Stored text contents", node.ZapPath(), zap.Int("length", len(text)))
+	return nil
+}
+
+// normalizeText collapses spaces, removes empty lines and truncates the text to maxTextSize.
+func normalizeText(text string) string {
+	var sb strings.Builder
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.Join(strings.Fields(line), " ")
+		if line == "" {
+			continue
+		}
+		if sb.Len()+len(line)+1 > maxTextSize {
+			break
+		}
+		sb.WriteString(line)
+		sb.WriteString("\n")
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
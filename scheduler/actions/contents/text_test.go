@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package contents
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/nodes"
+	"github.com/pydio/cells/v4/common/proto/jobs"
+	"github.com/pydio/cells/v4/common/proto/tree"
+	"github.com/pydio/cells/v4/scheduler/actions"
+)
+
+func init() {
+	nodes.IsUnitTestEnv = true
+}
+
+type metaClientMock struct {
+	tree.NodeReceiverClient
+	updated []*tree.Node
+}
+
+func (m *metaClientMock) UpdateNode(ctx context.Context, in *tree.UpdateNodeRequest, opts ...grpc.CallOption) (*tree.UpdateNodeResponse, error) {
+	m.updated = append(m.updated, in.To)
+	return &tree.UpdateNodeResponse{Success: true, Node: in.To}, nil
+}
+
+func zipPackage(parts map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for name, content := range parts {
+		w, _ := zw.Create(name)
+		w.Write([]byte(content))
+	}
+	zw.Close()
+	return buf.Bytes()
+}
+
+func TestExtractors(t *testing.T) {
+
+	Convey("Extract text from supported formats", t, func() {
+		_, ok := extractorFor("archive.zip")
+		So(ok, ShouldBeFalse)
+
+		x, ok := extractorFor("page.HTML")
+		So(ok, ShouldBeTrue)
+		text, e := x([]byte(`<html><head><style>body{}</style><script>var a = 1;</script></head><body><h1>Title</h1><p>Some <b>bold</b> text</p></body></html>`))
+		So(e, ShouldBeNil)
+		So(normalizeText(text), ShouldEqual, "Title\nSome bold\ntext")
+
+		x, _ = extractorFor("document.docx")
+		text, e = x(zipPackage(map[string]string{
+			"word/document.xml": `<w:document xmlns:w="w"><w:body><w:p><w:r><w:t>First</w:t></w:r><w:r><w:tab/><w:t>paragraph</w:t></w:r></w:p><w:p><w:r><w:t>Second</w:t></w:r></w:p></w:body></w:document>`,
+			"word/styles.xml":   `<w:styles xmlns:w="w"><w:style>Ignored</w:style></w:styles>`,
+		}))
+		So(e, ShouldBeNil)
+		So(normalizeText(text), ShouldEqual, "First paragraph\nSecond")
+
+		x, _ = extractorFor("slides.pptx")
+		text, e = x(zipPackage(map[string]string{
+			"ppt/slides/slide10.xml": `<p:sld xmlns:a="a" xmlns:p="p"><a:p><a:t>Ten</a:t></a:p></p:sld>`,
+			"ppt/slides/slide2.xml":  `<p:sld xmlns:a="a" xmlns:p="p"><a:p><a:t>Two</a:t></a:p></p:sld>`,
+		}))
+		So(e, ShouldBeNil)
+		So(normalizeText(text), ShouldEqual, "Two\nTen")
+
+		x, _ = extractorFor("sheet.ods")
+		text, e = x(zipPackage(map[string]string{
+			"content.xml": `<office:document-content xmlns:office="o" xmlns:table="t" xmlns:text="x"><table:table-cell><text:p>A1</text:p></table:table-cell><table:table-cell><text:p>B1</text:p></table:table-cell></office:document-content>`,
+		}))
+		So(e, ShouldBeNil)
+		So(normalizeText(text), ShouldEqual, "A1\nB1")
+
+		x, _ = extractorFor("broken.pdf")
+		_, e = x([]byte("not a pdf"))
+		So(e, ShouldNotBeNil)
+	})
+}
+
+func TestTextExtractor_Run(t *testing.T) {
+
+	Convey("Store gzipped text and set ContentRef", t, func() {
+		rootDir := t.TempDir()
+		So(os.Mkdir(filepath.Join(rootDir, common.PydioDocstoreBinariesNamespace), 0700), ShouldBeNil)
+		So(os.WriteFile(filepath.Join(rootDir, "notes.md"), []byte("# Notes\n\nSome   *markdown*  text"), 0600), ShouldBeNil)
+		mock := nodes.NewHandlerMock()
+		mock.RootDir = rootDir
+		mock.Nodes["notes.md"] = &tree.Node{Uuid: "notes-uuid", Path: "notes.md", Type: tree.NodeType_LEAF, Size: 31}
+		mock.Nodes["image.png"] = &tree.Node{Uuid: "image-uuid", Path: "image.png", Type: tree.NodeType_LEAF, Size: 10}
+		metaClient := &metaClientMock{}
+
+		action := &TextExtractor{}
+		So(action.GetName(), ShouldEqual, textActionName)
+		So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{"maxFileSize": "-1"}}), ShouldNotBeNil)
+		So(action.Init(&jobs.Job{}, &jobs.Action{}), ShouldBeNil)
+		action.Router = mock
+		action.MetaClient = metaClient
+
+		output, e := action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{Nodes: []*tree.Node{{Path: "image.png"}, {Path: "notes.md"}}})
+		So(e, ShouldBeNil)
+		So(output.GetLastOutput().GetSuccess(), ShouldBeTrue)
+		So(metaClient.updated, ShouldHaveLength, 1)
+		ref := metaClient.updated[0].GetStringMeta(MetadataContentRef)
+		So(ref, ShouldEqual, common.PydioDocstoreBinariesNamespace+"/contents-notes-uuid.txt.gz")
+
+		f, e := os.Open(filepath.Join(rootDir, ref))
+		So(e, ShouldBeNil)
+		defer f.Close()
+		gz, e := gzip.NewReader(f)
+		So(e, ShouldBeNil)
+		data, _ := io.ReadAll(gz)
+		So(string(data), ShouldEqual, "# Notes\nSome *markdown* text")
+
+		output, e = action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{Nodes: []*tree.Node{{Path: "image.png"}}})
+		So(e, ShouldBeNil)
+		So(output.GetLastOutput().GetIgnored(), ShouldBeTrue)
+	})
+}
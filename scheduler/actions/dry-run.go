@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package actions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pydio/cells/v4/common/proto/idm"
+	"github.com/pydio/cells/v4/common/proto/jobs"
+	"github.com/pydio/cells/v4/common/proto/tree"
+)
+
+// maxDescribedItems limits the number of items listed by name in a dry-run plan
+const maxDescribedItems = 20
+
+// DescribeInput builds a default dry-run plan for actions that do not implement DryRunnableAction,
+// listing the objects found in the action input.
+func DescribeInput(action ConcreteAction, input *jobs.ActionMessage) string {
+	name := action.GetName()
+	if dp, ok := action.(DescriptionProviderAction); ok {
+		if l := dp.GetDescription().Label; l != "" {
+			name = l
+		}
+	}
+	var targets []string
+	if len(input.Nodes) > 0 {
+		targets = append(targets, DescribeNodes(input.Nodes))
+	}
+	if len(input.Users) > 0 {
+		targets = append(targets, DescribeUsers(input.Users))
+	}
+	if len(input.Workspaces) > 0 {
+		targets = append(targets, fmt.Sprintf("%d workspace(s)", len(input.Workspaces)))
+	}
+	if len(input.Roles) > 0 {
+		targets = append(targets, fmt.Sprintf("%d role(s)", len(input.Roles)))
+	}
+	if len(input.Acls) > 0 {
+		targets = append(targets, fmt.Sprintf("%d ACL(s)", len(input.Acls)))
+	}
+	if len(input.DataSources) > 0 {
+		targets = append(targets, fmt.Sprintf("%d datasource(s)", len(input.DataSources)))
+	}
+	if len(targets) == 0 {
+		return fmt.Sprintf("Would run '%s'", name)
+	}
+	return fmt.Sprintf("Would run '%s' on %s", name, strings.Join(targets, ", "))
+}
+
+// DescribeNodes lists nodes by path, truncating the list if it is too long
+func DescribeNodes(nodes []*tree.Node) string {
+	var items []string
+	for _, n := range nodes {
+		if n.GetPath() != "" {
+			items = append(items, n.GetPath())
+		} else {
+			items = append(items, n.GetUuid())
+		}
+	}
+	return describeItems(items, "node(s)")
+}
+
+// DescribeUsers lists users by login and groups by path, truncating the list if it is too long
+func DescribeUsers(users []*idm.User) string {
+	var items []string
+	for _, u := range users {
+		if u.GetIsGroup() {
+			items = append(items, "group "+strings.TrimSuffix(u.GetGroupPath(), "/")+"/"+u.GetGroupLabel())
+		} else {
+			items = append(items, u.GetLogin())
+		}
+	}
+	return describeItems(items, "user(s)")
+}
+
+func describeItems(items []string, noun string) string {
+	count := len(items)
+	if count > maxDescribedItems {
+		items = append(items[:maxDescribedItems:maxDescribedItems], fmt.Sprintf("and %d more", count-maxDescribedItems))
+	}
+	return fmt.Sprintf("%d %s [%s]", count, noun, strings.Join(items, ", "))
+}
@@ -21,6 +21,7 @@
 package images
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -65,7 +66,7 @@ func (e *ExifProcessor) GetDescription(lang ...string) actions.ActionDescription
 		ID:                exifTaskName,
 		Label:             "Extract EXIF",
 		Icon:              "image",
-		Description:       "Extract EXIF data from jpeg images and store them as indexed metadata",
+		Description:       "Extract EXIF data from jpeg, webp, heic and avif images and store them as indexed metadata",
 		SummaryTemplate:   "",
 		HasForm:           false,
 		Category:          actions.ActionCategoryContents,
@@ -192,14 +193,28 @@ func (e *ExifProcessor) ExtractExif(ctx context.Context, node *tree.Node) (*exif
 		return nil, rer
 	}
 	defer func() {
-		ioutil.ReadAll(reader)
+		io.Copy(ioutil.Discard, reader)
 		reader.Close()
 	}()
 
+	var exifReader io.Reader = reader
+	if extract, ok := exifContainerFor(node.GetPath()); ok {
+		// EXIF block must be found inside the WebP or HEIF container
+		data, er := ioutil.ReadAll(io.LimitReader(reader, exifContainerMaxSize))
+		if er != nil {
+			return nil, er
+		}
+		block := extract(data)
+		if block == nil {
+			return nil, nil
+		}
+		exifReader = bytes.NewReader(block)
+	}
+
 	// Optionally register camera makenote data parsing - currently Nikon and
 	// Canon are supported.
 	// exif.RegisterParsers(mknote.All...)
-	x, err := exif.Decode(reader)
+	x, err := exif.Decode(exifReader)
 
 	// Do not throw an error when there are no exif data
 	if err != nil && err.Error() != "EOF" {
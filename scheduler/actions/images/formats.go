@@ -0,0 +1,241 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package images
+
+import (
+	"bytes"
+	"encoding/binary"
+	"path"
+	"strings"
+
+	// Register WebP decoder for thumbnails
+	_ "golang.org/x/image/webp"
+)
+
+// exifContainerMaxSize caps the size of the WebP or HEIF data loaded in memory to find the EXIF block. Files are
+// read from their start, EXIF blocks located beyond this limit are ignored.
+const exifContainerMaxSize = 32 * 1024 * 1024
+
+// exifContainers lists the extensions of formats whose EXIF block is extracted from their container
+// before being decoded. Other formats (JPEG, TIFF) are directly read by the EXIF decoder.
+var exifContainers = map[string]func(data []byte) []byte{
+	"webp": webpExifBlock,
+	"heic": heifExifBlock,
+	"heif": heifExifBlock,
+	"avif": heifExifBlock,
+}
+
+// exifContainerFor finds the EXIF block extractor for a file name, if its format requires one.
+func exifContainerFor(name string) (func(data []byte) []byte, bool) {
+	f, ok := exifContainers[strings.ToLower(strings.TrimPrefix(path.Ext(name), "."))]
+	return f, ok
+}
+
+// webpExifBlock finds the EXIF chunk of a WebP RIFF container.
+func webpExifBlock(data []byte) []byte {
+	if len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return nil
+	}
+	for chunks := data[12:]; len(chunks) >= 8; {
+		size := int(binary.LittleEndian.Uint32(chunks[4:]))
+		if size < 0 || len(chunks) < 8+size {
+			return nil
+		}
+		if string(chunks[0:4]) == "EXIF" {
+			return chunks[8 : 8+size]
+		}
+		// Chunks are padded to an even size
+		next := 8 + size + size%2
+		if next > len(chunks) {
+			return nil
+		}
+		chunks = chunks[next:]
+	}
+	return nil
+}
+
+// heifExifBlock finds the Exif item of a HEIF container (used by HEIC and AVIF images), by looking up its
+// ID in the item information box then its location in the item location box.
+func heifExifBlock(data []byte) []byte {
+	meta, ok := isoBox(data, "meta")
+	if !ok || len(meta) < 4 {
+		return nil
+	}
+	// meta is a full box: skip version and flags
+	meta = meta[4:]
+	iinf, ok := isoBox(meta, "iinf")
+	if !ok {
+		return nil
+	}
+	itemID, ok := heifExifItem(iinf)
+	if !ok {
+		return nil
+	}
+	iloc, ok := isoBox(meta, "iloc")
+	if !ok {
+		return nil
+	}
+	offset, length, ok := heifItemLocation(iloc, itemID)
+	if !ok || offset > uint64(len(data)) || length > uint64(len(data))-offset || length < 4 {
+		return nil
+	}
+	// Exif item starts with the offset of the TIFF header
+	item := data[offset : offset+length]
+	tiffOffset := uint64(binary.BigEndian.Uint32(item)) + 4
+	if tiffOffset >= uint64(len(item)) {
+		return nil
+	}
+	block := item[tiffOffset:]
+	// Some writers include the Exif header and count it in the offset
+	return bytes.TrimPrefix(block, []byte("Exif\x00\x00"))
+}
+
+// isoBox returns the content of the first box of a given type in an ISO base media (ISOBMFF) sequence of boxes.
+func isoBox(data []byte, boxType string) ([]byte, bool) {
+	for len(data) >= 8 {
+		size := uint64(binary.BigEndian.Uint32(data))
+		header := uint64(8)
+		switch size {
+		case 0:
+			size = uint64(len(data))
+		case 1:
+			if len(data) < 16 {
+				return nil, false
+			}
+			size = binary.BigEndian.Uint64(data[8:])
+			header = 16
+		}
+		if size < header || size > uint64(len(data)) {
+			return nil, false
+		}
+		if string(data[4:8]) == boxType {
+			return data[header:size], true
+		}
+		data = data[size:]
+	}
+	return nil, false
+}
+
+// heifExifItem reads the item information box to find the ID of the Exif item.
+func heifExifItem(iinf []byte) (uint32, bool) {
+	if len(iinf) < 6 {
+		return 0, false
+	}
+	entries := iinf[6:]
+	if iinf[0] > 0 {
+		if len(iinf) < 8 {
+			return 0, false
+		}
+		entries = iinf[8:]
+	}
+	for len(entries) >= 8 {
+		size := binary.BigEndian.Uint32(entries)
+		if size < 8 || int(size) > len(entries) {
+			return 0, false
+		}
+		boxType, infe := string(entries[4:8]), entries[8:size]
+		entries = entries[size:]
+		if boxType != "infe" || len(infe) < 4 {
+			continue
+		}
+		// Item type is only available in infe boxes version 2 and 3
+		switch infe[0] {
+		case 2:
+			if len(infe) >= 12 && string(infe[8:12]) == "Exif" {
+				return uint32(binary.BigEndian.Uint16(infe[4:])), true
+			}
+		case 3:
+			if len(infe) >= 14 && string(infe[10:14]) == "Exif" {
+				return binary.BigEndian.Uint32(infe[4:]), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// heifItemLocation reads the item location box to find the file offset and length of an item. Only items stored
+// in a single extent, located by their file offset, are supported.
+func heifItemLocation(iloc []byte, itemID uint32) (uint64, uint64, bool) {
+	if len(iloc) < 8 {
+		return 0, 0, false
+	}
+	version := iloc[0]
+	offsetSize := int(iloc[4] >> 4)
+	lengthSize := int(iloc[4] & 0xf)
+	baseOffsetSize := int(iloc[5] >> 4)
+	indexSize := 0
+	if version == 1 || version == 2 {
+		indexSize = int(iloc[5] & 0xf)
+	}
+	r := &byteReader{data: iloc[6:], ok: true}
+	var count uint64
+	if version < 2 {
+		count = r.uint(2)
+	} else {
+		count = r.uint(4)
+	}
+	for i := uint64(0); i < count && r.ok; i++ {
+		var id uint64
+		if version < 2 {
+			id = r.uint(2)
+		} else {
+			id = r.uint(4)
+		}
+		method := uint64(0)
+		if version == 1 || version == 2 {
+			method = r.uint(2) & 0xf
+		}
+		r.uint(2) // data reference index
+		base := r.uint(baseOffsetSize)
+		extents := r.uint(2)
+		var offset, length uint64
+		for j := uint64(0); j < extents && r.ok; j++ {
+			r.uint(indexSize)
+			o, l := r.uint(offsetSize), r.uint(lengthSize)
+			if j == 0 {
+				offset, length = o, l
+			}
+		}
+		if r.ok && uint32(id) == itemID {
+			return base + offset, length, method == 0 && extents == 1 && base+offset >= base
+		}
+	}
+	return 0, 0, false
+}
+
+// byteReader reads big-endian integers of variable size, ok is false once data is exhausted.
+type byteReader struct {
+	data []byte
+	ok   bool
+}
+
+func (b *byteReader) uint(size int) uint64 {
+	if !b.ok || len(b.data) < size {
+		b.ok = false
+		return 0
+	}
+	var v uint64
+	for _, c := range b.data[:size] {
+		v = v<<8 | uint64(c)
+	}
+	b.data = b.data[size:]
+	return v
+}
@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package images
+
+import (
+	"context"
+	"encoding/binary"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/nodes"
+	"github.com/pydio/cells/v4/common/proto/jobs"
+	"github.com/pydio/cells/v4/common/proto/tree"
+	json "github.com/pydio/cells/v4/common/utils/jsonx"
+	"github.com/pydio/cells/v4/common/utils/uuid"
+	"github.com/pydio/cells/v4/scheduler/actions"
+)
+
+// copyTestNode copies a testdata file to tmpDir, and returns a node pointing to this local copy
+func copyTestNode(tmpDir, testFile, ext string) (*tree.Node, error) {
+	data, e := ioutil.ReadFile(filepath.Join("testdata", testFile))
+	if e != nil {
+		return nil, e
+	}
+	uuidNode := uuid.New()
+	if e := ioutil.WriteFile(filepath.Join(tmpDir, uuidNode+ext), data, 0755); e != nil {
+		return nil, e
+	}
+	node := &tree.Node{
+		Path: "path/to/local/" + uuidNode + ext,
+		Type: tree.NodeType_LEAF,
+		Uuid: uuidNode,
+	}
+	node.MustSetMeta(common.MetaNamespaceNodeName, uuidNode+ext)
+	node.MustSetMeta(common.MetaNamespaceDatasourceName, "dsname")
+	node.MustSetMeta(common.MetaNamespaceNodeTestLocalFolder, tmpDir)
+	return node, nil
+}
+
+func TestModernFormats(t *testing.T) {
+
+	// exif.webp and exif.heic embed the same EXIF block as exif.jpg. exif.heic only contains the
+	// metadata boxes of a HEIF file, without any image data.
+	for _, testFile := range []string{"exif.webp", "exif.heic"} {
+
+		Convey("Extract EXIF and geolocation from "+testFile, t, func() {
+			tmpDir, e := ioutil.TempDir("", "exif-test")
+			So(e, ShouldBeNil)
+			defer os.RemoveAll(tmpDir)
+			node, e := copyTestNode(tmpDir, testFile, filepath.Ext(testFile))
+			So(e, ShouldBeNil)
+
+			action := &ExifProcessor{}
+			So(action.Init(&jobs.Job{}, &jobs.Action{}), ShouldBeNil)
+			action.metaClient = nodes.NewHandlerMock()
+			output, e := action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{Nodes: []*tree.Node{node}})
+			So(e, ShouldBeNil)
+			So(output.Nodes, ShouldHaveLength, 1)
+
+			var exifMeta, refStruct interface{}
+			So(output.Nodes[0].GetMeta(MetadataExif, &exifMeta), ShouldBeNil)
+			refData, e := ioutil.ReadFile(filepath.Join("testdata", "exif.json"))
+			So(e, ShouldBeNil)
+			So(json.Unmarshal(refData, &refStruct), ShouldBeNil)
+			So(exifMeta, ShouldResemble, refStruct)
+
+			var geo map[string]interface{}
+			So(output.Nodes[0].GetMeta(common.MetaNamespaceGeoLocation, &geo), ShouldBeNil)
+			So(geo["lat"], ShouldNotBeNil)
+			So(geo["lon"], ShouldNotBeNil)
+		})
+
+	}
+
+	Convey("Ignore files without EXIF block", t, func() {
+		So(webpExifBlock([]byte("RIFF\x04\x00\x00\x00WEBP")), ShouldBeNil)
+		So(heifExifBlock([]byte("\x00\x00\x00\x10ftypheic\x00\x00\x00\x00")), ShouldBeNil)
+		So(heifExifBlock([]byte("not a heif file")), ShouldBeNil)
+	})
+
+	Convey("Ignore HEIF items located out of the file", t, func() {
+		box := func(boxType string, content []byte) []byte {
+			b := make([]byte, 8, 8+len(content))
+			binary.BigEndian.PutUint32(b, uint32(8+len(content)))
+			copy(b[4:], boxType)
+			return append(b, content...)
+		}
+		// infe version 2 for item 1 of type Exif
+		infe := box("infe", []byte("\x02\x00\x00\x00\x00\x01\x00\x00Exif"))
+		iinf := box("iinf", append([]byte("\x00\x00\x00\x00\x00\x01"), infe...))
+		location := func(offset uint32, length uint64) []byte {
+			// iloc version 0, 4 bytes offsets, 8 bytes lengths, one item with a single extent
+			content := append([]byte("\x00\x00\x00\x00\x48\x00\x00\x01\x00\x01\x00\x00\x00\x01"), make([]byte, 12)...)
+			binary.BigEndian.PutUint32(content[14:], offset)
+			binary.BigEndian.PutUint64(content[18:], length)
+			return box("meta", append(append([]byte("\x00\x00\x00\x00"), iinf...), box("iloc", content)...))
+		}
+		So(heifExifBlock(location(8, math.MaxUint64)), ShouldBeNil)
+		So(heifExifBlock(location(math.MaxUint32, 16)), ShouldBeNil)
+		So(heifExifBlock(location(8, 1024)), ShouldBeNil)
+	})
+
+	Convey("Create thumbnails from WebP images", t, func() {
+		tmpDir, e := ioutil.TempDir("", "thumbs-test")
+		So(e, ShouldBeNil)
+		defer os.RemoveAll(tmpDir)
+		node, e := copyTestNode(tmpDir, "exif.webp", ".webp")
+		So(e, ShouldBeNil)
+
+		action := &ThumbnailExtractor{}
+		So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{"ThumbSizes": `{"sm":64}`}}), ShouldBeNil)
+		action.metaClient = nodes.NewHandlerMock()
+		_, e = action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{Nodes: []*tree.Node{node}})
+		So(e, ShouldBeNil)
+
+		_, e = os.Stat(filepath.Join(tmpDir, node.Uuid+"-64.jpg"))
+		So(e, ShouldBeNil)
+		So(node.GetStringMeta(MetadataCompatImageReadableDimensions), ShouldEqual, "150px X 100px")
+	})
+
+	Convey("Create thumbnails from HEIC images with heif-convert", t, func() {
+		tmpDir, e := ioutil.TempDir("", "thumbs-test")
+		So(e, ShouldBeNil)
+		defer os.RemoveAll(tmpDir)
+		node, e := copyTestNode(tmpDir, "exif.heic", ".heic")
+		So(e, ShouldBeNil)
+
+		// Fake heif-convert copies a reference image to the output path (last argument)
+		converted, _ := filepath.Abs(filepath.Join("testdata", "photo-512.jpg"))
+		fakeConvert := filepath.Join(tmpDir, "heif-convert")
+		So(ioutil.WriteFile(fakeConvert, []byte("#!/bin/sh\nfor last; do true; done\ncp "+converted+" \"$last\"\n"), 0755), ShouldBeNil)
+
+		action := &ThumbnailExtractor{}
+		So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{"ThumbSizes": `{"sm":64}`}}), ShouldBeNil)
+		action.metaClient = nodes.NewHandlerMock()
+		output, e := action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{Nodes: []*tree.Node{node}})
+		So(e, ShouldBeNil)
+		So(output.GetLastOutput().GetIgnored(), ShouldBeTrue)
+
+		action.heifConvert = fakeConvert
+		_, e = action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{Nodes: []*tree.Node{node}})
+		So(e, ShouldBeNil)
+		_, e = os.Stat(filepath.Join(tmpDir, node.Uuid+"-64.jpg"))
+		So(e, ShouldBeNil)
+		var isImage bool
+		So(node.GetMeta(MetadataCompatIsImage, &isImage), ShouldBeNil)
+		So(isImage, ShouldBeTrue)
+	})
+
+}
@@ -0,0 +1,299 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package images
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/disintegration/imaging"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/config"
+	"github.com/pydio/cells/v4/common/log"
+	"github.com/pydio/cells/v4/common/nodes/models"
+	"github.com/pydio/cells/v4/common/proto/tree"
+)
+
+const (
+	MetadataVideo = "VideoMetadata"
+
+	previewPdf   = "pdf"
+	previewVideo = "video"
+	previewHeif  = "heif"
+
+	defaultVideoFrameTime = 1
+	previewTimeout        = 2 * time.Minute
+)
+
+var (
+	previewExtensions = map[string]string{
+		"pdf":  previewPdf,
+		"mp4":  previewVideo,
+		"m4v":  previewVideo,
+		"mov":  previewVideo,
+		"avi":  previewVideo,
+		"mkv":  previewVideo,
+		"webm": previewVideo,
+		"mpg":  previewVideo,
+		"mpeg": previewVideo,
+		"wmv":  previewVideo,
+		"heic": previewHeif,
+		"heif": previewHeif,
+		"avif": previewHeif,
+	}
+
+	// ffmpegDemuxers forces the input format of videos: ffmpeg must not probe untrusted uploads, as playlist-like
+	// formats (hls, concat...) would let it read other files or URLs.
+	ffmpegDemuxers = map[string]string{
+		"mp4":  "mov",
+		"m4v":  "mov",
+		"mov":  "mov",
+		"avi":  "avi",
+		"mkv":  "matroska",
+		"webm": "matroska",
+		"mpg":  "mpeg",
+		"mpeg": "mpeg",
+		"wmv":  "asf",
+	}
+
+	ffmpegDuration = regexp.MustCompile(`Duration: (\d+):(\d{2}):(\d{2}(?:\.\d+)?)`)
+	ffmpegStream   = regexp.MustCompile(`Stream #\d+:\d+.*?: (Video|Audio): ([^\s,]+)(?:.*?, (\d+)x(\d+))?`)
+)
+
+// VideoMetadata is stored in the node metadata along with video thumbnails
+type VideoMetadata struct {
+	Duration   float64
+	VideoCodec string
+	AudioCodec string
+	Width      int
+	Height     int
+}
+
+// previewKindFor returns the kind of preview that can be generated for a file name, or an empty
+// string for raster images.
+func previewKindFor(name string) string {
+	return previewExtensions[strings.ToLower(strings.TrimPrefix(path.Ext(name), "."))]
+}
+
+// previewBinary finds the binary configured in the tasks service previews section, e.g. services/pydio.grpc.tasks/previews/ffmpeg.
+// It returns an empty string if it is not configured or cannot be found.
+func previewBinary(name string) string {
+	configured := config.Get("services", common.ServiceGrpcNamespace_+common.ServiceTasks, "previews", name).String()
+	if configured == "" {
+		return ""
+	}
+	binary, e := exec.LookPath(configured)
+	if e != nil {
+		log.Logger(context.Background()).Warn("Cannot find "+name+" binary for previews, they will be skipped", zap.String("path", configured), zap.Error(e))
+		return ""
+	}
+	return binary
+}
+
+// preview renders the first page of a PDF, a frame of a video or a HEIC/AVIF image with an external binary,
+// and writes thumbnails of this image to the thumbstore.
+func (t *ThumbnailExtractor) preview(ctx context.Context, node *tree.Node, kind string, binary string, sizes map[string]int) error {
+	if !node.HasSource() {
+		return fmt.Errorf("node does not have enough metadata for Preview (missing Source data)")
+	}
+
+	workDir, e := ioutil.TempDir("", "pydio-preview-")
+	if e != nil {
+		return e
+	}
+	defer os.RemoveAll(workDir)
+
+	input, errPath, e := t.previewInput(ctx, node, workDir)
+	if e != nil {
+		return errors.Wrap(e, errPath)
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, previewTimeout)
+	defer cancel()
+	var rendered string
+	var video *VideoMetadata
+	switch kind {
+	case previewVideo:
+		rendered, video, e = renderVideoFrame(runCtx, binary, input, workDir, t.frameAfter)
+	case previewHeif:
+		rendered, e = renderHeifImage(runCtx, binary, input, workDir)
+	default:
+		rendered, e = renderPdfPage(runCtx, binary, input, workDir, maxSize(sizes))
+	}
+	if e != nil {
+		return errors.Wrap(e, errPath)
+	}
+	src, e := imaging.Open(rendered)
+	if e != nil {
+		return errors.Wrap(e, errPath)
+	}
+	if kind == previewHeif {
+		// Converted image is the original image: store its dimensions as well
+		return t.writeImageThumbnails(ctx, src, node, sizes, errPath)
+	}
+
+	node.MustSetMeta(MetadataThumbnails, &ThumbnailsMeta{Processing: true})
+	if video != nil {
+		node.MustSetMeta(MetadataVideo, video)
+	}
+	if _, e := t.metaClient.UpdateNode(ctx, &tree.UpdateNodeRequest{From: node, To: node}); e != nil {
+		return errors.Wrap(e, errPath)
+	}
+
+	return t.writeThumbnails(ctx, src, node, sizes, errPath)
+}
+
+// previewInput returns the path of a local copy of the node content, downloading it to workDir if necessary.
+func (t *ThumbnailExtractor) previewInput(ctx context.Context, node *tree.Node, workDir string) (string, string, error) {
+	if localPath := getNodeLocalPath(node); len(localPath) > 0 {
+		return localPath, localPath, nil
+	}
+	routerNode := proto.Clone(node).(*tree.Node)
+	reader, e := getRouter(t.GetRuntimeContext()).GetObject(ctx, routerNode, &models.GetRequestData{Length: -1})
+	if e != nil {
+		return "", routerNode.Path, e
+	}
+	defer reader.Close()
+	local := filepath.Join(workDir, "input"+path.Ext(node.GetPath()))
+	file, e := os.Create(local)
+	if e != nil {
+		return "", routerNode.Path, e
+	}
+	defer file.Close()
+	if _, e := io.Copy(file, reader); e != nil {
+		return "", routerNode.Path, e
+	}
+	return local, routerNode.Path, nil
+}
+
+// renderPdfPage renders the first page of a PDF document to a jpeg image whose largest side is size.
+func renderPdfPage(ctx context.Context, pdftoppm, input, workDir string, size int) (string, error) {
+	prefix := filepath.Join(workDir, "page")
+	cmd := exec.CommandContext(ctx, pdftoppm, "-f", "1", "-l", "1", "-singlefile", "-jpeg", "-scale-to", strconv.Itoa(size), input, prefix)
+	if out, e := cmd.CombinedOutput(); e != nil {
+		return "", fmt.Errorf("pdftoppm failed: %v %s", e, strings.TrimSpace(string(out)))
+	}
+	return prefix + ".jpg", nil
+}
+
+// renderHeifImage converts the primary image of a HEIC or AVIF file to jpeg with heif-convert. If the file
+// contains several images, heif-convert numbers the outputs starting with the primary one.
+func renderHeifImage(ctx context.Context, heifConvert, input, workDir string) (string, error) {
+	output := filepath.Join(workDir, "image.jpg")
+	cmd := exec.CommandContext(ctx, heifConvert, "-q", "92", input, output)
+	if out, e := cmd.CombinedOutput(); e != nil {
+		return "", fmt.Errorf("heif-convert failed: %v %s", e, strings.TrimSpace(string(out)))
+	}
+	for _, name := range []string{output, filepath.Join(workDir, "image-1.jpg")} {
+		if _, e := os.Stat(name); e == nil {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("heif-convert did not produce any image")
+}
+
+// renderVideoFrame extracts a frame of a video to a jpeg image, after frameAfter seconds or at the middle of the
+// video if it is shorter. Duration and codecs are parsed from the ffmpeg output.
+func renderVideoFrame(ctx context.Context, ffmpeg, input, workDir string, frameAfter float64) (string, *VideoMetadata, error) {
+	demuxer, ok := ffmpegDemuxers[strings.ToLower(strings.TrimPrefix(filepath.Ext(input), "."))]
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported video format %s", filepath.Ext(input))
+	}
+	output := filepath.Join(workDir, "frame.jpg")
+	extract := func(at float64) (*VideoMetadata, error) {
+		var stderr bytes.Buffer
+		cmd := exec.CommandContext(ctx, ffmpeg, "-hide_banner", "-nostdin", "-protocol_whitelist", "file",
+			"-ss", strconv.FormatFloat(at, 'f', 3, 64), "-f", demuxer, "-i", "file:"+input, "-frames:v", "1", "-y", output)
+		cmd.Stderr = &stderr
+		e := cmd.Run()
+		info := parseVideoMetadata(stderr.String())
+		if e != nil {
+			return info, fmt.Errorf("ffmpeg failed: %v", e)
+		}
+		return info, nil
+	}
+	info, e := extract(frameAfter)
+	if e != nil {
+		return "", nil, e
+	}
+	if _, er := os.Stat(output); er != nil {
+		// No frame after frameAfter, retry at the middle of the video
+		if info.Duration == 0 || info.Duration > frameAfter {
+			return "", nil, fmt.Errorf("ffmpeg did not extract any frame")
+		}
+		if info, e = extract(info.Duration / 2); e != nil {
+			return "", nil, e
+		}
+		if _, er := os.Stat(output); er != nil {
+			return "", nil, fmt.Errorf("ffmpeg did not extract any frame")
+		}
+	}
+	return output, info, nil
+}
+
+// parseVideoMetadata reads the duration, codecs and dimensions of a video from the ffmpeg input description.
+func parseVideoMetadata(ffmpegOutput string) *VideoMetadata {
+	info := &VideoMetadata{}
+	if m := ffmpegDuration.FindStringSubmatch(ffmpegOutput); m != nil {
+		h, _ := strconv.Atoi(m[1])
+		min, _ := strconv.Atoi(m[2])
+		sec, _ := strconv.ParseFloat(m[3], 64)
+		info.Duration = float64(h*3600+min*60) + sec
+	}
+	for _, m := range ffmpegStream.FindAllStringSubmatch(ffmpegOutput, -1) {
+		if m[1] == "Video" && info.VideoCodec == "" {
+			info.VideoCodec = m[2]
+			info.Width, _ = strconv.Atoi(m[3])
+			info.Height, _ = strconv.Atoi(m[4])
+		} else if m[1] == "Audio" && info.AudioCodec == "" {
+			info.AudioCodec = m[2]
+		}
+	}
+	return info
+}
+
+func maxSize(sizes map[string]int) int {
+	max := 0
+	for _, s := range sizes {
+		if s > max {
+			max = s
+		}
+	}
+	if max == 0 {
+		max = 1024
+	}
+	return max
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package images
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/nodes"
+	"github.com/pydio/cells/v4/common/proto/jobs"
+	"github.com/pydio/cells/v4/common/proto/tree"
+	"github.com/pydio/cells/v4/common/utils/uuid"
+	"github.com/pydio/cells/v4/scheduler/actions"
+)
+
+const ffmpegSampleOutput = `Input #0, mov,mp4,m4a,3gp,3g2,mj2, from 'input.mp4':
+  Metadata:
+    major_brand     : isom
+  Duration: 00:01:02.50, start: 0.000000, bitrate: 1205 kb/s
+  Stream #0:0(und): Video: h264 (High) (avc1 / 0x31637661), yuv420p(tv, bt709), 1280x720 [SAR 1:1 DAR 16:9], 1072 kb/s, 25 fps, 25 tbr, 12800 tbn (default)
+  Stream #0:1(und): Audio: aac (LC) (mp4a / 0x6134706D), 44100 Hz, stereo, fltp, 128 kb/s (default)
+`
+
+func TestPreviews(t *testing.T) {
+
+	Convey("Detect preview kind", t, func() {
+		So(previewKindFor("path/to/doc.PDF"), ShouldEqual, previewPdf)
+		So(previewKindFor("path/to/movie.mp4"), ShouldEqual, previewVideo)
+		So(previewKindFor("path/to/movie.webm"), ShouldEqual, previewVideo)
+		So(previewKindFor("path/to/photo.jpg"), ShouldBeEmpty)
+	})
+
+	Convey("Parse ffmpeg output", t, func() {
+		info := parseVideoMetadata(ffmpegSampleOutput)
+		So(info, ShouldResemble, &VideoMetadata{
+			Duration:   62.5,
+			VideoCodec: "h264",
+			AudioCodec: "aac",
+			Width:      1280,
+			Height:     720,
+		})
+		So(parseVideoMetadata("not a video"), ShouldResemble, &VideoMetadata{})
+	})
+
+	Convey("Ignore previews without binary", t, func() {
+		action := &ThumbnailExtractor{}
+		So(action.Init(&jobs.Job{}, &jobs.Action{}), ShouldBeNil)
+		node := &tree.Node{Path: "path/to/doc.pdf", Type: tree.NodeType_LEAF, Size: 12, Uuid: uuid.New()}
+		output, e := action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{Nodes: []*tree.Node{node}})
+		So(e, ShouldBeNil)
+		So(output.GetLastOutput().GetIgnored(), ShouldBeTrue)
+	})
+
+	Convey("Create video thumbnails with ffmpeg", t, func() {
+		tmpDir, e := ioutil.TempDir("", "preview-test")
+		So(e, ShouldBeNil)
+		defer os.RemoveAll(tmpDir)
+
+		// Fake ffmpeg copies a reference image to the output path (last argument) and prints a stream description
+		frame, _ := filepath.Abs(filepath.Join("testdata", "photo-512.jpg"))
+		argsFile := filepath.Join(tmpDir, "ffmpeg-args")
+		script := "#!/bin/sh\necho \"$@\" > " + argsFile + "\nfor last; do true; done\ncp " + frame + " \"$last\"\ncat >&2 <<'EOF'\n" + ffmpegSampleOutput + "EOF\n"
+		fakeFfmpeg := filepath.Join(tmpDir, "ffmpeg")
+		So(ioutil.WriteFile(fakeFfmpeg, []byte(script), 0755), ShouldBeNil)
+
+		action := &ThumbnailExtractor{}
+		So(action.Init(&jobs.Job{}, &jobs.Action{Parameters: map[string]string{"ThumbSizes": `{"sm":256}`, "VideoFrameTime": "5"}}), ShouldBeNil)
+		So(action.frameAfter, ShouldEqual, 5)
+		action.metaClient = nodes.NewHandlerMock()
+		action.ffmpeg = fakeFfmpeg
+
+		uuidNode := uuid.New()
+		So(ioutil.WriteFile(filepath.Join(tmpDir, uuidNode+".mp4"), []byte("fake video"), 0755), ShouldBeNil)
+		node := &tree.Node{
+			Path: "path/to/local/" + uuidNode + ".mp4",
+			Type: tree.NodeType_LEAF,
+			Uuid: uuidNode,
+		}
+		node.MustSetMeta(common.MetaNamespaceNodeName, uuidNode+".mp4")
+		node.MustSetMeta(common.MetaNamespaceDatasourceName, "dsname")
+		node.MustSetMeta(common.MetaNamespaceNodeTestLocalFolder, tmpDir)
+
+		_, e = action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{Nodes: []*tree.Node{node}})
+		So(e, ShouldBeNil)
+
+		_, e = os.Stat(filepath.Join(tmpDir, uuidNode+"-256.jpg"))
+		So(e, ShouldBeNil)
+		var info VideoMetadata
+		So(node.GetMeta(MetadataVideo, &info), ShouldBeNil)
+		So(info.Duration, ShouldEqual, 62.5)
+		So(info.VideoCodec, ShouldEqual, "h264")
+		var thumbs ThumbnailsMeta
+		So(node.GetMeta(MetadataThumbnails, &thumbs), ShouldBeNil)
+		So(thumbs.Thumbnails, ShouldHaveLength, 1)
+
+		args, e := ioutil.ReadFile(argsFile)
+		So(e, ShouldBeNil)
+		So(string(args), ShouldContainSubstring, "-protocol_whitelist file")
+		So(string(args), ShouldContainSubstring, "-f mov -i file:")
+
+		_, _, e = renderVideoFrame(context.Background(), fakeFfmpeg, filepath.Join(tmpDir, "playlist.m3u8"), tmpDir, 1)
+		So(e, ShouldNotBeNil)
+	})
+
+}
@@ -80,6 +80,13 @@ type ThumbnailExtractor struct {
 	common.RuntimeHolder
 	thumbSizes map[string]int
 	metaClient tree.NodeReceiverClient
+
+	// Local binaries used to render previews of PDFs, videos and HEIC/AVIF images, previews are skipped if they are empty
+	ffmpeg      string
+	pdftoppm    string
+	heifConvert string
+	// Position of the video frame used for thumbnails, in seconds
+	frameAfter float64
 }
 
 // GetDescription returns action description
@@ -88,7 +95,7 @@ func (t *ThumbnailExtractor) GetDescription(_ ...string) actions.ActionDescripti
 		ID:                thumbnailsActionName,
 		Label:             "Create Thumbs",
 		Icon:              "image-filter",
-		Description:       "Create thumbnails on image creation/modification",
+		Description:       "Create thumbnails on image creation/modification. PDFs (first page), videos (a frame after a few seconds) and HEIC/AVIF images are also supported if pdftoppm, ffmpeg and heif-convert binaries are configured in the tasks service",
 		SummaryTemplate:   "",
 		HasForm:           true,
 		Category:          actions.ActionCategoryContents,
@@ -111,6 +118,15 @@ func (t *ThumbnailExtractor) GetParametersForm() *forms.Form {
 					Mandatory:   false,
 					Editable:    true,
 				},
+				&forms.FormField{
+					Name:        "VideoFrameTime",
+					Type:        forms.ParamInteger,
+					Label:       "Video frame",
+					Description: "Position of the frame used as video thumbnail, in seconds",
+					Default:     defaultVideoFrameTime,
+					Mandatory:   false,
+					Editable:    true,
+				},
 			},
 		},
 	}}
@@ -136,8 +152,17 @@ func (t *ThumbnailExtractor) Init(job *jobs.Job, action *jobs.Action) error {
 	} else {
 		t.thumbSizes = map[string]int{"sm": 300}
 	}
+	t.frameAfter = defaultVideoFrameTime
+	if ft, ok := action.Parameters["VideoFrameTime"]; ok {
+		if parsed, e := strconv.ParseFloat(ft, 64); e == nil && parsed >= 0 {
+			t.frameAfter = parsed
+		}
+	}
 	if !nodes.IsUnitTestEnv {
 		t.metaClient = tree.NewNodeReceiverClient(grpc.GetClientConnFromCtx(t.GetRuntimeContext(), common.ServiceMeta))
+		t.ffmpeg = previewBinary("ffmpeg")
+		t.pdftoppm = previewBinary("pdftoppm")
+		t.heifConvert = previewBinary("heif-convert")
 	}
 	return nil
 }
@@ -151,9 +176,26 @@ func (t *ThumbnailExtractor) Run(ctx context.Context, _ *actions.RunnableChannel
 		return input.WithIgnore(), nil
 	}
 
-	log.Logger(ctx).Debug("[THUMB EXTRACTOR] Resizing image...")
 	node := input.Nodes[0]
-	err := t.resize(ctx, node, t.thumbSizes)
+	var err error
+	if kind := previewKindFor(node.GetPath()); kind != "" {
+		binary := t.pdftoppm
+		switch kind {
+		case previewVideo:
+			binary = t.ffmpeg
+		case previewHeif:
+			binary = t.heifConvert
+		}
+		if binary == "" {
+			log.Logger(ctx).Debug("[THUMB EXTRACTOR] no binary available for " + kind + " previews, ignoring " + node.GetPath())
+			return input.WithIgnore(), nil
+		}
+		log.Logger(ctx).Debug("[THUMB EXTRACTOR] Rendering " + kind + " preview...")
+		err = t.preview(ctx, node, kind, binary, t.thumbSizes)
+	} else {
+		log.Logger(ctx).Debug("[THUMB EXTRACTOR] Resizing image...")
+		err = t.resize(ctx, node, t.thumbSizes)
+	}
 	if err != nil {
 		return input.WithError(err), err
 	}
@@ -206,6 +248,12 @@ func (t *ThumbnailExtractor) resize(ctx context.Context, node *tree.Node, sizes
 	}
 	displayMemStat(ctx, "AFTER DECODE")
 
+	return t.writeImageThumbnails(ctx, src, node, sizes, errPath)
+}
+
+// writeImageThumbnails stores the image dimensions in the node metadata, then creates its thumbnails.
+func (t *ThumbnailExtractor) writeImageThumbnails(ctx context.Context, src image.Image, node *tree.Node, sizes map[string]int, errPath string) error {
+
 	// Extract dimensions
 	bounds := src.Bounds()
 	width := bounds.Max.X
@@ -224,11 +272,21 @@ func (t *ThumbnailExtractor) resize(ctx context.Context, node *tree.Node, sizes
 	node.MustSetMeta(MetadataCompatImageWidth, width)
 	node.MustSetMeta(MetadataCompatImageReadableDimensions, fmt.Sprintf("%dpx X %dpx", width, height))
 
-	if _, err = t.metaClient.UpdateNode(ctx, &tree.UpdateNodeRequest{From: node, To: node}); err != nil {
+	if _, err := t.metaClient.UpdateNode(ctx, &tree.UpdateNodeRequest{From: node, To: node}); err != nil {
 		return errors.Wrap(err, errPath)
 	}
 
 	log.Logger(ctx).Debug("Thumbnails - Extracted dimension and saved in metadata", zap.Any("dimension", bounds))
+
+	return t.writeThumbnails(ctx, src, node, sizes, errPath)
+}
+
+// writeThumbnails creates a thumbnail of src for each size, and stores the list in the node metadata.
+func (t *ThumbnailExtractor) writeThumbnails(ctx context.Context, src image.Image, node *tree.Node, sizes map[string]int, errPath string) error {
+
+	bounds := src.Bounds()
+	width := bounds.Max.X
+	height := bounds.Max.Y
 	meta := &ThumbnailsMeta{}
 
 	for metaId, size := range sizes {
@@ -268,7 +326,7 @@ func (t *ThumbnailExtractor) resize(ctx context.Context, node *tree.Node, sizes
 	}
 
 	log.TasksLogger(ctx).Info("Thumbs Generated for", zap.String(common.KeyNodePath, errPath), zap.Any("meta", meta))
-	_, err = t.metaClient.UpdateNode(ctx, &tree.UpdateNodeRequest{From: node, To: node})
+	_, err := t.metaClient.UpdateNode(ctx, &tree.UpdateNodeRequest{From: node, To: node})
 	if err != nil {
 		err = errors.Wrap(err, errPath)
 	}
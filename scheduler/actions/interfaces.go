@@ -114,6 +114,34 @@ type RecursiveNodeWalkerAction interface {
 	SetNodeFilterAsWalkFilter(*jobs.NodesSelector)
 }
 
+// Checkpointer persists the progress of an action inside its task, so that the task can be resumed
+// if it is interrupted by a restart.
+type Checkpointer interface {
+	// LastCheckpoint returns the cursor saved by a previous run of the action on the same input, if any.
+	LastCheckpoint() (cursor string, processed int64, ok bool)
+	// Checkpoint saves a cursor pointing to the last processed item.
+	Checkpoint(cursor string, processed int64)
+}
+
+// ResumableAction Actions that implement this interface save checkpoints while processing a large selection
+// or walking a tree, and restart from the last checkpoint when a task is resumed.
+type ResumableAction interface {
+	SetCheckpointer(c Checkpointer)
+}
+
+// SpoolingAction Actions that implement this interface keep local files that are referenced by their checkpoints.
+// At startup, files created before a given time and not referenced by the cursors of the interrupted tasks are removed.
+type SpoolingAction interface {
+	SweepSpools(ctx context.Context, cursors []string, before time.Time)
+}
+
+// DryRunnableAction Actions that implement this interface can describe what they would do with their input
+// when a job is run in dry-run mode. It returns the message that would be forwarded to the chained actions,
+// along with a human-readable plan, or nil and an error. Actions that do not implement it are described by DescribeInput.
+type DryRunnableAction interface {
+	DryRun(ctx context.Context, input *jobs.ActionMessage) (*jobs.ActionMessage, string, error)
+}
+
 // RunnableChannels defines the API to communicate with a Runnable via Channels
 type RunnableChannels struct {
 	// Input Channels
@@ -23,6 +23,7 @@ package actions
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/pydio/cells/v4/common"
 	"github.com/pydio/cells/v4/common/forms"
@@ -88,6 +89,21 @@ func (m *ActionsManager) DescribeActions(languages ...string) map[string]ActionD
 	return data
 }
 
+// SweepSpools lets registered SpoolingAction remove the local files that are not referenced by cursors.
+func (m *ActionsManager) SweepSpools(ctx context.Context, cursors []string, before time.Time) {
+	m.lock.Lock()
+	var spoolers []SpoolingAction
+	for _, gen := range m.registeredActions {
+		if s, ok := gen().(SpoolingAction); ok {
+			spoolers = append(spoolers, s)
+		}
+	}
+	m.lock.Unlock()
+	for _, s := range spoolers {
+		s.SweepSpools(ctx, cursors, before)
+	}
+}
+
 // LoadActionForm tries to load a forms.Form object that can be serialized for frontend
 func (m *ActionsManager) LoadActionForm(actionID string) (*forms.Form, error) {
 	if action, ok := m.ActionById(actionID); ok {
@@ -116,3 +132,8 @@ func (i *ignoredAction) Init(job *jobs.Job, action *jobs.Action) error {
 func (i *ignoredAction) Run(_ context.Context, _ *RunnableChannels, input jobs.ActionMessage) (jobs.ActionMessage, error) {
 	return input, nil
 }
+
+// DryRun does nothing more than Run
+func (i *ignoredAction) DryRun(_ context.Context, input *jobs.ActionMessage) (*jobs.ActionMessage, string, error) {
+	return input, "Nothing to process", nil
+}
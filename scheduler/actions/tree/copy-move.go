@@ -44,6 +44,7 @@ import (
 	"github.com/pydio/cells/v4/common/proto/tree"
 	"github.com/pydio/cells/v4/common/service/errors"
 	"github.com/pydio/cells/v4/common/utils/i18n"
+	json "github.com/pydio/cells/v4/common/utils/jsonx"
 	"github.com/pydio/cells/v4/common/utils/permissions"
 	"github.com/pydio/cells/v4/scheduler/actions"
 	"github.com/pydio/cells/v4/scheduler/actions/tools"
@@ -60,6 +61,13 @@ type CopyMoveAction struct {
 	targetPlaceholder string
 	createFolder      bool
 	targetIsParent    bool
+	checkpointer      actions.Checkpointer
+}
+
+// copyMoveCursor is saved as task checkpoint
+type copyMoveCursor struct {
+	Target string `json:"Target"`
+	Child  string `json:"Child,omitempty"`
 }
 
 func (c *CopyMoveAction) GetDescription(_ ...string) actions.ActionDescription {
@@ -133,6 +141,11 @@ func (c *CopyMoveAction) ProvidesProgress() bool {
 	return true
 }
 
+// SetCheckpointer implements ResumableAction: a recursive copy or move restarts after the last processed child.
+func (c *CopyMoveAction) SetCheckpointer(cp actions.Checkpointer) {
+	c.checkpointer = cp
+}
+
 // Init passes parameters to the action
 func (c *CopyMoveAction) Init(job *jobs.Job, action *jobs.Action) error {
 
@@ -168,13 +181,7 @@ func (c *CopyMoveAction) Run(ctx context.Context, channels *actions.RunnableChan
 	sourceNode := input.Nodes[0]
 	T := lang.Bundle().GetTranslationFunc(i18n.UserLanguageFromContext(ctx, config.Get(), true))
 
-	targetNode := &tree.Node{
-		Path: jobs.EvaluateFieldStr(ctx, input, c.targetPlaceholder),
-	}
-	if c.targetIsParent {
-		targetNode.Path = path.Join(targetNode.Path, path.Base(sourceNode.Path))
-	}
-	targetNode.Path = strings.ReplaceAll(targetNode.Path, "//", "/")
+	targetNode := c.targetNode(ctx, &input, sourceNode)
 
 	log.Logger(ctx).Debug("Copy/Move target path is", targetNode.ZapPath(), zap.Bool("targetIsParent", c.targetIsParent))
 
@@ -185,8 +192,31 @@ func (c *CopyMoveAction) Run(ctx context.Context, channels *actions.RunnableChan
 	}
 	ctx = c2
 
-	// Handle already existing
-	c.suffixPathIfNecessary(ctx, cli, targetNode)
+	var cursor copyMoveCursor
+	var processed int64
+	if c.checkpointer != nil {
+		var last string
+		var ok bool
+		if last, processed, ok = c.checkpointer.LastCheckpoint(); ok && json.Unmarshal([]byte(last), &cursor) == nil && cursor.Target != "" {
+			// Resume into the same target
+			targetNode.Path = cursor.Target
+			log.TasksLogger(ctx).Info("Resuming interrupted copy/move to " + cursor.Target)
+		}
+	}
+	if cursor.Target == "" {
+		// Handle already existing
+		c.suffixPathIfNecessary(ctx, cli, targetNode)
+		cursor.Target = targetNode.Path
+	}
+	if c.checkpointer != nil {
+		c.saveCheckpoint(cursor, processed)
+		ctx = nodes.WithCopyMoveCursor(ctx, &nodes.CopyMoveCursor{
+			After: cursor.Child,
+			Processed: func(relPath string, count int) {
+				c.saveCheckpoint(copyMoveCursor{Target: cursor.Target, Child: relPath}, int64(count))
+			},
+		})
+	}
 
 	readR, readE := cli.ReadNode(ctx, &tree.ReadNodeRequest{Node: sourceNode})
 	if readE != nil {
@@ -214,6 +244,57 @@ func (c *CopyMoveAction) Run(ctx context.Context, channels *actions.RunnableChan
 
 }
 
+// DryRun resolves the target path and describes what would be copied or moved, without touching any node.
+func (c *CopyMoveAction) DryRun(ctx context.Context, input *jobs.ActionMessage) (*jobs.ActionMessage, string, error) {
+
+	if len(input.Nodes) == 0 {
+		o := input.WithIgnore()
+		return &o, "No node to copy or move", nil
+	}
+	sourceNode := input.Nodes[0]
+	targetNode := c.targetNode(ctx, input, sourceNode)
+
+	c2, cli, e := c.GetHandler(ctx)
+	if e != nil {
+		return nil, "", e
+	}
+	ctx = c2
+
+	readR, readE := cli.ReadNode(ctx, &tree.ReadNodeRequest{Node: sourceNode})
+	if readE != nil {
+		return nil, "", readE
+	}
+	desc, e := describeNode(ctx, cli, readR.GetNode())
+	if e != nil {
+		return nil, "", e
+	}
+	c.suffixPathIfNecessary(ctx, cli, targetNode)
+
+	verb := "copy"
+	if c.move {
+		verb = "move"
+	}
+	output := input.WithNode(targetNode)
+	return &output, fmt.Sprintf("Would %s %s to %s", verb, desc, targetNode.GetPath()), nil
+}
+
+// targetNode computes the target of the copy/move from the action parameters.
+func (c *CopyMoveAction) targetNode(ctx context.Context, input *jobs.ActionMessage, sourceNode *tree.Node) *tree.Node {
+	targetNode := &tree.Node{
+		Path: jobs.EvaluateFieldStr(ctx, *input, c.targetPlaceholder),
+	}
+	if c.targetIsParent {
+		targetNode.Path = path.Join(targetNode.Path, path.Base(sourceNode.Path))
+	}
+	targetNode.Path = strings.ReplaceAll(targetNode.Path, "//", "/")
+	return targetNode
+}
+
+func (c *CopyMoveAction) saveCheckpoint(cursor copyMoveCursor, processed int64) {
+	data, _ := json.Marshal(cursor)
+	c.checkpointer.Checkpoint(string(data), processed)
+}
+
 func (c *CopyMoveAction) suffixPathIfNecessary(ctx context.Context, cli nodes.Handler, targetNode *tree.Node) {
 	// Look for registered child locks : children that are currently in creation
 	pNode := &tree.Node{Path: path.Dir(targetNode.Path)}
@@ -236,3 +236,43 @@ func (c *DeleteAction) Run(ctx context.Context, channels *actions.RunnableChanne
 
 	return output, nil
 }
+
+// DryRun reads the input node and describes what would be deleted, without deleting anything.
+func (c *DeleteAction) DryRun(ctx context.Context, input *jobs.ActionMessage) (*jobs.ActionMessage, string, error) {
+
+	if len(input.Nodes) == 0 {
+		o := input.WithIgnore()
+		return &o, "No node to delete", nil
+	}
+
+	childrenOnly, e := jobs.EvaluateFieldBool(ctx, *input, c.childrenOnlyParam)
+	if e != nil {
+		return nil, "", e
+	}
+
+	c2, cli, e := c.GetHandler(ctx)
+	if e != nil {
+		return nil, "", e
+	}
+	ctx = c2
+
+	readR, readE := cli.ReadNode(ctx, &tree.ReadNodeRequest{Node: input.Nodes[0]})
+	if readE != nil {
+		if ignore, _ := jobs.EvaluateFieldBool(ctx, *input, c.ignoreNonExisting); ignore {
+			o := input.WithIgnore()
+			return &o, "No file found at " + input.Nodes[0].GetPath() + ", nothing would be deleted", nil
+		}
+		return nil, "", readE
+	}
+	desc, e := describeNode(ctx, cli, readR.GetNode())
+	if e != nil {
+		return nil, "", e
+	}
+	plan := "Would delete " + desc
+	if childrenOnly && !readR.GetNode().IsLeaf() {
+		plan = "Would delete the content of " + desc
+	}
+
+	output := input.WithNode(nil)
+	return &output, plan, nil
+}
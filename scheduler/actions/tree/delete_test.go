@@ -84,3 +84,37 @@ func TestDeleteAction_Run(t *testing.T) {
 
 	})
 }
+
+func TestDeleteAction_DryRun(t *testing.T) {
+
+	Convey("", t, func() {
+
+		action := &DeleteAction{}
+		job := &jobs.Job{}
+		action.Init(job, &jobs.Action{})
+		mock := &nodes.HandlerMock{
+			Nodes: map[string]*tree.Node{
+				"/folder":          {Path: "/folder", Type: tree.NodeType_COLLECTION},
+				"/folder/a.txt":    {Path: "/folder/a.txt", Type: tree.NodeType_LEAF, Size: 1000},
+				"/folder/sub":      {Path: "/folder/sub", Type: tree.NodeType_COLLECTION},
+				"/folder/sub/b.go": {Path: "/folder/sub/b.go", Type: tree.NodeType_LEAF, Size: 500},
+			},
+		}
+		action.PresetHandler(mock)
+
+		ignored, plan, err := action.DryRun(context.Background(), &jobs.ActionMessage{})
+		So(err, ShouldBeNil)
+		So(ignored.GetLastOutput().Ignored, ShouldBeTrue)
+		So(plan, ShouldNotBeEmpty)
+
+		output, plan, err := action.DryRun(context.Background(), &jobs.ActionMessage{
+			Nodes: []*tree.Node{{Path: "/folder"}},
+		})
+		So(err, ShouldBeNil)
+		So(output.Nodes, ShouldHaveLength, 0)
+		So(plan, ShouldEqual, "Would delete folder /folder (2 file(s), 1 folder(s), 1.5 kB)")
+		So(mock.Nodes, ShouldContainKey, "/folder/a.txt")
+		So(mock.Nodes, ShouldContainKey, "/folder/sub/b.go")
+
+	})
+}
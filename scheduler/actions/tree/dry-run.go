@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package tree
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/pydio/cells/v4/common/nodes"
+	"github.com/pydio/cells/v4/common/proto/tree"
+)
+
+// describeNode walks a node recursively to report the number of files and folders, as well as the total size,
+// that an operation on this node would touch.
+func describeNode(ctx context.Context, cli nodes.Handler, node *tree.Node) (string, error) {
+	if node.IsLeaf() {
+		return fmt.Sprintf("file %s (%s)", node.GetPath(), humanize.Bytes(uint64(node.GetSize()))), nil
+	}
+	var files, folders int
+	var size int64
+	e := cli.ListNodesWithCallback(ctx, &tree.ListNodesRequest{Node: node, Recursive: true}, func(ctx context.Context, n *tree.Node, err error) error {
+		if err != nil || n.GetPath() == node.GetPath() {
+			return err
+		}
+		if n.IsLeaf() {
+			files++
+			size += n.GetSize()
+		} else {
+			folders++
+		}
+		return nil
+	}, true, nodes.WalkFilterSkipPydioHiddenFile)
+	if e != nil {
+		return "", e
+	}
+	return fmt.Sprintf("folder %s (%d file(s), %d folder(s), %s)", node.GetPath(), files, folders, humanize.Bytes(uint64(size))), nil
+}
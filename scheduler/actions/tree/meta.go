@@ -126,3 +126,35 @@ func (c *MetaAction) Run(ctx context.Context, channels *actions.RunnableChannels
 
 	return input, nil
 }
+
+// DryRun describes the metadata that would be stored on the input nodes, and forwards the updated nodes
+// without storing them.
+func (c *MetaAction) DryRun(ctx context.Context, input *jobs.ActionMessage) (*jobs.ActionMessage, string, error) {
+
+	if len(input.Nodes) == 0 {
+		o := input.WithIgnore()
+		return &o, "No node to update", nil
+	}
+
+	ms := jobs.EvaluateFieldStr(ctx, *input, c.MetaJSON)
+	var mm map[string]interface{}
+	if e := json.Unmarshal([]byte(ms), &mm); e != nil {
+		return nil, "", e
+	}
+	var updated []*tree.Node
+	for _, n := range input.Nodes {
+		u := n.Clone()
+		for k, v := range mm {
+			u.MustSetMeta(k, v)
+		}
+		updated = append(updated, u)
+	}
+	plan := "Would store node metadata for " + actions.DescribeNodes(input.Nodes)
+	if len(mm) > 0 {
+		plan = fmt.Sprintf("Would update %s with meta %s and store them", actions.DescribeNodes(input.Nodes), ms)
+	}
+
+	output := input.WithNode(nil)
+	output.Nodes = updated
+	return &output, plan, nil
+}
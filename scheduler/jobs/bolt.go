@@ -22,6 +22,7 @@ package jobs
 
 import (
 	"context"
+	"encoding/binary"
 	bolt "go.etcd.io/bbolt"
 	"go.uber.org/zap"
 	"sort"
@@ -40,6 +41,8 @@ var (
 	jobsBucketKey = []byte("jobs")
 	// Running tasks
 	tasksBucketString = "tasks-"
+	// Jobs definitions history
+	revisionsBucketString = "revisions-"
 )
 
 type BoltStore struct {
@@ -127,6 +130,9 @@ func (s *BoltStore) DeleteJob(jobID string) error {
 				err = tx.DeleteBucket([]byte(tasksBucketString + jobID))
 			}
 		}
+		if err == nil && tx.Bucket([]byte(revisionsBucketString+jobID)) != nil {
+			err = tx.DeleteBucket([]byte(revisionsBucketString + jobID))
+		}
 		if err != nil {
 			log.Logger(context.Background()).Error("Error on Job Deletion: ", zap.Error(err))
 		}
@@ -135,6 +141,118 @@ func (s *BoltStore) DeleteJob(jobID string) error {
 
 }
 
+// PutJobRevision stores a new revision of a job definition. If the definition did not change since the last
+// revision, nothing is stored and revision is updated with the last revision number.
+func (s *BoltStore) PutJobRevision(revision *jobs.JobRevision) error {
+
+	return s.DB().Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists([]byte(revisionsBucketString + revision.JobID))
+		if err != nil {
+			return err
+		}
+		if _, v := bucket.Cursor().Last(); v != nil {
+			last := &jobs.JobRevision{}
+			if e := json.Unmarshal(v, last); e == nil && sameJobDefinition(last.Job, revision.Job) {
+				revision.Revision = last.Revision
+				return nil
+			}
+		}
+		seq, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		revision.Revision = int32(seq)
+		jsonData, err := json.Marshal(revision)
+		if err != nil {
+			return err
+		}
+		if err := bucket.Put(revisionKey(revision.Revision), jsonData); err != nil {
+			return err
+		}
+		// Prune oldest revisions
+		var oldest [][]byte
+		var count int
+		c := bucket.Cursor()
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			count++
+		}
+		for k, _ := c.First(); k != nil && count > maxJobRevisions; k, _ = c.Next() {
+			oldest = append(oldest, k)
+			count--
+		}
+		for _, k := range oldest {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+}
+
+// ListJobRevisions lists revisions of a job, most recent first.
+func (s *BoltStore) ListJobRevisions(jobId string, offset, limit int32) ([]*jobs.JobRevision, error) {
+
+	var revisions []*jobs.JobRevision
+	e := s.DB().View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(revisionsBucketString + jobId))
+		if bucket == nil {
+			return nil
+		}
+		c := bucket.Cursor()
+		var index int32
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			if index < offset {
+				index++
+				continue
+			}
+			if limit > 0 && int32(len(revisions)) >= limit {
+				break
+			}
+			rev := &jobs.JobRevision{}
+			if err := json.Unmarshal(v, rev); err != nil {
+				return errors.InternalServerError(common.ServiceJobs, "Cannot deserialize job revision")
+			}
+			revisions = append(revisions, rev)
+			index++
+		}
+		return nil
+	})
+	return revisions, e
+
+}
+
+// GetJobRevision loads a specific revision of a job.
+func (s *BoltStore) GetJobRevision(jobId string, revision int32) (*jobs.JobRevision, error) {
+
+	rev := &jobs.JobRevision{}
+	e := s.DB().View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(revisionsBucketString + jobId))
+		if bucket == nil {
+			return errors.NotFound(common.ServiceJobs, "Job revision not found")
+		}
+		data := bucket.Get(revisionKey(revision))
+		if data == nil {
+			return errors.NotFound(common.ServiceJobs, "Job revision not found")
+		}
+		if err := json.Unmarshal(data, rev); err != nil {
+			return errors.InternalServerError(common.ServiceJobs, "Cannot deserialize job revision")
+		}
+		return nil
+	})
+	if e != nil {
+		return nil, e
+	}
+	return rev, nil
+
+}
+
+func revisionKey(revision int32) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, uint64(revision))
+	return k
+}
+
 func (s *BoltStore) ListJobs(owner string, eventsOnly bool, timersOnly bool, withTasks jobs.TaskStatus, jobIDs []string, taskCursor ...int32) (chan *jobs.Job, chan bool, error) {
 
 	res := make(chan *jobs.Job)
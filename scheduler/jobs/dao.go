@@ -21,6 +21,8 @@
 package jobs
 
 import (
+	"google.golang.org/protobuf/proto"
+
 	"github.com/pydio/cells/v4/common/dao"
 	"github.com/pydio/cells/v4/common/dao/boltdb"
 	"github.com/pydio/cells/v4/common/dao/mongodb"
@@ -38,6 +40,10 @@ type DAO interface {
 	DeleteJob(jobId string) error
 	ListJobs(owner string, eventsOnly bool, timersOnly bool, withTasks jobs.TaskStatus, jobIDs []string, taskCursor ...int32) (chan *jobs.Job, chan bool, error)
 
+	PutJobRevision(revision *jobs.JobRevision) error
+	ListJobRevisions(jobId string, offset, limit int32) ([]*jobs.JobRevision, error)
+	GetJobRevision(jobId string, revision int32) (*jobs.JobRevision, error)
+
 	PutTask(task *jobs.Task) error
 	PutTasks(task map[string]map[string]*jobs.Task) error
 	ListTasks(jobId string, taskStatus jobs.TaskStatus, cursor ...int32) (chan *jobs.Task, chan bool, error)
@@ -56,6 +62,19 @@ func NewDAO(dao dao.DAO) dao.DAO {
 	return nil
 }
 
+// maxJobRevisions is the number of revisions kept for each job
+const maxJobRevisions = 50
+
+// sameJobDefinition compares two jobs definitions, ignoring their tasks
+func sameJobDefinition(j1, j2 *jobs.Job) bool {
+	if j1 == nil || j2 == nil {
+		return false
+	}
+	c1, c2 := proto.Clone(j1).(*jobs.Job), proto.Clone(j2).(*jobs.Job)
+	c1.Tasks, c2.Tasks = nil, nil
+	return proto.Equal(c1, c2)
+}
+
 // stripTaskData removes unnecessary data from the task log
 // like fully loaded users, nodes, activities, etc.
 func stripTaskData(task *jobs.Task) {
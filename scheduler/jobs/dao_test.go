@@ -308,6 +308,89 @@ func loadTasks(db DAO, jobId string, jobStatus jobs.TaskStatus, offset ...int32)
 	return allTasks, nil
 }
 
+func TestDAO_JobRevisions(t *testing.T) {
+
+	Convey("Test Job Revisions", t, func() {
+
+		db, closer := initDAO("bolt-test-revisions")
+		defer closer()
+
+		job := &jobs.Job{ID: "revised-job", Label: "Version 1", Owner: "admin"}
+		rev := &jobs.JobRevision{JobID: job.ID, Author: "admin", Job: job}
+		So(db.PutJobRevision(rev), ShouldBeNil)
+		So(rev.Revision, ShouldEqual, 1)
+
+		// Same definition does not create a revision
+		rev = &jobs.JobRevision{JobID: job.ID, Author: "admin", Job: &jobs.Job{ID: "revised-job", Label: "Version 1", Owner: "admin"}}
+		So(db.PutJobRevision(rev), ShouldBeNil)
+		So(rev.Revision, ShouldEqual, 1)
+
+		for i := 2; i <= maxJobRevisions+2; i++ {
+			rev = &jobs.JobRevision{JobID: job.ID, Message: "Update", Job: &jobs.Job{ID: "revised-job", Label: fmt.Sprintf("Version %d", i), Owner: "admin"}}
+			So(db.PutJobRevision(rev), ShouldBeNil)
+			So(rev.Revision, ShouldEqual, i)
+		}
+
+		revisions, e := db.ListJobRevisions(job.ID, 0, 2)
+		So(e, ShouldBeNil)
+		So(revisions, ShouldHaveLength, 2)
+		So(revisions[0].Revision, ShouldEqual, maxJobRevisions+2)
+		So(revisions[1].Job.Label, ShouldEqual, fmt.Sprintf("Version %d", maxJobRevisions+1))
+
+		// Oldest revisions are pruned
+		revisions, e = db.ListJobRevisions(job.ID, 0, 0)
+		So(e, ShouldBeNil)
+		So(revisions, ShouldHaveLength, maxJobRevisions)
+		_, e = db.GetJobRevision(job.ID, 1)
+		So(e, ShouldNotBeNil)
+
+		r, e := db.GetJobRevision(job.ID, 3)
+		So(e, ShouldBeNil)
+		So(r.Job.Label, ShouldEqual, "Version 3")
+
+		So(db.PutJob(job), ShouldBeNil)
+		So(db.DeleteJob(job.ID), ShouldBeNil)
+		revisions, e = db.ListJobRevisions(job.ID, 0, 0)
+		So(e, ShouldBeNil)
+		So(revisions, ShouldBeEmpty)
+
+	})
+}
+
+func TestDAO_ConcurrentJobRevisions(t *testing.T) {
+
+	Convey("Test concurrent Job Revisions", t, func() {
+
+		db, closer := initDAO("bolt-test-concurrent-revisions")
+		defer closer()
+
+		workers := 4
+		wg := &sync.WaitGroup{}
+		errs := make(chan error, workers)
+		for i := 1; i <= workers; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				errs <- db.PutJobRevision(&jobs.JobRevision{JobID: "concurrent-job", Job: &jobs.Job{ID: "concurrent-job", Label: fmt.Sprintf("Version %d", i)}})
+			}(i)
+		}
+		wg.Wait()
+		close(errs)
+		for e := range errs {
+			So(e, ShouldBeNil)
+		}
+
+		// Each write got its own revision number
+		revisions, e := db.ListJobRevisions("concurrent-job", 0, 0)
+		So(e, ShouldBeNil)
+		So(revisions, ShouldHaveLength, workers)
+		for i, r := range revisions {
+			So(r.Revision, ShouldEqual, workers-i)
+		}
+
+	})
+}
+
 func TestDAO_PutTask(t *testing.T) {
 
 	Convey("Test Put Task", t, func() {
@@ -329,6 +412,30 @@ func TestDAO_PutTask(t *testing.T) {
 		So(allTasks, ShouldHaveLength, 1)
 
 	})
+
+	Convey("Test Put Task with checkpoints", t, func() {
+
+		db, closer := initDAO("bolt-test-put-checkpoints")
+		defer closer()
+
+		e := db.PutTask(&jobs.Task{
+			ID:     "resumable-task-id",
+			JobID:  "resumable-job-id",
+			Status: jobs.TaskStatus_Running,
+			Checkpoints: []*jobs.TaskCheckpoint{
+				{ActionPath: "ROOT/actions.tree.copymove$0", InputHash: "hash", Cursor: "folder/file", Processed: 12},
+			},
+		})
+		So(e, ShouldBeNil)
+
+		allTasks, err := loadTasks(db, "resumable-job-id", jobs.TaskStatus_Running)
+		So(err, ShouldBeNil)
+		So(allTasks, ShouldHaveLength, 1)
+		So(allTasks[0].Checkpoints, ShouldHaveLength, 1)
+		So(allTasks[0].Checkpoints[0].Cursor, ShouldEqual, "folder/file")
+		So(allTasks[0].Checkpoints[0].Processed, ShouldEqual, 12)
+
+	})
 }
 
 func TestDAO_listTask(t *testing.T) {
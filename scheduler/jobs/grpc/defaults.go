@@ -66,10 +66,10 @@ func getDefaultJobs() []*jobs.Job {
 			jobs.NodeChangeEventName(tree.NodeChangeEvent_DELETE),
 		},
 		NodeEventFilter: &jobs.NodesSelector{
-			Label: "Images Only",
+			Label: "Images, PDFs and Videos",
 			Query: &service.Query{
 				SubQueries: []*anypb.Any{jobs.MustMarshalAny(&tree.Query{
-					Extension: "jpg,png,jpeg,gif,bmp,tiff",
+					Extension: "jpg,png,jpeg,gif,bmp,tiff,webp,heic,heif,avif,pdf,mp4,m4v,mov,avi,mkv,webm,mpg,mpeg,wmv",
 					MinSize:   1,
 				})},
 			},
@@ -84,10 +84,10 @@ func getDefaultJobs() []*jobs.Job {
 				ID:            "actions.images.exif",
 				TriggerFilter: triggerCreate,
 				NodesFilter: &jobs.NodesSelector{
-					Label: "Jpg only",
+					Label: "Jpg, WebP, HEIC and AVIF",
 					Query: &service.Query{
 						SubQueries: []*anypb.Any{jobs.MustMarshalAny(&tree.Query{
-							Extension: "jpg,jpeg",
+							Extension: "jpg,jpeg,webp,heic,heif,avif",
 						})},
 					},
 				},
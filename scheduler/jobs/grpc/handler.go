@@ -22,6 +22,7 @@ package grpc
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"sync"
 	"time"
@@ -32,10 +33,13 @@ import (
 	logcore "github.com/pydio/cells/v4/broker/log/grpc"
 	"github.com/pydio/cells/v4/common"
 	"github.com/pydio/cells/v4/common/broker"
+	"github.com/pydio/cells/v4/common/config"
 	"github.com/pydio/cells/v4/common/log"
 	proto "github.com/pydio/cells/v4/common/proto/jobs"
 	log2 "github.com/pydio/cells/v4/common/proto/log"
 	"github.com/pydio/cells/v4/common/service/errors"
+	"github.com/pydio/cells/v4/common/utils/permissions"
+	"github.com/pydio/cells/v4/common/utils/schedule"
 	"github.com/pydio/cells/v4/scheduler/jobs"
 	"github.com/pydio/cells/v4/scheduler/lang"
 )
@@ -84,11 +88,23 @@ func (j *JobsHandler) Name() string {
 /////////////////
 
 func (j *JobsHandler) PutJob(ctx context.Context, request *proto.PutJobRequest) (*proto.PutJobResponse, error) {
+	if c := request.GetJob().GetSchedule().GetCron(); c != "" {
+		if _, er := schedule.NewTickerScheduleFromCron(c, request.GetJob().GetSchedule().GetTimezone()); er != nil {
+			return nil, errors.BadRequest(common.ServiceJobs, "Invalid cron schedule: "+er.Error())
+		}
+	}
+	j.storeWebhookSecret(request.Job)
 	err := j.store.PutJob(request.Job)
 	log.Logger(ctx).Debug("Scheduler PutJob", zap.Any("job", request.Job))
 	if err != nil {
 		return nil, err
 	}
+	// Do not keep history of one-shot jobs
+	if !request.Job.AutoStart || !request.Job.AutoClean {
+		if er := j.putRevision(ctx, request.Job, request.RevisionMessage); er != nil {
+			log.Logger(ctx).Warn("Cannot store job revision", request.Job.ZapId(), zap.Error(er))
+		}
+	}
 	response := &proto.PutJobResponse{}
 	response.Job = request.Job
 	broker.MustPublish(j.RuntimeCtx, common.TopicJobConfigEvent, &proto.JobChangeEvent{
@@ -114,11 +130,15 @@ func (j *JobsHandler) DeleteJob(ctx context.Context, request *proto.DeleteJobReq
 	if request.JobID != "" {
 
 		log.Logger(ctx).Debug("Scheduler DeleteJob", zap.String("jobId", request.JobID))
+		previous, _ := j.store.GetJob(request.JobID, proto.TaskStatus_Unknown)
 		err := j.store.DeleteJob(request.JobID)
 		if err != nil {
 			response.Success = false
 			return nil, err
 		}
+		if previous.GetWebhook() != nil {
+			config.DelSecret(previous.WebhookSecretKey())
+		}
 		broker.MustPublish(j.RuntimeCtx, common.TopicJobConfigEvent, &proto.JobChangeEvent{
 			JobRemoved: request.JobID,
 		})
@@ -209,6 +229,68 @@ func (j *JobsHandler) ListJobs(request *proto.ListJobsRequest, streamer proto.Jo
 	}
 }
 
+// ListJobRevisions lists the history of a job definition, most recent first. Secrets are redacted.
+func (j *JobsHandler) ListJobRevisions(ctx context.Context, request *proto.ListJobRevisionsRequest) (*proto.ListJobRevisionsResponse, error) {
+	revisions, err := j.store.ListJobRevisions(request.JobID, request.Offset, request.Limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range revisions {
+		if r.Job != nil {
+			r.Job = r.Job.WithoutSecrets()
+		}
+	}
+	return &proto.ListJobRevisionsResponse{Revisions: revisions}, nil
+}
+
+// RestoreJobRevision saves a previous revision as the current job definition, creating a new revision.
+// As secrets are redacted in revisions, they are taken from the current job definition.
+func (j *JobsHandler) RestoreJobRevision(ctx context.Context, request *proto.RestoreJobRevisionRequest) (*proto.RestoreJobRevisionResponse, error) {
+	revision, err := j.store.GetJobRevision(request.JobID, request.Revision)
+	if err != nil {
+		return nil, err
+	}
+	current, _ := j.store.GetJob(request.JobID, proto.TaskStatus_Unknown)
+	revision.Job.RestoreSecrets(current)
+	resp, err := j.PutJob(ctx, &proto.PutJobRequest{
+		Job:             revision.Job,
+		RevisionMessage: fmt.Sprintf("Restored revision %d", revision.Revision),
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Logger(ctx).Info(fmt.Sprintf("Restored job to revision %d", revision.Revision), revision.Job.ZapId())
+	return &proto.RestoreJobRevisionResponse{Job: resp.Job}, nil
+}
+
+// storeWebhookSecret moves the webhook secret to the vault, so that it is never stored nor sent back
+// with the job. An empty secret keeps the current one (e.g. when importing a job exported without
+// secrets over an existing job), and removing the webhook deletes it.
+func (j *JobsHandler) storeWebhookSecret(job *proto.Job) {
+	if w := job.GetWebhook(); w != nil {
+		if w.HmacSecret != "" {
+			config.SetSecret(job.WebhookSecretKey(), w.HmacSecret)
+			w.HmacSecret = ""
+		}
+	} else if previous, e := j.store.GetJob(job.GetID(), proto.TaskStatus_Unknown); e == nil && previous.GetWebhook() != nil {
+		config.DelSecret(job.WebhookSecretKey())
+	}
+}
+
+func (j *JobsHandler) putRevision(ctx context.Context, job *proto.Job, message string) error {
+	author, _ := permissions.FindUserNameInContext(ctx)
+	if author == "" {
+		author = common.PydioSystemUsername
+	}
+	return j.store.PutJobRevision(&proto.JobRevision{
+		JobID:   job.ID,
+		Time:    int32(time.Now().Unix()),
+		Author:  author,
+		Message: message,
+		Job:     job.WithoutSecrets(),
+	})
+}
+
 //////////////////
 // TASKS STORE
 /////////////////
@@ -457,10 +539,11 @@ func (j *JobsHandler) DetectStuckTasks(ctx context.Context, request *proto.Detec
 	return response, nil
 }
 
-// CleanStuckTasks may be run at startup to
+// CleanStuckTasks may be run at startup to flag tasks saved as running in Error status.
+// At startup, tasks that saved checkpoints are set back in Queued status instead, so that they can be resumed.
 func (j *JobsHandler) CleanStuckTasks(ctx context.Context, duration ...time.Duration) ([]*proto.Task, error) {
 
-	var fixedTasks []*proto.Task
+	var fixedTasks, stuckTasks []*proto.Task
 
 	res, done, err := j.store.ListTasks("", proto.TaskStatus_Running)
 	defer close(res)
@@ -471,25 +554,44 @@ func (j *JobsHandler) CleanStuckTasks(ctx context.Context, duration ...time.Dura
 		select {
 
 		case <-done:
-			for _, t := range fixedTasks {
-				log.Logger(ctx).Info("Setting task " + t.ID + " in error status as it was saved as running")
+			for _, t := range stuckTasks {
+				if len(duration) == 0 && j.isResumable(t) {
+					log.Logger(ctx).Info("Setting task " + t.ID + " in queued status as it was interrupted and can be resumed")
+					t.Status = proto.TaskStatus_Queued
+					t.StatusMessage = "Interrupted, waiting to be resumed"
+				} else {
+					log.Logger(ctx).Info("Setting task " + t.ID + " in error status as it was saved as running")
+					t.Status = proto.TaskStatus_Error
+					t.StatusMessage = "Task stuck"
+				}
 				j.store.PutTask(t)
+				fixedTasks = append(fixedTasks, t)
 			}
 			return fixedTasks, nil
 
 		case t := <-res:
-			t.Status = proto.TaskStatus_Error
-			t.StatusMessage = "Task stuck"
 			if len(duration) > 0 && t.StartTime > 0 {
 				check := duration[0]
 				startTime := time.Unix(int64(t.StartTime), 0)
 				if time.Since(startTime) > check {
-					fixedTasks = append(fixedTasks, t)
+					stuckTasks = append(stuckTasks, t)
 				}
 			} else {
-				fixedTasks = append(fixedTasks, t)
+				stuckTasks = append(stuckTasks, t)
 			}
 		}
 	}
 
 }
+
+// isResumable checks if a task saved checkpoints and belongs to a job that is not triggered by events.
+func (j *JobsHandler) isResumable(t *proto.Task) bool {
+	if len(t.GetCheckpoints()) == 0 {
+		return false
+	}
+	job, e := j.store.GetJob(t.JobID, 0)
+	if e != nil || job == nil {
+		return false
+	}
+	return len(job.EventNames) == 0
+}
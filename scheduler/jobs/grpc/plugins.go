@@ -49,6 +49,7 @@ var (
 	Migration140 = false
 	Migration150 = false
 	Migration230 = false
+	Migration401 = false
 )
 
 const ServiceName = common.ServiceGrpcNamespace_ + common.ServiceJobs
@@ -101,6 +102,14 @@ func init() {
 						return nil
 					},
 				},
+				{
+					TargetVersion: service.ValidVersion("4.0.1"),
+					Up: func(ctx context.Context) error {
+						// Set flag for migration script to be run AfterStart (see below, handler cannot be shared)
+						Migration401 = true
+						return nil
+					},
+				},
 			}),
 			service.WithGRPC(func(c context.Context, server *grpc.Server) error {
 
@@ -126,8 +135,8 @@ func init() {
 					if _, e := handler.GetJob(c, &proto.GetJobRequest{JobID: j.ID}); e != nil {
 						handler.PutJob(c, &proto.PutJobRequest{Job: j})
 					}
-					// Force re-adding thumbs job
-					if Migration230 && j.ID == "thumbs-job" {
+					// Force re-adding thumbs job (4.0.1 adds documents, videos and WebP/HEIC/AVIF images previews and exif)
+					if (Migration230 || Migration401) && j.ID == "thumbs-job" {
 						handler.PutJob(c, &proto.PutJobRequest{Job: j})
 					}
 				}
@@ -35,8 +35,12 @@ import (
 )
 
 const (
-	collJobs  = "jobs"
-	collTasks = "tasks"
+	// putRevisionRetries is the number of attempts to insert a revision when a concurrent write used the same number
+	putRevisionRetries = 5
+
+	collJobs      = "jobs"
+	collTasks     = "tasks"
+	collRevisions = "jobs_revisions"
 )
 
 var (
@@ -55,6 +59,10 @@ var (
 					{"id": 1, "job_id": 1, "status": 1, "ts": -1},
 				},
 			},
+			{
+				// Unique index on job_id and revision is created in Init
+				Name: collRevisions,
+			},
 		},
 	}
 )
@@ -75,6 +83,12 @@ type mongoTask struct {
 	*jobs.Task
 }
 
+type mongoRevision struct {
+	JobId    string `bson:"job_id"`
+	Revision int32  `bson:"revision"`
+	*jobs.JobRevision
+}
+
 type mongoImpl struct {
 	mongodb.DAO
 }
@@ -83,6 +97,13 @@ func (m *mongoImpl) Init(values configx.Values) error {
 	if er := model.Init(context.Background(), m.DB()); er != nil {
 		return er
 	}
+	// Revision numbers are computed from the last revision, a unique index detects concurrent writes
+	if _, er := m.DB().Collection(collRevisions).Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{"job_id", 1}, {"revision", 1}},
+		Options: options.Index().SetUnique(true),
+	}); er != nil {
+		return er
+	}
 	return m.DAO.Init(values)
 }
 
@@ -131,6 +152,9 @@ func (m *mongoImpl) DeleteJob(jobId string) error {
 	if e != nil {
 		return e
 	}
+	if _, e := m.DB().Collection(collRevisions).DeleteMany(c, bson.D{{"job_id", jobId}}); e != nil {
+		return e
+	}
 	//fmt.Println("Delete", res.DeletedCount, "job")
 	return nil
 }
@@ -189,6 +213,80 @@ func (m *mongoImpl) ListJobs(owner string, eventsOnly bool, timersOnly bool, wit
 
 }
 
+// PutJobRevision stores a new revision of a job definition. If the definition did not change since the last
+// revision, nothing is stored and revision is updated with the last revision number. If another revision
+// is inserted concurrently with the same number, the revision number is computed again.
+func (m *mongoImpl) PutJobRevision(revision *jobs.JobRevision) error {
+	c := context.Background()
+	coll := m.DB().Collection(collRevisions)
+	for i := 0; ; i++ {
+		if last, e := m.ListJobRevisions(revision.JobID, 0, 1); e != nil {
+			return e
+		} else if len(last) > 0 {
+			if sameJobDefinition(last[0].Job, revision.Job) {
+				revision.Revision = last[0].Revision
+				return nil
+			}
+			revision.Revision = last[0].Revision + 1
+		} else {
+			revision.Revision = 1
+		}
+		_, e := coll.InsertOne(c, &mongoRevision{JobId: revision.JobID, Revision: revision.Revision, JobRevision: revision})
+		if e == nil {
+			break
+		} else if !mongo.IsDuplicateKeyError(e) || i == putRevisionRetries-1 {
+			return e
+		}
+	}
+	// Prune oldest revisions
+	_, e := coll.DeleteMany(c, bson.D{{"job_id", revision.JobID}, {"revision", bson.M{"$lte": revision.Revision - maxJobRevisions}}})
+	return e
+}
+
+// ListJobRevisions lists revisions of a job, most recent first.
+func (m *mongoImpl) ListJobRevisions(jobId string, offset, limit int32) (revisions []*jobs.JobRevision, e error) {
+	findOpts := &options.FindOptions{
+		Sort: bson.M{"revision": -1},
+	}
+	if offset > 0 {
+		o := int64(offset)
+		findOpts.Skip = &o
+	}
+	if limit > 0 {
+		l := int64(limit)
+		findOpts.Limit = &l
+	}
+	c := context.Background()
+	cursor, e := m.DB().Collection(collRevisions).Find(c, bson.D{{"job_id", jobId}}, findOpts)
+	if e != nil {
+		return nil, e
+	}
+	for cursor.Next(c) {
+		mr := &mongoRevision{}
+		if er := cursor.Decode(mr); er != nil {
+			continue
+		}
+		revisions = append(revisions, mr.JobRevision)
+	}
+	return
+}
+
+// GetJobRevision loads a specific revision of a job.
+func (m *mongoImpl) GetJobRevision(jobId string, revision int32) (*jobs.JobRevision, error) {
+	res := m.DB().Collection(collRevisions).FindOne(context.Background(), bson.D{{"job_id", jobId}, {"revision", revision}})
+	if res.Err() != nil {
+		if strings.Contains(res.Err().Error(), "no documents in result") {
+			return nil, errors.NotFound("job.revision.not.found", "Job revision not found")
+		}
+		return nil, res.Err()
+	}
+	mr := &mongoRevision{}
+	if er := res.Decode(mr); er != nil {
+		return nil, er
+	}
+	return mr.JobRevision, nil
+}
+
 func (m *mongoImpl) PutTask(task *jobs.Task) error {
 	c := context.Background()
 	// do not store tasks inside job
@@ -163,13 +163,14 @@ func (s *JobsHandler) UserControlJob(req *restful.Request, rsp *restful.Response
 			rsp.WriteEntity(&jobs.CtrlCommandResponse{Msg: fmt.Sprintf("Deleted %v tasks", len(response.Deleted))})
 		}
 
-	} else if cmd.Cmd == jobs.Command_RunOnce {
+	} else if cmd.Cmd == jobs.Command_RunOnce || cmd.Cmd == jobs.Command_DryRun {
 
 		broker.MustPublish(ctx, common.TopicTimerEvent, &jobs.JobTriggerEvent{
 			JobID:         cmd.JobId,
 			RunNow:        true,
 			RunTaskId:     cmd.TaskId,
 			RunParameters: cmd.RunParameters,
+			DryRun:        cmd.Cmd == jobs.Command_DryRun,
 		})
 
 	} else if cmd.Cmd == jobs.Command_Active || cmd.Cmd == jobs.Command_Inactive {
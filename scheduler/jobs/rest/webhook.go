@@ -0,0 +1,242 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v4/common"
+	"github.com/pydio/cells/v4/common/auth/claim"
+	"github.com/pydio/cells/v4/common/broker"
+	"github.com/pydio/cells/v4/common/client/grpc"
+	"github.com/pydio/cells/v4/common/config"
+	"github.com/pydio/cells/v4/common/log"
+	"github.com/pydio/cells/v4/common/proto/jobs"
+	"github.com/pydio/cells/v4/common/service"
+	json "github.com/pydio/cells/v4/common/utils/jsonx"
+	"github.com/pydio/cells/v4/common/utils/uuid"
+)
+
+const (
+	// webhookMaxBodySize limits the size of the payload accepted by the webhook endpoint
+	webhookMaxBodySize = 1024 * 1024
+	// webhookMaxAge is the maximum difference accepted between the signed timestamp and the server time
+	webhookMaxAge = 5 * time.Minute
+	// webhookSignatureHeader contains an HMAC-SHA256 signature of the timestamp and the body joined by
+	// a dot, expressed as hex string with an optional "sha256=" prefix.
+	webhookSignatureHeader = "X-Cells-Signature-256"
+	// webhookTimestampHeader contains the signed timestamp, in seconds since epoch
+	webhookTimestampHeader = "X-Cells-Timestamp"
+)
+
+var (
+	// webhookSeen keeps the signatures received during the last webhookMaxAge, to refuse replayed requests
+	webhookSeen     = map[string]time.Time{}
+	webhookSeenLock sync.Mutex
+)
+
+// webhookSwagger declares the webhook route, that is not generated from the protobuf definitions
+// as it accepts arbitrary payloads.
+const webhookSwagger = `{
+  "swagger": "2.0",
+  "info": {"title": "Jobs Webhook", "version": "2.0"},
+  "paths": {
+    "/jobs/webhook/{JobID}": {
+      "post": {
+        "operationId": "TriggerJobWebhook",
+        "parameters": [
+          {"in": "path", "name": "JobID", "required": true, "type": "string"},
+          {"in": "body", "name": "body", "required": false, "schema": {"type": "object"}}
+        ],
+        "responses": {
+          "200": {"description": "Job was triggered, response contains the ID of the new task"},
+          "401": {"description": "Missing or invalid signature or token, or webhook not enabled"}
+        },
+        "tags": ["JobsService"]
+      }
+    }
+  }
+}`
+
+func init() {
+	service.RegisterSwaggerJSON(webhookSwagger)
+}
+
+// TriggerJobWebhook starts a job from an external system. The request must either be signed with the
+// job webhook HMAC secret, or authenticated with a token of the job owner or an administrator.
+// Query and body parameters are passed to the job as RunParameters, and the raw body as RunPayload.
+// Unknown jobs are reported like invalid signatures, not to disclose which jobs exist.
+func (s *JobsHandler) TriggerJobWebhook(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	jobID := req.PathParameter("JobID")
+
+	var job *jobs.Job
+	cli := jobs.NewJobServiceClient(grpc.GetClientConnFromCtx(ctx, common.ServiceJobs))
+	if resp, er := cli.GetJob(ctx, &jobs.GetJobRequest{JobID: jobID}); er == nil && resp.GetJob().GetWebhook().GetEnabled() {
+		job = resp.GetJob()
+	}
+
+	body, er := io.ReadAll(io.LimitReader(req.Request.Body, webhookMaxBodySize+1))
+	if er != nil {
+		service.RestError500(req, rsp, er)
+		return
+	}
+	if len(body) > webhookMaxBodySize {
+		_ = rsp.WriteErrorString(http.StatusRequestEntityTooLarge, "webhook payload is too large")
+		return
+	}
+
+	if job == nil || !webhookAuthorized(req, job, body) {
+		log.Auditer(ctx).Error("Blocked unauthorized webhook request for job "+jobID, zap.String("jobId", jobID))
+		service.RestError401(req, rsp, fmt.Errorf("invalid signature or token"))
+		return
+	}
+	if job.Inactive {
+		service.RestError403(req, rsp, fmt.Errorf("job %s is inactive", jobID))
+		return
+	}
+
+	taskID := uuid.New()
+	broker.MustPublish(ctx, common.TopicTimerEvent, &jobs.JobTriggerEvent{
+		JobID:         jobID,
+		RunNow:        true,
+		RunTaskId:     taskID,
+		RunParameters: webhookParameters(req.Request.URL.Query(), req.HeaderParameter("Content-Type"), body),
+		RunPayload:    body,
+		Webhook:       true,
+	})
+	log.Logger(ctx).Info("Triggered job "+jobID+" from webhook", zap.String("taskId", taskID))
+
+	rsp.WriteEntity(&jobs.CtrlCommandResponse{Msg: taskID})
+}
+
+// webhookAuthorized checks the request signature against the job secret stored in the vault, or falls
+// back to the authenticated user, that must be the job owner or an administrator.
+func webhookAuthorized(req *restful.Request, job *jobs.Job, body []byte) bool {
+	if secret := config.GetSecret(job.WebhookSecretKey()).String(); secret != "" {
+		if sig := req.HeaderParameter(webhookSignatureHeader); sig != "" &&
+			verifyWebhookSignature(secret, req.HeaderParameter(webhookTimestampHeader), body, sig, time.Now()) {
+			return true
+		}
+	}
+	if c, ok := req.Request.Context().Value(claim.ContextKey).(claim.Claims); ok {
+		return c.Profile == common.PydioProfileAdmin || (c.Name != "" && c.Name == job.Owner)
+	}
+	return false
+}
+
+// verifyWebhookSignature checks an hex-encoded HMAC-SHA256 signature of the timestamp and the body joined
+// by a dot. Stale timestamps and signatures that were already received are refused.
+func verifyWebhookSignature(secret, timestamp string, body []byte, signature string, now time.Time) bool {
+	ts, er := strconv.ParseInt(timestamp, 10, 64)
+	if er != nil {
+		return false
+	}
+	if age := now.Sub(time.Unix(ts, 0)); age > webhookMaxAge || age < -webhookMaxAge {
+		return false
+	}
+	expected, er := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(signature), "sha256="))
+	if er != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return false
+	}
+	return webhookFirstSeen(hex.EncodeToString(expected), now)
+}
+
+// webhookFirstSeen records a valid signature, and returns false if it was already received.
+func webhookFirstSeen(signature string, now time.Time) bool {
+	webhookSeenLock.Lock()
+	defer webhookSeenLock.Unlock()
+	for sig, t := range webhookSeen {
+		if now.Sub(t) > webhookMaxAge {
+			delete(webhookSeen, sig)
+		}
+	}
+	if _, ok := webhookSeen[signature]; ok {
+		return false
+	}
+	webhookSeen[signature] = now
+	return true
+}
+
+// webhookParameters flattens query and body values to a map of parameters. Body is parsed
+// as a form or a JSON object depending on its content type. Non-string JSON values are kept
+// in their JSON representation.
+func webhookParameters(query url.Values, contentType string, body []byte) map[string]string {
+	params := make(map[string]string)
+	for k, vv := range query {
+		if len(vv) > 0 {
+			params[k] = vv[0]
+		}
+	}
+	if len(body) == 0 {
+		return params
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "application/x-www-form-urlencoded":
+		if values, er := url.ParseQuery(string(body)); er == nil {
+			for k, vv := range values {
+				if len(vv) > 0 {
+					params[k] = vv[0]
+				}
+			}
+		}
+	case "", "application/json":
+		var data map[string]interface{}
+		if er := json.Unmarshal(body, &data); er != nil {
+			break
+		}
+		for k, v := range data {
+			switch tv := v.(type) {
+			case string:
+				params[k] = tv
+			case nil:
+				params[k] = ""
+			default:
+				if b, e := json.Marshal(tv); e == nil {
+					params[k] = string(b)
+				}
+			}
+		}
+	}
+	return params
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2019-2021. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestVerifyWebhookSignature(t *testing.T) {
+
+	sign := func(secret, ts string, body []byte) string {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(ts + "."))
+		mac.Write(body)
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+
+	Convey("Verify HMAC signatures", t, func() {
+		now := time.Now()
+		ts := strconv.FormatInt(now.Unix(), 10)
+		body := []byte(`{"ticket":"1234"}`)
+		sig := sign("secret", ts, body)
+
+		So(verifyWebhookSignature("other", ts, body, sig, now), ShouldBeFalse)
+		So(verifyWebhookSignature("secret", ts, []byte(`{"ticket":"1235"}`), sig, now), ShouldBeFalse)
+		So(verifyWebhookSignature("secret", ts, body, "not-hex", now), ShouldBeFalse)
+		So(verifyWebhookSignature("secret", "", body, sig, now), ShouldBeFalse)
+		So(verifyWebhookSignature("secret", ts, body, "sha256="+sig, now), ShouldBeTrue)
+	})
+
+	Convey("Refuse replayed and stale requests", t, func() {
+		now := time.Now()
+		ts := strconv.FormatInt(now.Unix(), 10)
+		body := []byte(`{"ticket":"5678"}`)
+		sig := sign("secret", ts, body)
+		So(verifyWebhookSignature("secret", ts, body, sig, now), ShouldBeTrue)
+		So(verifyWebhookSignature("secret", ts, body, sig, now.Add(time.Second)), ShouldBeFalse)
+
+		old := strconv.FormatInt(now.Add(-10*time.Minute).Unix(), 10)
+		So(verifyWebhookSignature("secret", old, body, sign("secret", old, body), now), ShouldBeFalse)
+	})
+
+}
+
+func TestWebhookParameters(t *testing.T) {
+
+	Convey("Parse JSON body and query", t, func() {
+		q := url.Values{"source": []string{"ci"}, "ticket": []string{"overridden"}}
+		params := webhookParameters(q, "application/json; charset=utf-8", []byte(`{"ticket":"1234","count":3,"draft":false,"labels":["a","b"],"empty":null}`))
+		So(params["source"], ShouldEqual, "ci")
+		So(params["ticket"], ShouldEqual, "1234")
+		So(params["count"], ShouldEqual, "3")
+		So(params["draft"], ShouldEqual, "false")
+		So(params["labels"], ShouldEqual, `["a","b"]`)
+		So(params["empty"], ShouldEqual, "")
+	})
+
+	Convey("Parse form body", t, func() {
+		params := webhookParameters(url.Values{}, "application/x-www-form-urlencoded", []byte("user=john&group=dev"))
+		So(params, ShouldResemble, map[string]string{"user": "john", "group": "dev"})
+	})
+
+	Convey("Ignore unsupported bodies", t, func() {
+		params := webhookParameters(url.Values{"a": []string{"b"}}, "text/plain", []byte("hello"))
+		So(params, ShouldResemble, map[string]string{"a": "b"})
+		params = webhookParameters(url.Values{}, "application/json", []byte("[1,2]"))
+		So(params, ShouldBeEmpty)
+	})
+
+}
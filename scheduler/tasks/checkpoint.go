@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package tasks
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"time"
+
+	"github.com/pydio/cells/v4/common/proto/jobs"
+	"github.com/pydio/cells/v4/scheduler/actions"
+)
+
+// checkpointSaveInterval throttles the task updates sent when actions save checkpoints
+const checkpointSaveInterval = 2 * time.Second
+
+// checkpointer stores the progress of an action running on a given input inside the task
+type checkpointer struct {
+	task       *Task
+	actionPath string
+	inputHash  string
+}
+
+// Checkpointer provides an actions.Checkpointer for the action at actionPath running on input.
+func (t *Task) Checkpointer(actionPath string, input jobs.ActionMessage) actions.Checkpointer {
+	return &checkpointer{
+		task:       t,
+		actionPath: actionPath,
+		inputHash:  checkpointInputHash(input),
+	}
+}
+
+// LastCheckpoint returns the cursor saved by a previous run, if the checkpoint is not already marked as done.
+func (c *checkpointer) LastCheckpoint() (string, int64, bool) {
+	c.task.lockTask()
+	defer c.task.unlockTask()
+	if cp := c.task.findCheckpoint(c.actionPath); cp != nil && cp.InputHash == c.inputHash && !cp.Done {
+		return cp.Cursor, cp.Processed, true
+	}
+	return "", 0, false
+}
+
+// Checkpoint updates the task checkpoint. Task is saved at most every checkpointSaveInterval.
+func (c *checkpointer) Checkpoint(cursor string, processed int64) {
+	c.task.lockTask()
+	cp := c.task.findCheckpoint(c.actionPath)
+	if cp == nil {
+		cp = &jobs.TaskCheckpoint{ActionPath: c.actionPath}
+		c.task.lockedTask.Checkpoints = append(c.task.lockedTask.Checkpoints, cp)
+	}
+	cp.InputHash = c.inputHash
+	cp.Done = false
+	cp.Cursor = cursor
+	cp.Processed = processed
+	cp.Time = int32(time.Now().Unix())
+	save := time.Since(c.task.lastCheckpointSave) > checkpointSaveInterval
+	if save {
+		c.task.lastCheckpointSave = time.Now()
+	}
+	c.task.unlockTask()
+	if save {
+		c.task.Save()
+	}
+}
+
+// CheckpointDone checks if the action at actionPath already processed this input before the task was interrupted.
+func (t *Task) CheckpointDone(actionPath string, input jobs.ActionMessage) bool {
+	t.lockTask()
+	defer t.unlockTask()
+	cp := t.findCheckpoint(actionPath)
+	return cp != nil && cp.InputHash == checkpointInputHash(input) && cp.Done
+}
+
+// SetCheckpointDone flags the input as fully processed by the action at actionPath, so that it is skipped
+// if the task is resumed. A single checkpoint is kept per action: it is not replaced if it still holds
+// the cursor of another input being processed.
+func (t *Task) SetCheckpointDone(actionPath string, input jobs.ActionMessage) {
+	t.lockTask()
+	defer t.unlockTask()
+	hash := checkpointInputHash(input)
+	cp := t.findCheckpoint(actionPath)
+	if cp == nil {
+		cp = &jobs.TaskCheckpoint{ActionPath: actionPath}
+		t.lockedTask.Checkpoints = append(t.lockedTask.Checkpoints, cp)
+	} else if cp.InputHash != hash && !cp.Done && cp.Cursor != "" {
+		return
+	}
+	cp.InputHash = hash
+	cp.Cursor = ""
+	cp.Done = true
+	cp.Time = int32(time.Now().Unix())
+}
+
+// RestoreCheckpoints loads the checkpoints and the start time of a task that was interrupted, before it is resumed.
+func (t *Task) RestoreCheckpoints(saved *jobs.Task) {
+	t.lockTask()
+	defer t.unlockTask()
+	t.lockedTask.Checkpoints = saved.GetCheckpoints()
+	t.lockedTask.StartTime = saved.GetStartTime()
+	t.lockedTask.TriggerOwner = saved.GetTriggerOwner()
+	t.lockedTask.StatusMessage = "Resuming"
+}
+
+func (t *Task) findCheckpoint(actionPath string) *jobs.TaskCheckpoint {
+	for _, cp := range t.lockedTask.Checkpoints {
+		if cp.ActionPath == actionPath {
+			return cp
+		}
+	}
+	return nil
+}
+
+// checkpointInputHash identifies an action input by its nodes and users.
+func checkpointInputHash(input jobs.ActionMessage) string {
+	h := md5.New()
+	for _, n := range input.GetNodes() {
+		h.Write([]byte("n:" + n.GetUuid() + ":" + n.GetPath() + "\n"))
+	}
+	for _, u := range input.GetUsers() {
+		h.Write([]byte("u:" + u.GetUuid() + "\n"))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
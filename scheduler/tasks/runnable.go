@@ -148,8 +148,9 @@ func (r *Runnable) RunAction(Queue chan Runnable) error {
 		return errors.NotFound(common.ServiceJobs, fmt.Sprintf("cannot run action: no concrete implementation found for ID %s, are you sure this action has been correctly registered?", r.Action.ID))
 	}
 
+	dryRun := r.Task.IsDryRun()
 	taskUpdateDelegated := false
-	if taskConsumer, ok := (r.Implementation).(actions.TaskUpdaterDelegateAction); ok {
+	if taskConsumer, ok := (r.Implementation).(actions.TaskUpdaterDelegateAction); ok && !dryRun {
 		taskUpdateDelegated = true
 		taskConsumer.SetTask(r.Task.GetJobTaskClone())
 	}
@@ -176,11 +177,27 @@ func (r *Runnable) RunAction(Queue chan Runnable) error {
 	r.Task.SetStartTime(time.Now())
 	r.Task.Save()
 
+	resumable, isResumable := r.Implementation.(actions.ResumableAction)
+	if isResumable && !r.Action.Bypass && !dryRun {
+		if r.Task.CheckpointDone(r.ActionPath, r.Message) {
+			// Chain was already dispatched before the task was interrupted
+			log.TasksLogger(r.Context).Info("Skipping action " + r.ID + " as it was already completed before the task was interrupted.")
+			r.Task.Done(1)
+			r.Task.SetStatus(jobs.TaskStatus_Finished, "Complete")
+			r.Task.SetEndTime(time.Now())
+			r.Task.Save()
+			return nil
+		}
+		resumable.SetCheckpointer(r.Task.Checkpointer(r.ActionPath, r.Message))
+	}
+
 	var outputMessage jobs.ActionMessage
 	var err error
 	if r.Action.Bypass {
 		log.TasksLogger(r.Context).Warn("Skipping action " + r.ID + " as it is flagged Bypass. Forwarding input to output.")
 		outputMessage = r.Message
+	} else if dryRun {
+		outputMessage, err = r.dryRunAction()
 	} else {
 		runnableChannels, done := r.Task.GetRunnableChannels()
 		outputMessage, err = r.Implementation.Run(r.Context, runnableChannels, r.Message)
@@ -196,6 +213,9 @@ func (r *Runnable) RunAction(Queue chan Runnable) error {
 		return err
 	}
 	r.Task.AppendLog(r.Action, r.Message, outputMessage)
+	if isResumable && !r.Action.Bypass && !dryRun {
+		r.Task.SetCheckpointDone(r.ActionPath, r.Message)
+	}
 
 	if !r.Action.BreakAfter {
 		r.Dispatch(r.ActionPath, outputMessage, r.ChainedActions, Queue)
@@ -211,3 +231,25 @@ func (r *Runnable) RunAction(Queue chan Runnable) error {
 
 	return nil
 }
+
+// dryRunAction asks the implementation to describe what it would do with its input instead of running it.
+// Actions that do not implement DryRunnableAction forward their input and are described by default.
+func (r *Runnable) dryRunAction() (jobs.ActionMessage, error) {
+	var plan string
+	output := r.Message
+	if dr, ok := r.Implementation.(actions.DryRunnableAction); ok {
+		out, p, e := dr.DryRun(r.Context, &r.Message)
+		if e != nil {
+			return r.Message.WithError(e), e
+		}
+		output, plan = *out, p
+	} else {
+		plan = actions.DescribeInput(r.Implementation, &r.Message)
+	}
+	log.TasksLogger(r.Context).Info("[Dry Run] " + plan)
+	output.AppendOutput(&jobs.ActionOutput{
+		Success:    true,
+		StringBody: plan,
+	})
+	return output, nil
+}
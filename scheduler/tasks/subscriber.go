@@ -22,6 +22,7 @@ package tasks
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"sync"
@@ -47,6 +48,7 @@ import (
 	"github.com/pydio/cells/v4/common/utils/cache"
 	"github.com/pydio/cells/v4/common/utils/permissions"
 	"github.com/pydio/cells/v4/common/utils/std"
+	"github.com/pydio/cells/v4/scheduler/actions"
 )
 
 const (
@@ -142,6 +144,7 @@ func NewSubscriber(parentContext context.Context) *Subscriber {
 // Init subscriber with current list of jobs from Jobs service
 func (s *Subscriber) Init() {
 
+	started := time.Now()
 	go func() error {
 		// Load Jobs Definitions
 		jobClients := jobs.NewJobServiceClient(grpc.GetClientConnFromCtx(s.rootCtx, common.ServiceJobs))
@@ -152,7 +155,6 @@ func (s *Subscriber) Init() {
 		defer streamer.CloseSend()
 
 		s.Lock()
-		defer s.Unlock()
 		for {
 			resp, er := streamer.Recv()
 			if er != nil {
@@ -167,12 +169,88 @@ func (s *Subscriber) Init() {
 			s.definitions[resp.Job.ID] = resp.Job
 			s.getDispatcherForJob(resp.Job)
 		}
+		s.Unlock()
+
+		s.resumeInterruptedTasks(jobClients, started)
 
 		return nil
 	}()
 
 }
 
+// resumeInterruptedTasks restarts tasks that were interrupted by a restart after saving checkpoints.
+// They are flagged as Queued by the jobs service at startup, or still saved as Running if only this service
+// was restarted. Running tasks that cannot be resumed are set in Error status, and local files left by their
+// actions are removed.
+func (s *Subscriber) resumeInterruptedTasks(jobClients jobs.JobServiceClient, started time.Time) {
+	var saved []*jobs.Task
+	for _, status := range []jobs.TaskStatus{jobs.TaskStatus_Queued, jobs.TaskStatus_Running} {
+		streamer, e := jobClients.ListTasks(s.rootCtx, &jobs.ListTasksRequest{Status: status})
+		if e != nil {
+			log.Logger(s.rootCtx).Error("Cannot list interrupted tasks", zap.Error(e))
+			return
+		}
+		for {
+			resp, er := streamer.Recv()
+			if er != nil {
+				break
+			}
+			// Ignore tasks started since this service is up
+			if t := resp.GetTask(); t != nil && int64(t.GetStartTime()) < started.Unix() {
+				saved = append(saved, t)
+			}
+		}
+		streamer.CloseSend()
+	}
+
+	var cursors []string
+	var resumed []*Task
+	for _, t := range saved {
+		s.RLock()
+		job, ok := s.definitions[t.JobID]
+		s.RUnlock()
+		if !ok || len(t.GetCheckpoints()) == 0 || len(job.EventNames) > 0 {
+			if t.Status == jobs.TaskStatus_Running {
+				log.Logger(s.rootCtx).Info("Setting task " + t.ID + " in error status as it was saved as running")
+				t.Status = jobs.TaskStatus_Error
+				t.StatusMessage = "Task stuck"
+				if _, e := jobClients.PutTask(s.rootCtx, &jobs.PutTaskRequest{Task: t}); e != nil {
+					log.Logger(s.rootCtx).Error("Cannot update stuck task", zap.Error(e))
+				}
+			}
+			continue
+		}
+		ctx := s.rootCtx
+		if t.TriggerOwner != "" {
+			ctx = metadata.WithUserNameMetadata(context.WithValue(ctx, common.PydioContextUserKey, t.TriggerOwner), t.TriggerOwner)
+		}
+		trigger := &jobs.JobTriggerEvent{
+			JobID:         job.ID,
+			RunNow:        true,
+			RunTaskId:     t.ID,
+			RunParameters: t.GetRunParameters(),
+			RunPayload:    t.GetRunPayload(),
+			Webhook:       t.GetWebhook(),
+		}
+		ctx = s.prepareTaskContext(ctx, job, true, trigger)
+		log.Logger(ctx).Info("Resuming task " + t.ID + " of job " + job.ID + " from its last checkpoints")
+		task := NewTaskFromEvent(ctx, job, trigger)
+		task.RestoreCheckpoints(t)
+		task.SetRuntimeContext(s.rootCtx)
+		for _, cp := range t.GetCheckpoints() {
+			cursors = append(cursors, cp.GetCursor())
+		}
+		resumed = append(resumed, task)
+	}
+
+	// Remove local files left by actions of tasks that will not be resumed
+	actions.GetActionsManager().SweepSpools(s.rootCtx, cursors, started)
+
+	for _, task := range resumed {
+		go task.EnqueueRunnables(s.queue)
+	}
+}
+
 // Stop closes internal EventsBatcher
 func (s *Subscriber) Stop() {
 	s.batcher.Done <- true
@@ -250,7 +328,7 @@ func (s *Subscriber) jobsChangeEvent(ctx context.Context, msg *jobs.JobChangeEve
 }
 
 // prepareTaskContext creates adequate context for launching a task
-func (s *Subscriber) prepareTaskContext(ctx context.Context, job *jobs.Job, addSystemUser bool, eventParameters ...map[string]string) context.Context {
+func (s *Subscriber) prepareTaskContext(ctx context.Context, job *jobs.Job, addSystemUser bool, trigger ...*jobs.JobTriggerEvent) context.Context {
 
 	// Add System User if necessary
 	if addSystemUser {
@@ -268,11 +346,15 @@ func (s *Subscriber) prepareTaskContext(ctx context.Context, job *jobs.Job, addS
 		for _, p := range job.Parameters {
 			params[p.Name] = jobs.EvaluateFieldStr(ctx, jobs.ActionMessage{}, p.Value)
 		}
-		if len(eventParameters) > 0 {
-			// Replace job parameters with values passed through TriggerEvent
-			for k, v := range eventParameters[0] {
+		if len(trigger) > 0 {
+			// Replace job parameters with values passed through TriggerEvent. Values sent to a webhook
+			// are caller data, they are used as is and never evaluated.
+			for k, v := range trigger[0].GetRunParameters() {
 				if _, o := params[k]; o {
-					params[k] = jobs.EvaluateFieldStr(ctx, jobs.ActionMessage{}, v)
+					if !trigger[0].GetWebhook() {
+						v = jobs.EvaluateFieldStr(ctx, jobs.ActionMessage{}, v)
+					}
+					params[k] = v
 				}
 			}
 		}
@@ -298,15 +380,18 @@ func (s *Subscriber) timerEvent(ctx context.Context, event *jobs.JobTriggerEvent
 		}
 		j = resp.Job
 	}
-	if j.Inactive {
+	// A dry-run can be used to test an inactive job before enabling it
+	if j.Inactive && !event.GetDryRun() {
 		return nil
 	}
 	if event.GetRunNow() && event.GetRunParameters() != nil {
-		ctx = s.prepareTaskContext(ctx, j, true, event.GetRunParameters())
+		ctx = s.prepareTaskContext(ctx, j, true, event)
 	} else {
 		ctx = s.prepareTaskContext(ctx, j, true)
 	}
-	if event.GetRunNow() {
+	if event.GetDryRun() {
+		log.Logger(ctx).Info("Dry-run Job " + jobId + " on demand")
+	} else if event.GetRunNow() {
 		log.Logger(ctx).Info("Run Job " + jobId + " on demand")
 	} else {
 		log.Logger(ctx).Info("Run Job " + jobId + " on timer event " + event.Schedule.String())
@@ -516,6 +601,16 @@ func createMessageFromEvent(event interface{}) jobs.ActionMessage {
 
 		any, _ := anypb.New(triggerEvent)
 		initialInput.Event = any
+		if len(triggerEvent.RunPayload) > 0 {
+			// Expose webhook payload as initial output, as JSON if possible
+			output := &jobs.ActionOutput{Success: true}
+			if json.Valid(triggerEvent.RunPayload) {
+				output.JsonBody = triggerEvent.RunPayload
+			} else {
+				output.RawBody = triggerEvent.RunPayload
+			}
+			initialInput.AppendOutput(output)
+		}
 
 	} else if idmEvent, ok := event.(*idm.ChangeEvent); ok {
 
@@ -542,8 +637,15 @@ func createMessageFromEvent(event interface{}) jobs.ActionMessage {
 func logStartMessageFromEvent(ctx context.Context, task *Task, event interface{}) {
 	var msg string
 	if triggerEvent, ok := event.(*jobs.JobTriggerEvent); ok {
-		if triggerEvent.Schedule == nil {
+		if triggerEvent.Webhook {
+			msg = "Starting job from webhook"
+		} else if triggerEvent.Schedule == nil {
 			msg = "Starting job manually"
+		} else if c := triggerEvent.Schedule.GetCron(); c != "" {
+			msg = "Starting job on cron schedule " + c
+			if tz := triggerEvent.Schedule.GetTimezone(); tz != "" {
+				msg += " (" + tz + ")"
+			}
 		} else {
 			msg = "Starting job on schedule " + strings.ReplaceAll(triggerEvent.Schedule.String(), "Iso8601Schedule:", "")
 		}
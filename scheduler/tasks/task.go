@@ -44,6 +44,9 @@ type Task struct {
 	lockedTask     *jobs.Task
 	rc             int
 	run            string
+	dryRun         bool
+
+	lastCheckpointSave time.Time
 }
 
 // NewTaskFromEvent creates a task based on incoming job and event
@@ -68,11 +71,26 @@ func NewTaskFromEvent(ctx context.Context, job *jobs.Job, event interface{}) *Ta
 			TriggerOwner:  ctxUserName,
 		},
 	}
+	if trigger, ok := event.(*jobs.JobTriggerEvent); ok {
+		if trigger.DryRun {
+			t.dryRun = true
+			t.lockedTask.DryRun = true
+		}
+		// Keep run values, to rebuild the trigger if the task is resumed
+		t.lockedTask.RunParameters = trigger.RunParameters
+		t.lockedTask.RunPayload = trigger.RunPayload
+		t.lockedTask.Webhook = trigger.Webhook
+	}
 	t.initialMessage = createMessageFromEvent(event)
 	logStartMessageFromEvent(c, t, event)
 	return t
 }
 
+// IsDryRun tells whether actions should only describe what they would do instead of running
+func (t *Task) IsDryRun() bool {
+	return t.dryRun
+}
+
 // Add increments task internal retain counter
 func (t *Task) Add(delta int) {
 	t.lockTask()
@@ -114,6 +132,10 @@ func (t *Task) SetStatus(status jobs.TaskStatus, message ...string) {
 	if len(message) > 0 {
 		t.lockedTask.StatusMessage = message[0]
 	}
+	if status == jobs.TaskStatus_Finished {
+		// Task will not be resumed anymore
+		t.lockedTask.Checkpoints = nil
+	}
 	t.lockedTask.Status = status
 }
 
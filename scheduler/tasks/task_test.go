@@ -32,6 +32,7 @@ import (
 
 	"github.com/pydio/cells/v4/common/proto/jobs"
 	"github.com/pydio/cells/v4/common/proto/tree"
+	"github.com/pydio/cells/v4/scheduler/actions"
 
 	// registered default scheduler actions
 	"github.com/pydio/cells/v4/common/service/context"
@@ -60,6 +61,14 @@ func TestNewTaskFromEvent(t *testing.T) {
 		opId, _ := servicecontext.GetOperationID(task.context)
 		So(opId, ShouldEqual, "ajob-"+task.lockedTask.ID[0:8])
 	})
+
+	Convey("Run values are kept on the task", t, func() {
+		event := &jobs.JobTriggerEvent{JobID: "ajob", RunParameters: map[string]string{"p": "v"}, RunPayload: []byte("{}"), Webhook: true}
+		task := NewTaskFromEvent(context.Background(), &jobs.Job{ID: "ajob"}, event)
+		So(task.lockedTask.RunParameters, ShouldResemble, map[string]string{"p": "v"})
+		So(string(task.lockedTask.RunPayload), ShouldEqual, "{}")
+		So(task.lockedTask.Webhook, ShouldBeTrue)
+	})
 }
 
 func TestTaskSetters(t *testing.T) {
@@ -198,6 +207,84 @@ func TestTask_Save(t *testing.T) {
 	})
 }
 
+func TestTask_Checkpoints(t *testing.T) {
+
+	Convey("Test task checkpoints", t, func() {
+
+		task := NewTaskFromEvent(context.Background(), &jobs.Job{ID: "ajob"}, &jobs.JobTriggerEvent{JobID: "ajob"})
+		input := jobs.ActionMessage{Nodes: []*tree.Node{{Uuid: "uuid", Path: "folder"}}}
+		other := jobs.ActionMessage{Nodes: []*tree.Node{{Uuid: "uuid2", Path: "folder2"}}}
+
+		// Do not publish task on checkpoint
+		task.lastCheckpointSave = time.Now()
+		cp := task.Checkpointer("ROOT/action$0", input)
+		_, _, ok := cp.LastCheckpoint()
+		So(ok, ShouldBeFalse)
+
+		cp.Checkpoint("folder/file", 3)
+		cursor, processed, ok := task.Checkpointer("ROOT/action$0", input).LastCheckpoint()
+		So(ok, ShouldBeTrue)
+		So(cursor, ShouldEqual, "folder/file")
+		So(processed, ShouldEqual, 3)
+		_, _, ok = task.Checkpointer("ROOT/action$0", other).LastCheckpoint()
+		So(ok, ShouldBeFalse)
+
+		So(task.CheckpointDone("ROOT/action$0", input), ShouldBeFalse)
+		task.SetCheckpointDone("ROOT/action$0", input)
+		So(task.CheckpointDone("ROOT/action$0", input), ShouldBeTrue)
+		_, _, ok = cp.LastCheckpoint()
+		So(ok, ShouldBeFalse)
+
+		resumed := NewTaskFromEvent(context.Background(), &jobs.Job{ID: "ajob"}, &jobs.JobTriggerEvent{JobID: "ajob", RunTaskId: task.GetRunUUID()})
+		resumed.RestoreCheckpoints(task.GetJobTaskClone())
+		So(resumed.CheckpointDone("ROOT/action$0", input), ShouldBeTrue)
+
+		// A single checkpoint is kept per action
+		task.Checkpointer("ROOT/action$0", other).Checkpoint("folder2/file", 1)
+		task.SetCheckpointDone("ROOT/action$0", input)
+		So(task.GetJobTaskClone().Checkpoints, ShouldHaveLength, 1)
+		cursor, _, ok = task.Checkpointer("ROOT/action$0", other).LastCheckpoint()
+		So(ok, ShouldBeTrue)
+		So(cursor, ShouldEqual, "folder2/file")
+		task.SetCheckpointDone("ROOT/action$0", other)
+		So(task.CheckpointDone("ROOT/action$0", other), ShouldBeTrue)
+		So(task.CheckpointDone("ROOT/action$0", input), ShouldBeFalse)
+		So(task.GetJobTaskClone().Checkpoints, ShouldHaveLength, 1)
+
+		task.SetStatus(jobs.TaskStatus_Finished)
+		So(task.GetJobTaskClone().Checkpoints, ShouldBeEmpty)
+
+	})
+}
+
+func TestTask_DryRun(t *testing.T) {
+
+	Convey("Test dry-run task", t, func() {
+
+		task := NewTaskFromEvent(context.Background(), &jobs.Job{ID: "ajob"}, &jobs.JobTriggerEvent{JobID: "ajob"})
+		So(task.IsDryRun(), ShouldBeFalse)
+
+		task = NewTaskFromEvent(context.Background(), &jobs.Job{ID: "ajob"}, &jobs.JobTriggerEvent{JobID: "ajob", DryRun: true})
+		So(task.IsDryRun(), ShouldBeTrue)
+		So(task.GetJobTaskClone().DryRun, ShouldBeTrue)
+
+		input := jobs.ActionMessage{Nodes: []*tree.Node{{Uuid: "uuid", Path: "folder/file"}}}
+		r := NewRunnable(context.Background(), "ROOT", 0, task, &jobs.Action{ID: "actions.test.fake"}, input)
+		output, err := r.dryRunAction()
+		So(err, ShouldBeNil)
+		So(output.Nodes, ShouldHaveLength, 1)
+		So(output.GetLastOutput().Success, ShouldBeTrue)
+		So(output.GetLastOutput().StringBody, ShouldContainSubstring, "Would run")
+		So(output.GetLastOutput().StringBody, ShouldContainSubstring, "folder/file")
+
+		r = NewRunnable(context.Background(), "ROOT", 0, task, &jobs.Action{ID: actions.IgnoredActionName}, jobs.ActionMessage{})
+		output, err = r.dryRunAction()
+		So(err, ShouldBeNil)
+		So(output.GetLastOutput().StringBody, ShouldEqual, "Nothing to process")
+
+	})
+}
+
 func TestTask_EnqueueRunnables(t *testing.T) {
 
 	Convey("Test Enqueue Runnables", t, func(c C) {
@@ -130,8 +130,7 @@ func (e *EventProducer) StartOrUpdateJob(job *jobs.Job) {
 	jobId := job.ID
 	e.StopWaiter(jobId)
 
-	//schedule := job.Schedule
-	if s, err := schedule.NewTickerScheduleFromISO(job.Schedule.Iso8601Schedule); err == nil {
+	if s, err := tickerScheduleFromJob(job.Schedule); err == nil {
 		w := schedule.NewTicker(s, func() error {
 			e.EventChan <- &jobs.JobTriggerEvent{
 				JobID:    jobId,
@@ -145,3 +144,12 @@ func (e *EventProducer) StartOrUpdateJob(job *jobs.Job) {
 		log.Logger(context.Background()).Error("Cannot register job", zap.Error(err))
 	}
 }
+
+// tickerScheduleFromJob creates a TickerSchedule from the job Schedule, using the
+// Cron expression if it is set or the ISO8601 representation otherwise.
+func tickerScheduleFromJob(s *jobs.Schedule) (*schedule.TickerSchedule, error) {
+	if s.GetCron() != "" {
+		return schedule.NewTickerScheduleFromCron(s.GetCron(), s.GetTimezone())
+	}
+	return schedule.NewTickerScheduleFromISO(s.GetIso8601Schedule())
+}